@@ -6,6 +6,7 @@ type multiNodeContextKey int
 
 const (
 	contextKeyHeathCheckRequest multiNodeContextKey = iota + 1
+	contextKeyQuorumReadRequest
 )
 
 func CtxAddHealthCheckFlag(ctx context.Context) context.Context {
@@ -15,3 +16,13 @@ func CtxAddHealthCheckFlag(ctx context.Context) context.Context {
 func CtxIsHeathCheckRequest(ctx context.Context) bool {
 	return ctx.Value(contextKeyHeathCheckRequest) != nil
 }
+
+// CtxAddQuorumReadFlag marks the request as critical. If quorum reads are enabled, such requests are sent
+// to multiple RPCs and their result is only accepted if enough of them agree on it.
+func CtxAddQuorumReadFlag(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyQuorumReadRequest, struct{}{})
+}
+
+func CtxIsQuorumReadRequest(ctx context.Context) bool {
+	return ctx.Value(contextKeyQuorumReadRequest) != nil
+}
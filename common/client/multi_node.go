@@ -68,7 +68,12 @@ type MultiNode[
 	]
 	Close() error
 	NodeStates() map[string]string
+	// NodeLatencyScores returns a map of node Name->latency score, for the nodes that were scored
+	NodeLatencyScores() map[string]time.Duration
 	SelectNodeRPC() (RPC_CLIENT, error)
+	// SelectNodeRPCs returns RPCs of up to n alive nodes. The first one is the RPC returned by SelectNodeRPC, the
+	// remaining ones are ordered by preference of the NodeSelector.
+	SelectNodeRPCs(n int) ([]RPC_CLIENT, error)
 
 	BatchCallContextAll(ctx context.Context, b []BATCH_ELEM) error
 	ConfiguredChainID() CHAIN_ID
@@ -232,6 +237,32 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	return n.RPC(), nil
 }
 
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SelectNodeRPCs(n int) ([]RPC_CLIENT, error) {
+	active, err := c.selectNode()
+	if err != nil {
+		return nil, err
+	}
+	rpcs := []RPC_CLIENT{active.RPC()}
+	candidates := make([]Node[CHAIN_ID, HEAD, RPC_CLIENT], 0, len(c.nodes))
+	for _, node := range c.nodes {
+		if node != active {
+			candidates = append(candidates, node)
+		}
+	}
+	for len(rpcs) < n {
+		// a fresh selector is used on every iteration, so that the previously selected nodes are not considered
+		next := newNodeSelector(c.selectionMode, candidates).Select()
+		if next == nil {
+			break
+		}
+		rpcs = append(rpcs, next.RPC())
+		candidates = slices.DeleteFunc(candidates, func(node Node[CHAIN_ID, HEAD, RPC_CLIENT]) bool {
+			return node == next
+		})
+	}
+	return rpcs, nil
+}
+
 // selectNode returns the active Node, if it is still nodeStateAlive, otherwise it selects a new one from the NodeSelector.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) selectNode() (node Node[CHAIN_ID, HEAD, RPC_CLIENT], err error) {
 	c.activeMu.RLock()
@@ -575,6 +606,16 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	return
 }
 
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) NodeLatencyScores() (scores map[string]time.Duration) {
+	scores = make(map[string]time.Duration)
+	for _, n := range c.nodes {
+		if score, ok := n.LatencyScore(); ok {
+			scores[n.Name()] = score
+		}
+	}
+	return
+}
+
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) PendingSequenceAt(ctx context.Context, addr ADDR) (s SEQ, err error) {
 	n, err := c.selectNode()
 	if err != nil {
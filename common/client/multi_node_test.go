@@ -430,6 +430,54 @@ func TestMultiNode_selectNode(t *testing.T) {
 	})
 }
 
+func TestMultiNode_SelectNodeRPCs(t *testing.T) {
+	t.Parallel()
+	newNode := func(t *testing.T, state nodeState, order int32) (*mockNode[types.ID, types.Head[Hashable], multiNodeRPCClient], multiNodeRPCClient) {
+		rpc := newMultiNodeRPCClient(t)
+		node := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		node.On("State").Return(state).Maybe()
+		node.On("Order").Return(order).Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		node.On("String").Return(fmt.Sprintf("node_%d", order)).Maybe()
+		return node, rpc
+	}
+	t.Run("Returns active node first followed by alive nodes in order of preference", func(t *testing.T) {
+		t.Parallel()
+		node1, rpc1 := newNode(t, nodeStateAlive, 3)
+		node2, rpc2 := newNode(t, nodeStateAlive, 1)
+		node3, _ := newNode(t, nodeStateOutOfSync, 0)
+		node4, rpc4 := newNode(t, nodeStateAlive, 2)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModePriorityLevel,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{node1, node2, node3, node4},
+		})
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(node1).Once()
+		mn.nodeSelector = nodeSelector
+
+		rpcs, err := mn.SelectNodeRPCs(2)
+		require.NoError(t, err)
+		require.Equal(t, []multiNodeRPCClient{rpc1, rpc2}, rpcs)
+
+		rpcs, err = mn.SelectNodeRPCs(5)
+		require.NoError(t, err)
+		require.Equal(t, []multiNodeRPCClient{rpc1, rpc2, rpc4}, rpcs)
+	})
+	t.Run("Returns error if there are no alive nodes", func(t *testing.T) {
+		t.Parallel()
+		node, _ := newNode(t, nodeStateUnreachable, 1)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModePriorityLevel,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{node},
+		})
+		rpcs, err := mn.SelectNodeRPCs(2)
+		require.EqualError(t, err, ErroringNodeError.Error())
+		require.Nil(t, rpcs)
+	})
+}
+
 func TestMultiNode_ChainInfo(t *testing.T) {
 	t.Parallel()
 	type nodeParams struct {
@@ -69,6 +69,9 @@ type Node[
 	StateAndLatest() (nodeState, ChainInfo)
 	// HighestUserObservations - returns highest ChainInfo ever observed by underlying RPC excluding results of health check requests
 	HighestUserObservations() ChainInfo
+	// LatencyScore returns the expected time to obtain a successful response from the RPC, derived from the moving
+	// averages of observed round-trip time and error rate. Lower is better. Returns false if the node was not scored yet.
+	LatencyScore() (time.Duration, bool)
 	SetPoolChainInfoProvider(PoolChainInfoProvider)
 	// Name is a unique identifier for this node.
 	Name() string
@@ -108,6 +111,10 @@ type node[
 
 	poolInfoProvider PoolChainInfoProvider
 
+	latency latencyTracker
+	// reportsLatency is true if the RPC reports the latency of all its requests, including health checks
+	reportsLatency bool
+
 	stopCh services.StopChan
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
@@ -153,6 +160,10 @@ func NewNode[
 	n.lfcLog = logger.Named(lggr, "Lifecycle")
 	n.rpc = rpc
 	n.chainFamily = chainFamily
+	if r, ok := any(rpc).(latencyReporter); ok {
+		r.SetLatencyObserver(n.observeLatency)
+		n.reportsLatency = true
+	}
 	return n
 }
 
@@ -320,6 +331,10 @@ func (n *node[CHAIN_ID, HEAD, RPC]) disconnectAll() {
 	n.rpc.DisconnectAll()
 }
 
+func (n *node[CHAIN_ID, HEAD, RPC]) LatencyScore() (time.Duration, bool) {
+	return n.latency.score()
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) Order() int32 {
 	return n.order
 }
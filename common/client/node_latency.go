@@ -0,0 +1,75 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// latencyEWMAAlpha is the weight given to the most recent observation when updating the moving averages.
+	latencyEWMAAlpha = 0.3
+	// maxLatencyErrorRate caps the error rate used to compute the latency score, so that a node failing all of
+	// its requests still gets a finite (but very large) score.
+	maxLatencyErrorRate = 0.99
+)
+
+// latencyReporter is implemented by RPC clients that report the outcome of the requests they serve, so that the node
+// is scored on the latency of real requests rather than only on its health checks.
+type latencyReporter interface {
+	SetLatencyObserver(observe func(rtt time.Duration, err error))
+}
+
+// latencyTracker keeps exponentially weighted moving averages of RPC round-trip time and error rate.
+// It is safe for concurrent use.
+type latencyTracker struct {
+	mu        sync.RWMutex
+	rtt       float64 // EWMA of successful round-trip time, in nanoseconds
+	errorRate float64 // EWMA of failed requests, in range [0, 1]
+	// observed is false until the first successful round-trip was recorded
+	observed bool
+}
+
+// observe records the outcome of a single RPC request.
+// Round-trip times of failed requests are not taken into account, as errors are often returned much faster than
+// successful responses and would skew the average in favour of faulty nodes.
+func (l *latencyTracker) observe(rtt time.Duration, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err != nil {
+		l.errorRate = ewma(l.errorRate, 1)
+		return
+	}
+	l.errorRate = ewma(l.errorRate, 0)
+	if !l.observed {
+		l.rtt = float64(rtt)
+		l.observed = true
+		return
+	}
+	l.rtt = ewma(l.rtt, float64(rtt))
+}
+
+// stats returns current averages. ok is false if no successful request was observed yet.
+func (l *latencyTracker) stats() (rtt time.Duration, errorRate float64, ok bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return time.Duration(l.rtt), l.errorRate, l.observed
+}
+
+// score returns the expected time to obtain a successful response from the node, assuming failed requests are retried.
+// Lower is better. ok is false if no successful request was observed yet.
+func (l *latencyTracker) score() (time.Duration, bool) {
+	rtt, errorRate, ok := l.stats()
+	if !ok {
+		return 0, false
+	}
+	return latencyScore(rtt, errorRate), true
+}
+
+func latencyScore(rtt time.Duration, errorRate float64) time.Duration {
+	errorRate = min(errorRate, maxLatencyErrorRate)
+	return time.Duration(float64(rtt) / (1 - errorRate))
+}
+
+func ewma(avg, value float64) float64 {
+	return latencyEWMAAlpha*value + (1-latencyEWMAAlpha)*avg
+}
@@ -44,6 +44,18 @@ var (
 		Name: "pool_rpc_node_polls_success",
 		Help: "The total number of successful poll checks for the given RPC node",
 	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeRTT = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_rtt_seconds",
+		Help: "The moving average of successful request round-trip time for the given RPC node",
+	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_error_rate",
+		Help: "The moving average of failed requests for the given RPC node, in range [0, 1]",
+	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeLatencyScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_latency_score_seconds",
+		Help: "The latency score used by the LowestLatency selection mode for the given RPC node. Lower is better",
+	}, []string{"chainID", "nodeName"})
 )
 
 // zombieNodeCheckInterval controls how often to re-check to see if we need to
@@ -148,11 +160,15 @@ func (n *node[CHAIN_ID, HEAD, RPC]) aliveLoop() {
 			promPoolRPCNodePolls.WithLabelValues(n.chainID.String(), n.name).Inc()
 			lggr.Tracew("Polling for version", "nodeState", n.getCachedState(), "pollFailures", pollFailures)
 			var version string
+			pollStart := time.Now()
 			version, err = func(ctx context.Context) (string, error) {
 				ctx, cancel := context.WithTimeout(ctx, pollInterval)
 				defer cancel()
 				return n.RPC().ClientVersion(ctx)
 			}(ctx)
+			if !n.reportsLatency {
+				n.observeLatency(time.Since(pollStart), err)
+			}
 			if err != nil {
 				// prevent overflow
 				if pollFailures < math.MaxUint32 {
@@ -350,6 +366,18 @@ func (n *node[CHAIN_ID, HEAD, RPC]) onNewHead(lggr logger.SugaredLogger, chainIn
 	return true
 }
 
+// observeLatency records the outcome of a request, used to score the node for the LowestLatency selection mode
+func (n *node[CHAIN_ID, HEAD, RPC]) observeLatency(rtt time.Duration, err error) {
+	n.latency.observe(rtt, err)
+	avgRTT, errorRate, ok := n.latency.stats()
+	promPoolRPCNodeErrorRate.WithLabelValues(n.chainID.String(), n.name).Set(errorRate)
+	if !ok {
+		return
+	}
+	promPoolRPCNodeRTT.WithLabelValues(n.chainID.String(), n.name).Set(avgRTT.Seconds())
+	promPoolRPCNodeLatencyScore.WithLabelValues(n.chainID.String(), n.name).Set(latencyScore(avgRTT, errorRate).Seconds())
+}
+
 // isOutOfSyncWithPool returns outOfSync true if num or td is more than SyncThresold behind the best node.
 // Always returns outOfSync false for SyncThreshold 0.
 // liveNodes is only included when outOfSync is true.
@@ -366,7 +394,7 @@ func (n *node[CHAIN_ID, HEAD, RPC]) isOutOfSyncWithPool(localState ChainInfo) (o
 	ln, ci := n.poolInfoProvider.LatestChainInfo()
 	mode := n.nodePoolCfg.SelectionMode()
 	switch mode {
-	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel:
+	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLowestLatency:
 		return localState.BlockNumber < ci.BlockNumber-int64(threshold), ln
 	case NodeSelectionModeTotalDifficulty:
 		bigThreshold := big.NewInt(int64(threshold))
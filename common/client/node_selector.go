@@ -11,6 +11,7 @@ const (
 	NodeSelectionModeRoundRobin      = "RoundRobin"
 	NodeSelectionModeTotalDifficulty = "TotalDifficulty"
 	NodeSelectionModePriorityLevel   = "PriorityLevel"
+	NodeSelectionModeLowestLatency   = "LowestLatency"
 )
 
 type NodeSelector[
@@ -39,6 +40,8 @@ func newNodeSelector[
 		return NewTotalDifficultyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
 	case NodeSelectionModePriorityLevel:
 		return NewPriorityLevelNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
+	case NodeSelectionModeLowestLatency:
+		return NewLowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
 	default:
 		panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", selectionMode))
 	}
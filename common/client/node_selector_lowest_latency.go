@@ -0,0 +1,54 @@
+package client
+
+import (
+	"math"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type lowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] []Node[CHAIN_ID, HEAD, RPC]
+
+func NewLowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](nodes []Node[CHAIN_ID, HEAD, RPC]) NodeSelector[CHAIN_ID, HEAD, RPC] {
+	return lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
+}
+
+// Select returns the alive node with the lowest latency score. Nodes that have not been scored yet are only selected
+// if none of the alive nodes have a score. Ties are broken by node priority.
+func (s lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC]) Select() Node[CHAIN_ID, HEAD, RPC] {
+	var lowestScore time.Duration = math.MaxInt64
+	var lowestScoreNodes, unscoredNodes []Node[CHAIN_ID, HEAD, RPC]
+	for _, n := range s {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		score, ok := n.LatencyScore()
+		if !ok {
+			unscoredNodes = append(unscoredNodes, n)
+			continue
+		}
+		if score <= lowestScore {
+			if score < lowestScore {
+				lowestScore = score
+				lowestScoreNodes = nil
+			}
+			lowestScoreNodes = append(lowestScoreNodes, n)
+		}
+	}
+	if len(lowestScoreNodes) == 0 {
+		return firstOrHighestPriority(unscoredNodes)
+	}
+	return firstOrHighestPriority(lowestScoreNodes)
+}
+
+func (s lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC]) Name() string {
+	return NodeSelectionModeLowestLatency
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLowestLatencyNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, Head, NodeClient[types.ID, Head]](NodeSelectionModeLowestLatency, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLowestLatency)
+}
+
+func TestLowestLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+
+	newNode := func(t *testing.T, state nodeState, score time.Duration, scored bool, order int32) Node[types.ID, Head, nodeClient] {
+		node := newMockNode[types.ID, Head, nodeClient](t)
+		node.On("State").Return(state)
+		node.On("LatencyScore").Maybe().Return(score, scored)
+		node.On("Order").Maybe().Return(order)
+		return node
+	}
+
+	t.Run("selects alive node with the lowest score", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, 300*time.Millisecond, true, 1),
+			newNode(t, nodeStateOutOfSync, 10*time.Millisecond, true, 1),
+			newNode(t, nodeStateAlive, 50*time.Millisecond, true, 1),
+			newNode(t, nodeStateAlive, 100*time.Millisecond, true, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("prefers scored nodes over unscored", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, 0, false, 1),
+			newNode(t, nodeStateAlive, time.Second, true, 2),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("falls back to priority when no nodes are scored", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, 0, false, 3),
+			newNode(t, nodeStateAlive, 0, false, 1),
+			newNode(t, nodeStateAlive, 0, false, 2),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("same score but different order", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, 100*time.Millisecond, true, 2),
+			newNode(t, nodeStateAlive, 100*time.Millisecond, true, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("none alive", func(t *testing.T) {
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateOutOfSync, 100*time.Millisecond, true, 1),
+			newNode(t, nodeStateUnreachable, 0, false, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Nil(t, selector.Select())
+	})
+}
+
+func TestLatencyTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not scored before first successful observation", func(t *testing.T) {
+		var l latencyTracker
+		_, ok := l.score()
+		assert.False(t, ok)
+		l.observe(time.Second, assert.AnError)
+		_, ok = l.score()
+		assert.False(t, ok)
+		_, errorRate, _ := l.stats()
+		assert.InDelta(t, latencyEWMAAlpha, errorRate, 1e-9)
+	})
+
+	t.Run("first observation initializes average", func(t *testing.T) {
+		var l latencyTracker
+		l.observe(100*time.Millisecond, nil)
+		score, ok := l.score()
+		assert.True(t, ok)
+		assert.Equal(t, 100*time.Millisecond, score)
+	})
+
+	t.Run("moves towards recent observations", func(t *testing.T) {
+		var l latencyTracker
+		l.observe(100*time.Millisecond, nil)
+		for i := 0; i < 20; i++ {
+			l.observe(10*time.Millisecond, nil)
+		}
+		rtt, errorRate, ok := l.stats()
+		assert.True(t, ok)
+		assert.InDelta(t, float64(10*time.Millisecond), float64(rtt), float64(time.Millisecond))
+		assert.Zero(t, errorRate)
+	})
+
+	t.Run("errors increase score", func(t *testing.T) {
+		var healthy, faulty latencyTracker
+		healthy.observe(100*time.Millisecond, nil)
+		faulty.observe(100*time.Millisecond, nil)
+		faulty.observe(time.Millisecond, assert.AnError)
+		healthyScore, _ := healthy.score()
+		faultyScore, _ := faulty.score()
+		assert.Greater(t, faultyScore, healthyScore)
+	})
+
+	t.Run("score is capped for constantly failing node", func(t *testing.T) {
+		assert.InDelta(t, float64(100*time.Second), float64(latencyScore(time.Second, 1)), float64(time.Millisecond))
+	})
+}
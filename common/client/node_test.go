@@ -5,6 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	clientMocks "github.com/smartcontractkit/chainlink/v2/common/client/mocks"
@@ -105,3 +108,30 @@ func newTestNode(t *testing.T, opts testNodeOpts) testNode {
 		nodeI.(*node[types.ID, Head, NodeClient[types.ID, Head]]),
 	}
 }
+
+// latencyReportingClient is a NodeClient reporting the latency of its requests
+type latencyReportingClient struct {
+	*mockNodeClient[types.ID, Head]
+	observe func(rtt time.Duration, err error)
+}
+
+func (c *latencyReportingClient) SetLatencyObserver(observe func(rtt time.Duration, err error)) {
+	c.observe = observe
+}
+
+func TestNode_LatencyReporter(t *testing.T) {
+	t.Parallel()
+
+	rpc := &latencyReportingClient{mockNodeClient: newMockNodeClient[types.ID, Head](t)}
+	n := NewNode[types.ID, Head, NodeClient[types.ID, Head]](testNodeConfig{}, clientMocks.ChainConfig{}, logger.Test(t),
+		url.URL{}, nil, "test node", 42, types.RandomID(), 1, rpc, "test node chain family")
+	require.NotNil(t, rpc.observe, "the node observes the latency of the requests of the RPC")
+	assert.True(t, n.(*node[types.ID, Head, NodeClient[types.ID, Head]]).reportsLatency)
+
+	_, ok := n.LatencyScore()
+	assert.False(t, ok)
+	rpc.observe(100*time.Millisecond, nil)
+	score, ok := n.LatencyScore()
+	require.True(t, ok)
+	assert.Equal(t, 100*time.Millisecond, score)
+}
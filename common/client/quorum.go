@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrQuorumNotReached is returned by QuorumRead, if not enough RPCs agreed on the result of a request.
+var ErrQuorumNotReached = errors.New("quorum not reached")
+
+type quorumVote[T any] struct {
+	val T
+	err error
+	key string
+}
+
+// QuorumRead sends the request to all the given RPCs concurrently and returns the result as soon as `quorum` of them
+// returned the same result, as identified by resultKey. Errors are treated as results as well, bucketed by errorClass,
+// so that a deterministic error (e.g. an execution revert) is returned if enough RPCs agree on it, even if they word it
+// differently.
+// ErrQuorumNotReached is returned if the quorum can no longer be reached.
+func QuorumRead[RPC any, T any](ctx context.Context, rpcs []RPC, quorum int, call func(ctx context.Context, rpc RPC) (T, error),
+	resultKey func(T) (string, error), errorClass func(error) string) (res T, err error) {
+	if quorum < 1 {
+		return res, fmt.Errorf("invalid quorum %d: must be at least 1", quorum)
+	}
+	if len(rpcs) < quorum {
+		return res, fmt.Errorf("%w: only %d RPCs available, but quorum of %d is required", ErrQuorumNotReached, len(rpcs), quorum)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// buffered, so that late responses do not block
+	votes := make(chan quorumVote[T], len(rpcs))
+	for _, rpc := range rpcs {
+		go func(rpc RPC) {
+			val, err := call(ctx, rpc)
+			if err != nil {
+				votes <- quorumVote[T]{err: err, key: "error: " + errorClass(err)}
+				return
+			}
+			key, err := resultKey(val)
+			if err != nil {
+				// invalid results never agree with each other
+				votes <- quorumVote[T]{err: fmt.Errorf("failed to compute result key: %w", err), key: "invalid result: " + err.Error()}
+				return
+			}
+			votes <- quorumVote[T]{val: val, key: "result: " + key}
+		}(rpc)
+	}
+
+	counts := make(map[string]int)
+	responses := 0
+	for pending := len(rpcs); pending > 0; pending-- {
+		var v quorumVote[T]
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		case v = <-votes:
+		}
+		responses++
+		counts[v.key]++
+		if counts[v.key] >= quorum {
+			return v.val, v.err
+		}
+		// the quorum can no longer be reached, if even the most common result can not collect enough votes
+		maxVotes := 0
+		for _, c := range counts {
+			maxVotes = max(maxVotes, c)
+		}
+		if maxVotes+pending-1 < quorum {
+			break
+		}
+	}
+	return res, fmt.Errorf("%w: got %d distinct results from %d responses, but quorum of %d is required", ErrQuorumNotReached, len(counts), responses, quorum)
+}
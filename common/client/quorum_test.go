@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+type quorumTestRPC struct {
+	val   int
+	err   error
+	delay time.Duration
+}
+
+func quorumTestCall(ctx context.Context, rpc quorumTestRPC) (int, error) {
+	if rpc.delay > 0 {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(rpc.delay):
+		}
+	}
+	return rpc.val, rpc.err
+}
+
+func quorumTestKey(v int) (string, error) { return strconv.Itoa(v), nil }
+
+func quorumTestErrorClass(err error) string {
+	if strings.Contains(err.Error(), "reverted") {
+		return "reverted"
+	}
+	return err.Error()
+}
+
+func TestQuorumRead(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rejects invalid quorum", func(t *testing.T) {
+		_, err := QuorumRead(tests.Context(t), []quorumTestRPC{{val: 1}}, 0, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.EqualError(t, err, "invalid quorum 0: must be at least 1")
+	})
+
+	t.Run("fails if not enough RPCs are available", func(t *testing.T) {
+		_, err := QuorumRead(tests.Context(t), []quorumTestRPC{{val: 1}}, 2, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+	})
+
+	t.Run("returns result agreed on by quorum", func(t *testing.T) {
+		rpcs := []quorumTestRPC{{val: 1}, {val: 2}, {val: 1}}
+		val, err := QuorumRead(tests.Context(t), rpcs, 2, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.NoError(t, err)
+		assert.Equal(t, 1, val)
+	})
+
+	t.Run("does not wait for slow RPCs once quorum is reached", func(t *testing.T) {
+		rpcs := []quorumTestRPC{{val: 1}, {val: 1}, {val: 1, delay: time.Hour}}
+		val, err := QuorumRead(tests.Context(t), rpcs, 2, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.NoError(t, err)
+		assert.Equal(t, 1, val)
+	})
+
+	t.Run("fails if RPCs disagree", func(t *testing.T) {
+		rpcs := []quorumTestRPC{{val: 1}, {val: 2}, {val: 3}}
+		_, err := QuorumRead(tests.Context(t), rpcs, 2, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+	})
+
+	t.Run("fails early once quorum can no longer be reached", func(t *testing.T) {
+		rpcs := []quorumTestRPC{{val: 1}, {val: 2}, {val: 1, delay: time.Hour}}
+		_, err := QuorumRead(tests.Context(t), rpcs, 3, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+	})
+
+	t.Run("returns error agreed on by quorum", func(t *testing.T) {
+		rpcs := []quorumTestRPC{{err: errors.New("execution reverted")}, {val: 1}, {err: errors.New("execution reverted")}}
+		_, err := QuorumRead(tests.Context(t), rpcs, 2, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.EqualError(t, err, "execution reverted")
+	})
+
+	t.Run("errors of the same class agree", func(t *testing.T) {
+		rpcs := []quorumTestRPC{{err: errors.New("execution reverted: a")}, {val: 1}, {err: errors.New("reverted: b")}}
+		_, err := QuorumRead(tests.Context(t), rpcs, 2, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.ErrorContains(t, err, "reverted")
+		require.NotErrorIs(t, err, ErrQuorumNotReached)
+	})
+
+	t.Run("errors do not count towards a result", func(t *testing.T) {
+		rpcs := []quorumTestRPC{{err: errors.New("connection refused")}, {val: 1}, {err: errors.New("timeout")}}
+		_, err := QuorumRead(tests.Context(t), rpcs, 2, quorumTestCall, quorumTestKey, quorumTestErrorClass)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+	})
+}
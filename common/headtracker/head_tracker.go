@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	htrktypes "github.com/smartcontractkit/chainlink/v2/common/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
@@ -44,6 +45,13 @@ type HeadTracker[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface
 	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized H, err error)
 }
 
+// FinalityProvider determines the latest finalized block of chains which derive their finality from another source than
+// the finality tag or depth, e.g. L2s finalizing their blocks once the L1 batch containing them is final.
+type FinalityProvider[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface {
+	// LatestFinalizedBlockNumber returns the number of the latest finalized ancestor of latest
+	LatestFinalizedBlockNumber(ctx context.Context, latest H) (int64, error)
+}
+
 type headTracker[
 	HTH htrktypes.Head[BLOCK_HASH, ID],
 	S types.Subscription,
@@ -61,6 +69,7 @@ type headTracker[
 	chainID         types.ID
 	config          htrktypes.Config
 	htConfig        htrktypes.HeadTrackerConfig
+	finality        FinalityProvider[HTH, BLOCK_HASH]
 
 	backfillMB   *mailbox.Mailbox[HTH]
 	broadcastMB  *mailbox.Mailbox[HTH]
@@ -69,6 +78,7 @@ type headTracker[
 }
 
 // NewHeadTracker instantiates a new HeadTracker using HeadSaver to persist new block numbers.
+// The optional finalityProvider replaces the finality tag, or depth, to determine the latest finalized block.
 func NewHeadTracker[
 	HTH htrktypes.Head[BLOCK_HASH, ID],
 	S types.Subscription,
@@ -82,6 +92,7 @@ func NewHeadTracker[
 	headBroadcaster HeadBroadcaster[HTH, BLOCK_HASH],
 	headSaver HeadSaver[HTH, BLOCK_HASH],
 	mailMon *mailbox.Monitor,
+	finalityProvider FinalityProvider[HTH, BLOCK_HASH],
 	getNilHead func() HTH,
 ) HeadTracker[HTH, BLOCK_HASH] {
 	ht := &headTracker[HTH, S, ID, BLOCK_HASH]{
@@ -90,6 +101,7 @@ func NewHeadTracker[
 		chainID:         client.ConfiguredChainID(),
 		config:          config,
 		htConfig:        htConfig,
+		finality:        finalityProvider,
 		backfillMB:      mailbox.NewSingle[HTH](),
 		broadcastMB:     mailbox.New[HTH](HeadsBufferSize),
 		headSaver:       headSaver,
@@ -366,21 +378,28 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) getHeadAtHeight(ctx context.Conte
 // canonical chain. There is no guaranties that returned block belongs to the canonical chain. Additional verification
 // must be performed before usage.
 func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) calculateLatestFinalized(ctx context.Context, currentHead HTH, finalityTagBypass bool) (HTH, error) {
+	if ht.finality != nil && !finalityTagBypass {
+		finalizedBlockNumber, err := ht.finality.LatestFinalizedBlockNumber(ctx, currentHead)
+		if err != nil {
+			return ht.getNilHead(), fmt.Errorf("failed to get latest finalized block from finality provider: %w", err)
+		}
+		finalizedBlockNumber = ht.capFinalizedBlockNumber(currentHead, finalizedBlockNumber)
+		return ht.getHeadAtHeight(ctx, currentHead.BlockHash(), finalizedBlockNumber)
+	}
 	if ht.config.FinalityTagEnabled() && !finalityTagBypass {
-		latestFinalized, err := ht.client.LatestFinalizedBlock(ctx)
+		latestFinalized, err := ht.latestTaggedBlock(ctx)
 		if err != nil {
-			return latestFinalized, fmt.Errorf("failed to get latest finalized block: %w", err)
+			return latestFinalized, fmt.Errorf("failed to get latest %s block: %w", ht.config.FinalityBlockTag(), err)
 		}
 
 		if !latestFinalized.IsValid() {
-			return latestFinalized, fmt.Errorf("failed to get valid latest finalized block")
+			return latestFinalized, fmt.Errorf("failed to get valid latest %s block", ht.config.FinalityBlockTag())
 		}
 
-		if ht.config.FinalizedBlockOffset() == 0 {
+		finalizedBlockNumber := ht.capFinalizedBlockNumber(currentHead, latestFinalized.BlockNumber())
+		if finalizedBlockNumber == latestFinalized.BlockNumber() {
 			return latestFinalized, nil
 		}
-
-		finalizedBlockNumber := max(latestFinalized.BlockNumber()-int64(ht.config.FinalizedBlockOffset()), 0)
 		return ht.getHeadAtHeight(ctx, latestFinalized.BlockHash(), finalizedBlockNumber)
 	}
 	// no need to make an additional RPC call on chains with instant finality
@@ -394,6 +413,23 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) calculateLatestFinalized(ctx cont
 	return ht.getHeadAtHeight(ctx, currentHead.BlockHash(), finalizedBlockNumber)
 }
 
+// latestTaggedBlock returns the latest block of the configured finality tag
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) latestTaggedBlock(ctx context.Context) (HTH, error) {
+	if ht.config.FinalityBlockTag() == "safe" {
+		return ht.client.LatestSafeBlock(ctx)
+	}
+	return ht.client.LatestFinalizedBlock(commonclient.CtxAddQuorumReadFlag(ctx))
+}
+
+// capFinalizedBlockNumber caps the finalized block number to FinalityDepth blocks behind currentHead in hybrid mode,
+// then shifts it back by FinalizedBlockOffset.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) capFinalizedBlockNumber(currentHead HTH, finalizedBlockNumber int64) int64 {
+	if ht.config.FinalityHybridEnabled() {
+		finalizedBlockNumber = min(finalizedBlockNumber, currentHead.BlockNumber()-int64(ht.config.FinalityDepth()))
+	}
+	return max(finalizedBlockNumber-int64(ht.config.FinalizedBlockOffset()), 0)
+}
+
 // backfill fetches all missing heads up until the latestFinalizedHead
 func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfill(ctx context.Context, head, latestFinalizedHead HTH) (err error) {
 	headBlockNumber := head.BlockNumber()
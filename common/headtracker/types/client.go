@@ -17,4 +17,6 @@ type Client[H types.Head[BLOCK_HASH], S types.Subscription, ID types.ID, BLOCK_H
 	SubscribeNewHead(ctx context.Context, ch chan<- H) (S, error)
 	// LatestFinalizedBlock - returns the latest block that was marked as finalized
 	LatestFinalizedBlock(ctx context.Context) (head H, err error)
+	// LatestSafeBlock - returns the latest block that was marked as safe
+	LatestSafeBlock(ctx context.Context) (head H, err error)
 }
@@ -6,6 +6,10 @@ type Config interface {
 	BlockEmissionIdleWarningThreshold() time.Duration
 	FinalityDepth() uint32
 	FinalityTagEnabled() bool
+	// FinalityBlockTag is the block tag, finalized or safe, returning the latest finalized block if FinalityTagEnabled
+	FinalityBlockTag() string
+	// FinalityHybridEnabled caps the latest finalized block to FinalityDepth blocks behind the latest one
+	FinalityHybridEnabled() bool
 	FinalizedBlockOffset() uint32
 }
 
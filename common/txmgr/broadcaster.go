@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jpillora/backoff"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -39,6 +40,13 @@ const (
 	// maxBroadcastRetries is the number of times a transaction broadcast is retried when the sequence fails to increment on Hedera
 	maxHederaBroadcastRetries = 3
 
+	// maxScheduledTxCandidates is the number of unstarted transactions considered by the Broadcaster, when
+	// picking the next transaction according to the registered TxSchedulers
+	maxScheduledTxCandidates = 100
+
+	// defaultScheduleIdleTimeout is how long the schedule of a subject is kept after its last tx
+	defaultScheduleIdleTimeout = time.Hour
+
 	// hederaChainType is the string representation of the Hedera chain type
 	// Temporary solution until the Broadcaster is moved to the EVM code base
 	hederaChainType = "hedera"
@@ -144,6 +152,19 @@ type Broadcaster[
 	// Each key has its own trigger
 	triggers map[ADDR]chan struct{}
 
+	// schedulers holds the most recently used TxScheduler of each subject, until it has been idle for
+	// scheduleIdleTimeout
+	schedulersMu        sync.Mutex
+	schedulers          map[uuid.UUID]*subjectSchedule
+	scheduleIdleTimeout time.Duration
+
+	// budget, if set and deferring, holds back the unstarted txes of keys and jobs which exhausted their budget
+	budget txmgrtypes.TxBudget[ADDR]
+
+	// gapFills holds the pending sequence gap fill of each address, see fillSequenceGaps
+	gapFillsMu sync.Mutex
+	gapFills   map[ADDR]*gapFillRequest[SEQ]
+
 	chStop services.StopChan
 	wg     sync.WaitGroup
 
@@ -190,9 +211,11 @@ func NewBroadcaster[
 		checkerFactory:   checkerFactory,
 		autoSyncSequence: autoSyncSequence,
 		sequenceTracker:  sequenceTracker,
+		schedulers:       make(map[uuid.UUID]*subjectSchedule),
 	}
 
 	b.processUnstartedTxsImpl = b.processUnstartedTxs
+	b.scheduleIdleTimeout = defaultScheduleIdleTimeout
 	return b
 }
 
@@ -379,6 +402,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 		return retryable, fmt.Errorf("processUnstartedTxs failed on handleAnyInProgressTx: %w", err)
 	}
 	for {
+		// gap fills are not throttled, since the transactions in flight are stuck behind the gaps
+		if err, retryable := eb.handleGapFill(ctx, fromAddress); err != nil {
+			return retryable, fmt.Errorf("processUnstartedTxs failed on handleGapFill: %w", err)
+		}
 		maxInFlightTransactions := eb.txConfig.MaxInFlight()
 		if maxInFlightTransactions > 0 {
 			nUnconfirmed, err := eb.txStore.CountUnconfirmedTransactions(ctx, fromAddress, eb.chainID)
@@ -439,6 +466,9 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	if errors.Is(err, commonfee.ErrFeeLimitTooLow) {
 		etx.Error = null.StringFrom(commonfee.ErrFeeLimitTooLow.Error())
 		return eb.saveFatallyErroredTransaction(eb.lggr, etx), false
+	} else if errors.Is(err, txmgrtypes.ErrPermanentAttempt) {
+		etx.Error = null.StringFrom(err.Error())
+		return eb.saveFatallyErroredTransaction(eb.lggr, etx), false
 	} else if err != nil {
 		return fmt.Errorf("processUnstartedTxs failed on NewAttempt: %w", err), retryable
 	}
@@ -447,6 +477,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	if err != nil {
 		return fmt.Errorf("parsing transmit checker: %w", err), false
 	}
+	if checkerSpec.Simulate == nil {
+		simulate := eb.txConfig.SimulateBeforeBroadcast()
+		checkerSpec.Simulate = &simulate
+	}
 
 	checker, err := eb.checkerFactory.BuildChecker(checkerSpec)
 	if err != nil {
@@ -556,6 +590,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		}
 		// Increment sequence if successfully broadcasted
 		eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
+		eb.markBroadcast(etx, time.Now())
 		return err, true
 	case client.Underpriced:
 		bumpedAttempt, retryable, replaceErr := eb.replaceAttemptWithBumpedGas(ctx, lgr, err, etx, attempt)
@@ -620,6 +655,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 			}
 			// Increment sequence if successfully broadcasted
 			eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
+			eb.markBroadcast(etx, time.Now())
 			return err, true
 		}
 		// Either the unknown error prevented the transaction from being mined, or
@@ -675,7 +711,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) vali
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) nextUnstartedTransactionWithSequence(fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ctx, cancel := eb.chStop.NewCtx()
 	defer cancel()
-	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+	etx, err := eb.findNextUnstartedTransaction(ctx, fromAddress)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// Finish. No more transactions left to process. Hoorah!
@@ -692,6 +728,132 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) next
 	return etx, nil
 }
 
+// findNextUnstartedTransaction returns the next unstarted transaction to broadcast, i.e. the earliest one with the
+// highest priority. If any TxSchedulers are registered, transactions of rate limited subjects are skipped, and so are
+// transactions exceeding their TxBudget if it defers them.
+// Returns sql.ErrNoRows if there is no transaction to broadcast.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) findNextUnstartedTransaction(ctx context.Context, fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	eb.schedulersMu.Lock()
+	eb.pruneIdleSchedules(time.Now())
+	scheduled := len(eb.schedulers) > 0 || eb.deferExhausted()
+	eb.schedulersMu.Unlock()
+	if !scheduled {
+		return eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+	}
+
+	etxs, err := eb.txStore.FindUnstartedTransactionsFromAddress(ctx, fromAddress, eb.chainID, maxScheduledTxCandidates)
+	if err != nil {
+		return nil, err
+	}
+	etx := eb.scheduleUnstartedTransaction(etxs, time.Now())
+	if etx == nil {
+		return nil, sql.ErrNoRows
+	}
+	return etx, nil
+}
+
+// scheduleUnstartedTransaction picks the first transaction, skipping transactions of rate limited subjects and deferred
+// transactions exceeding their budget. The transactions are expected to be ordered by priority already.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) scheduleUnstartedTransaction(etxs []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], now time.Time) *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	eb.schedulersMu.Lock()
+	defer eb.schedulersMu.Unlock()
+
+	for _, etx := range etxs {
+		if eb.deferExhausted() {
+			if err := eb.checkBudget(etx); err != nil {
+				eb.lggr.Debugw("Deferring transaction exceeding its budget", "txID", etx.ID, "err", err)
+				continue
+			}
+		}
+		if !etx.Subject.Valid {
+			return etx
+		}
+		schedule, ok := eb.schedulers[etx.Subject.UUID]
+		if !ok {
+			return etx
+		}
+		if now.Sub(schedule.lastBroadcast) < schedule.MinInterval() {
+			continue
+		}
+		return etx
+	}
+	return nil
+}
+
+// markBroadcast starts the interval of the tx's subject, once the tx was successfully sent. Txes which failed to be
+// sent don't hold back the next tx of their subject.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) markBroadcast(etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], now time.Time) {
+	if !etx.Subject.Valid {
+		return
+	}
+	eb.schedulersMu.Lock()
+	defer eb.schedulersMu.Unlock()
+	if schedule, ok := eb.schedulers[etx.Subject.UUID]; ok {
+		schedule.lastBroadcast = now
+		schedule.lastUsed = now
+	}
+}
+
+// pruneIdleSchedules evicts the schedules which neither were registered nor broadcast a tx for scheduleIdleTimeout,
+// and no longer hold back their subject. Subjects are registered again with their next tx.
+// It must be called with schedulersMu held.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) pruneIdleSchedules(now time.Time) {
+	for subject, schedule := range eb.schedulers {
+		idle := now.Sub(schedule.lastUsed)
+		if idle > eb.scheduleIdleTimeout && idle >= schedule.MinInterval() {
+			delete(eb.schedulers, subject)
+		}
+	}
+}
+
+// RegisterTxScheduler makes the Broadcaster honor the rate limit of the scheduler's subject.
+// The most recently registered scheduler of a subject wins.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterTxScheduler(scheduler txmgrtypes.TxScheduler) {
+	subject := scheduler.Subject()
+	if !subject.Valid {
+		return
+	}
+	eb.schedulersMu.Lock()
+	defer eb.schedulersMu.Unlock()
+	if schedule, ok := eb.schedulers[subject.UUID]; ok {
+		schedule.TxScheduler = scheduler
+		schedule.lastUsed = time.Now()
+		return
+	}
+	eb.schedulers[subject.UUID] = &subjectSchedule{TxScheduler: scheduler, lastUsed: time.Now()}
+}
+
+// SetTxBudget makes the Broadcaster defer the txes exceeding the budget, if the budget defers them.
+// It must be called before the Broadcaster is started.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetTxBudget(budget txmgrtypes.TxBudget[ADDR]) {
+	eb.budget = budget
+}
+
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) deferExhausted() bool {
+	return eb.budget != nil && eb.budget.DeferExhausted()
+}
+
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) checkBudget(etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	meta, err := etx.GetMeta()
+	if err != nil {
+		// the tx can't be attributed to a job, only the budget of its key applies
+		eb.lggr.Errorw("Failed to parse transaction meta", "txID", etx.ID, "err", err)
+	}
+	var jobID *int32
+	if meta != nil {
+		jobID = meta.JobID
+	}
+	return eb.budget.CheckBudget(etx.FromAddress, jobID)
+}
+
+type subjectSchedule struct {
+	txmgrtypes.TxScheduler
+	// lastBroadcast is when the last tx of the subject was successfully sent
+	lastBroadcast time.Time
+	// lastUsed is when the subject last registered its scheduler or broadcast a tx
+	lastUsed time.Time
+}
+
 // replaceAttemptWithBumpedGas performs the replacement of the existing tx attempt with a new bumped fee attempt.
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) replaceAttemptWithBumpedGas(ctx context.Context, lgr logger.Logger, txError error, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (replacedAttempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], retryable bool, err error) {
 	// This log error is not applicable to Hedera since the action required would not be needed for its gas estimator
@@ -647,6 +647,16 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) get
 	return ec.client.SequenceAt(ctx, from, nil)
 }
 
+// revertReasoner is implemented by RPC errors, which can decode the reason of a revert
+type revertReasoner interface {
+	RevertReason() string
+}
+
+// decodedRevertReasoner is implemented by receipts, which can decode the revert reason provided by the RPC
+type decodedRevertReasoner interface {
+	GetDecodedRevertReason() *string
+}
+
 // Note this function will increment promRevertedTxCount upon receiving
 // a reverted transaction receipt. Should only be called with unconfirmed attempts.
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) batchFetchReceipts(ctx context.Context, attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockNum int64) (receipts []R, err error) {
@@ -711,16 +721,30 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bat
 		}
 
 		if receipt.GetStatus() == 0 {
+			var revertReason string
 			if receipt.GetRevertReason() != nil {
-				l.Warnw("transaction reverted on-chain", "hash", receipt.GetTxHash(), "revertReason", *receipt.GetRevertReason())
+				revertReason = *receipt.GetRevertReason()
+				if r, ok := any(receipt).(decodedRevertReasoner); ok && r.GetDecodedRevertReason() != nil {
+					revertReason = *r.GetDecodedRevertReason()
+				}
+				l.Warnw("transaction reverted on-chain", "hash", receipt.GetTxHash(), "revertReason", revertReason)
 			} else {
 				rpcError, errExtract := ec.client.CallContract(ctx, attempt, receipt.GetBlockNumber())
 				if errExtract == nil {
-					l.Warnw("transaction reverted on-chain", "hash", receipt.GetTxHash(), "rpcError", rpcError.String())
+					revertReason = rpcError.String()
+					if r, ok := rpcError.(revertReasoner); ok {
+						revertReason = r.RevertReason()
+					}
+					l.Warnw("transaction reverted on-chain", "hash", receipt.GetTxHash(), "rpcError", rpcError.String(), "revertReason", revertReason)
 				} else {
 					l.Warnw("transaction reverted on-chain unable to extract revert reason", "hash", receipt.GetTxHash(), "err", err)
 				}
 			}
+			if revertReason != "" {
+				if errSave := ec.txStore.UpdateTxRevertReason(ctx, attempt.TxID, revertReason); errSave != nil {
+					l.Errorw("Failed to save revert reason", "err", errSave)
+				}
+			}
 			// This might increment more than once e.g. in case of re-orgs going back and forth we might re-fetch the same receipt
 			promRevertedTxCount.WithLabelValues(ec.chainID.String()).Add(1)
 		} else {
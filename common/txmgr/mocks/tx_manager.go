@@ -925,6 +925,66 @@ func (_c *TxManager_RegisterResumeCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, B
 	return _c
 }
 
+// RepairSequenceGaps provides a mock function with given fields: ctx, addr, mode, gasLimit, dryRun
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RepairSequenceGaps(ctx context.Context, addr ADDR, mode txmgr.SequenceRepairMode, gasLimit uint64, dryRun bool) (txmgr.SequenceGapReport[ADDR, SEQ], error) {
+	ret := _m.Called(ctx, addr, mode, gasLimit, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepairSequenceGaps")
+	}
+
+	var r0 txmgr.SequenceGapReport[ADDR, SEQ]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, txmgr.SequenceRepairMode, uint64, bool) (txmgr.SequenceGapReport[ADDR, SEQ], error)); ok {
+		return rf(ctx, addr, mode, gasLimit, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, txmgr.SequenceRepairMode, uint64, bool) txmgr.SequenceGapReport[ADDR, SEQ]); ok {
+		r0 = rf(ctx, addr, mode, gasLimit, dryRun)
+	} else {
+		r0 = ret.Get(0).(txmgr.SequenceGapReport[ADDR, SEQ])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, txmgr.SequenceRepairMode, uint64, bool) error); ok {
+		r1 = rf(ctx, addr, mode, gasLimit, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_RepairSequenceGaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairSequenceGaps'
+type TxManager_RepairSequenceGaps_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// RepairSequenceGaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addr ADDR
+//   - mode txmgr.SequenceRepairMode
+//   - gasLimit uint64
+//   - dryRun bool
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RepairSequenceGaps(ctx interface{}, addr interface{}, mode interface{}, gasLimit interface{}, dryRun interface{}) *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("RepairSequenceGaps", ctx, addr, mode, gasLimit, dryRun)}
+}
+
+func (_c *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, addr ADDR, mode txmgr.SequenceRepairMode, gasLimit uint64, dryRun bool)) *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(txmgr.SequenceRepairMode), args[3].(uint64), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 txmgr.SequenceGapReport[ADDR, SEQ], _a1 error) *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, txmgr.SequenceRepairMode, uint64, bool) (txmgr.SequenceGapReport[ADDR, SEQ], error)) *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reset provides a mock function with given fields: addr, abandon
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Reset(addr ADDR, abandon bool) error {
 	ret := _m.Called(addr, abandon)
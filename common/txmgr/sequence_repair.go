@@ -0,0 +1,268 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// SequenceRepairMode selects how RepairSequenceGaps closes the sequence gaps of an address.
+type SequenceRepairMode string
+
+const (
+	// SequenceRepairFill sends a zero-value transaction from the address to itself for every gap.
+	SequenceRepairFill SequenceRepairMode = "fill"
+	// SequenceRepairRewind moves the in-flight transactions above the first gap, which were never broadcast, back to
+	// unstarted, so that the Broadcaster sends them again with new sequences. Broadcast transactions keep their
+	// sequences, as they might still be mined, so the gaps left below them are filled.
+	SequenceRepairRewind SequenceRepairMode = "rewind"
+)
+
+// maxSequenceGapFills limits the number of transactions sent by a single fill repair.
+const maxSequenceGapFills = 100
+
+// ParseSequenceRepairMode parses a SequenceRepairMode, defaulting to SequenceRepairFill if s is empty.
+func ParseSequenceRepairMode(s string) (SequenceRepairMode, error) {
+	switch mode := SequenceRepairMode(s); mode {
+	case "":
+		return SequenceRepairFill, nil
+	case SequenceRepairFill, SequenceRepairRewind:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid sequence repair mode %q, expected %q or %q", s, SequenceRepairFill, SequenceRepairRewind)
+	}
+}
+
+// SequenceGapReport describes the divergence between the on-chain and the local sequences of an address, and how
+// it was repaired.
+type SequenceGapReport[ADDR types.Hashable, SEQ types.Sequence] struct {
+	Address ADDR
+	// OnChainSequence is the sequence of the next transaction to be mined.
+	OnChainSequence SEQ
+	// LatestSequence is the highest sequence used by a local transaction, or nil if there is none.
+	LatestSequence *SEQ
+	// Gaps are the sequences from OnChainSequence up to LatestSequence, which are not used by any local transaction.
+	// Local transactions above a gap can not be mined until it is closed.
+	Gaps   []SEQ
+	Mode   SequenceRepairMode
+	DryRun bool
+	// FilledTxIDs are the IDs of the transactions sent to fill gaps.
+	FilledTxIDs []int64
+	// RewoundTxIDs are the IDs of the transactions moved back to unstarted.
+	RewoundTxIDs []int64
+}
+
+// RepairSequenceGaps finds the sequences of the address, which are neither mined nor used by any local transaction,
+// but block later transactions. Unless dryRun is set, the gaps are closed according to mode. Rewinding runs while the
+// Broadcaster and Confirmer are stopped, whereas the gaps are filled by the running Broadcaster, using the given gas
+// limit.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RepairSequenceGaps(ctx context.Context, addr ADDR, mode SequenceRepairMode, gasLimit uint64, dryRun bool) (report SequenceGapReport[ADDR, SEQ], err error) {
+	if mode, err = ParseSequenceRepairMode(string(mode)); err != nil {
+		return report, err
+	}
+	if err = b.checkEnabled(ctx, addr); err != nil {
+		return report, err
+	}
+	if dryRun {
+		return b.findSequenceGaps(ctx, addr, mode, dryRun)
+	}
+
+	ok := b.IfStarted(func() {
+		var gaps []SEQ
+		if mode == SequenceRepairRewind {
+			done := make(chan error)
+			f := func() {
+				report, gaps, err = b.rewindSequenceGaps(addr)
+			}
+
+			b.reset <- reset{f, done}
+			if resetErr := <-done; resetErr != nil {
+				err = resetErr
+			}
+		} else {
+			report, err = b.findSequenceGaps(ctx, addr, mode, false)
+			gaps = report.Gaps
+		}
+		if err != nil || len(gaps) == 0 {
+			return
+		}
+
+		if len(gaps) > maxSequenceGapFills {
+			err = fmt.Errorf("found %d sequence gaps, but at most %d can be filled at once", len(gaps), maxSequenceGapFills)
+			return
+		}
+		report.FilledTxIDs, err = b.broadcaster.fillSequenceGaps(ctx, addr, gaps, gasLimit)
+		if len(report.FilledTxIDs) > 0 {
+			b.logger.Warnw("Filled sequence gaps", "address", addr, "gaps", gaps, "txIDs", report.FilledTxIDs)
+		}
+	})
+	if !ok {
+		return report, errors.New("not started")
+	}
+	return report, err
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) findSequenceGaps(ctx context.Context, addr ADDR, mode SequenceRepairMode, dryRun bool) (report SequenceGapReport[ADDR, SEQ], err error) {
+	report = SequenceGapReport[ADDR, SEQ]{Address: addr, Mode: mode, DryRun: dryRun}
+
+	report.OnChainSequence, err = b.broadcaster.client.SequenceAt(ctx, addr, nil)
+	if err != nil {
+		return report, fmt.Errorf("failed to get on-chain sequence for address %s: %w", addr, err)
+	}
+	latest, err := b.txStore.FindLatestSequence(ctx, addr, b.chainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return report, nil
+	} else if err != nil {
+		return report, fmt.Errorf("failed to find latest sequence for address %s: %w", addr, err)
+	}
+	report.LatestSequence = &latest
+	report.Gaps, err = b.txStore.FindSequenceGaps(ctx, addr, b.chainID, report.OnChainSequence)
+	if err != nil {
+		return report, fmt.Errorf("failed to find sequence gaps for address %s: %w", addr, err)
+	}
+	return report, nil
+}
+
+// rewindSequenceGaps must not be run while Broadcaster or Confirmer are running. It returns the gaps left below
+// broadcast transactions, which must be filled.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) rewindSequenceGaps(addr ADDR) (report SequenceGapReport[ADDR, SEQ], gaps []SEQ, err error) {
+	ctx, cancel := b.chStop.NewCtx()
+	defer cancel()
+
+	report, err = b.findSequenceGaps(ctx, addr, SequenceRepairRewind, false)
+	if err != nil || len(report.Gaps) == 0 {
+		return report, nil, err
+	}
+
+	report.RewoundTxIDs, err = b.txStore.RewindSequences(ctx, addr, b.chainID, report.Gaps[0])
+	if err != nil {
+		return report, nil, fmt.Errorf("failed to rewind transactions from sequence %s: %w", report.Gaps[0], err)
+	}
+	b.logger.Warnw("Rewound unsent transactions above sequence gap", "address", addr, "gaps", report.Gaps, "onChainSequence", report.OnChainSequence, "txIDs", report.RewoundTxIDs)
+	// the sequences of the rewound transactions are free now, those below broadcast transactions must be filled
+	gaps, err = b.txStore.FindSequenceGaps(ctx, addr, b.chainID, report.OnChainSequence)
+	if err != nil {
+		return report, nil, fmt.Errorf("failed to find sequence gaps for address %s: %w", addr, err)
+	}
+	return report, gaps, nil
+}
+
+// gapFillRequest asks the Broadcaster to fill the sequence gaps of an address.
+type gapFillRequest[SEQ types.Sequence] struct {
+	seqs     []SEQ
+	gasLimit uint64
+	// txIDs and err are set before done is closed
+	txIDs []int64
+	err   error
+	done  chan struct{}
+}
+
+// fillSequenceGaps has the monitor of the address fill the given sequence gaps, so that sending the filling
+// transactions is serialized with the other transactions of the address. It waits until the gaps are filled, or ctx is
+// done before the fill started.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) fillSequenceGaps(ctx context.Context, addr ADDR, seqs []SEQ, gasLimit uint64) ([]int64, error) {
+	req := &gapFillRequest[SEQ]{seqs: seqs, gasLimit: gasLimit, done: make(chan struct{})}
+	eb.gapFillsMu.Lock()
+	if eb.gapFills == nil {
+		eb.gapFills = make(map[ADDR]*gapFillRequest[SEQ])
+	}
+	if _, exists := eb.gapFills[addr]; exists {
+		eb.gapFillsMu.Unlock()
+		return nil, fmt.Errorf("sequence gaps of address %s are already being filled", addr)
+	}
+	eb.gapFills[addr] = req
+	eb.gapFillsMu.Unlock()
+	eb.Trigger(addr)
+
+	select {
+	case <-req.done:
+	case <-ctx.Done():
+		eb.gapFillsMu.Lock()
+		pending := eb.gapFills[addr] == req
+		if pending {
+			delete(eb.gapFills, addr)
+		}
+		eb.gapFillsMu.Unlock()
+		if pending {
+			return nil, context.Cause(ctx)
+		}
+		// the fill has started already and is not interrupted midway
+		<-req.done
+	}
+	return req.txIDs, req.err
+}
+
+// handleGapFill fills the sequence gaps requested for the address, if any. It must only be called by the monitor of
+// the address, while there is no transaction in progress.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleGapFill(ctx context.Context, addr ADDR) (error, bool) {
+	eb.gapFillsMu.Lock()
+	req, exists := eb.gapFills[addr]
+	delete(eb.gapFills, addr)
+	eb.gapFillsMu.Unlock()
+	if !exists {
+		return nil, false
+	}
+	defer close(req.done)
+
+	for _, seq := range req.seqs {
+		txID, retryable, err := eb.fillSequenceGap(ctx, addr, seq, req.gasLimit)
+		if txID != 0 {
+			req.txIDs = append(req.txIDs, txID)
+		}
+		if err != nil {
+			req.err = fmt.Errorf("failed to fill sequence gap %s: %w", seq, err)
+			if retryable {
+				// the transaction stays in progress and is retried before any other
+				return req.err, true
+			}
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// fillSequenceGap sends a zero-value transaction from the address to itself with the given sequence. If sending fails
+// with a retryable error, the transaction stays in progress and is retried by handleAnyInProgressTx.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) fillSequenceGap(ctx context.Context, addr ADDR, seq SEQ, gasLimit uint64) (txID int64, retryable bool, err error) {
+	etx, err := eb.txStore.CreateTransaction(ctx, txmgrtypes.TxRequest[ADDR, TX_HASH]{
+		FromAddress:    addr,
+		ToAddress:      addr,
+		EncodedPayload: []byte{},
+		FeeLimit:       gasLimit,
+		Strategy:       NewSendEveryStrategy(),
+		Priority:       txmgrtypes.TxPriorityHigh,
+	}, eb.chainID)
+	if err != nil {
+		return 0, false, err
+	}
+	etx.Sequence = &seq
+
+	attempt, _, _, _, err := eb.NewTxAttempt(ctx, etx, eb.lggr)
+	if err == nil {
+		err = eb.txStore.UpdateTxUnstartedToInProgress(ctx, &etx, &attempt)
+	}
+	if err != nil {
+		// never leave the transaction unstarted, it would be sent with a new sequence
+		etx.Error = null.StringFrom(fmt.Sprintf("failed to fill sequence gap: %v", err))
+		return etx.ID, false, errors.Join(err, eb.txStore.UpdateTxFatalError(ctx, &etx))
+	}
+	if err, retryable = eb.handleInProgressTx(ctx, etx, attempt, time.Now(), 0); err != nil {
+		return etx.ID, retryable, err
+	}
+
+	tx, err := eb.txStore.GetTxByID(ctx, etx.ID)
+	if err != nil {
+		return etx.ID, false, err
+	}
+	if tx != nil && tx.State == TxFatalError {
+		return etx.ID, false, fmt.Errorf("transaction %d errored: %s", etx.ID, tx.Error.String)
+	}
+	return etx.ID, false, nil
+}
@@ -3,9 +3,13 @@ package txmgr
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 )
 
@@ -62,3 +66,128 @@ func (s DropOldestStrategy) PruneQueue(ctx context.Context, pruneService txmgrty
 	}
 	return
 }
+
+// Names of the built-in TxStrategies, which can be selected by TxStrategyConfig.Name
+const (
+	// TxStrategySendEvery sends every tx
+	TxStrategySendEvery = "send-every"
+	// TxStrategyDropOldest keeps the newest QueueSize unstarted txes of the subject, or all of them if QueueSize is 0
+	TxStrategyDropOldest = "drop-oldest"
+	// TxStrategyLatestOnly replaces any unstarted tx of the subject with the newest one
+	TxStrategyLatestOnly = "replace-by-subject-latest-only"
+	// TxStrategyPriorityQueue broadcasts the unstarted txes of subjects with a higher Priority first
+	TxStrategyPriorityQueue = "priority-queue"
+	// TxStrategyRateLimited broadcasts at most one tx of the subject per MinInterval
+	TxStrategyRateLimited = "rate-limited"
+)
+
+// TxStrategyConfig selects a registered TxStrategy by name. Strategies ignore the options they do not use.
+type TxStrategyConfig struct {
+	Name string `json:"name"`
+	// QueueSize is the maximum number of unstarted txes kept for the subject. 0 means unlimited.
+	QueueSize uint32 `json:"queueSize"`
+	// Priority is used by the priority-queue strategy
+	Priority int32 `json:"priority"`
+	// MinInterval is used by the rate-limited strategy
+	MinInterval commonconfig.Duration `json:"minInterval"`
+}
+
+// TxStrategyFactory creates a TxStrategy for the given subject, which is usually the external job ID.
+type TxStrategyFactory func(subject uuid.UUID, cfg TxStrategyConfig) (txmgrtypes.TxStrategy, error)
+
+var (
+	txStrategiesMu sync.RWMutex
+	txStrategies   = map[string]TxStrategyFactory{
+		TxStrategySendEvery: func(uuid.UUID, TxStrategyConfig) (txmgrtypes.TxStrategy, error) {
+			return NewSendEveryStrategy(), nil
+		},
+		TxStrategyDropOldest: func(subject uuid.UUID, cfg TxStrategyConfig) (txmgrtypes.TxStrategy, error) {
+			if cfg.QueueSize > 0 {
+				if err := requireSubject(TxStrategyDropOldest, subject); err != nil {
+					return nil, err
+				}
+			}
+			return NewQueueingTxStrategy(subject, cfg.QueueSize), nil
+		},
+		TxStrategyLatestOnly: func(subject uuid.UUID, _ TxStrategyConfig) (txmgrtypes.TxStrategy, error) {
+			if err := requireSubject(TxStrategyLatestOnly, subject); err != nil {
+				return nil, err
+			}
+			return NewDropOldestStrategy(subject, 1), nil
+		},
+		TxStrategyPriorityQueue: func(subject uuid.UUID, cfg TxStrategyConfig) (txmgrtypes.TxStrategy, error) {
+			if err := requireSubject(TxStrategyPriorityQueue, subject); err != nil {
+				return nil, err
+			}
+			return NewSchedulingStrategy(subject, cfg.QueueSize, cfg.Priority, 0), nil
+		},
+		TxStrategyRateLimited: func(subject uuid.UUID, cfg TxStrategyConfig) (txmgrtypes.TxStrategy, error) {
+			if err := requireSubject(TxStrategyRateLimited, subject); err != nil {
+				return nil, err
+			}
+			if cfg.MinInterval.Duration() <= 0 {
+				return nil, fmt.Errorf("%s strategy requires a positive minInterval", TxStrategyRateLimited)
+			}
+			return NewSchedulingStrategy(subject, cfg.QueueSize, 0, cfg.MinInterval.Duration()), nil
+		},
+	}
+)
+
+// RegisterTxStrategy makes a custom TxStrategy available under the given name.
+// It returns an error if the name is already taken.
+func RegisterTxStrategy(name string, factory TxStrategyFactory) error {
+	txStrategiesMu.Lock()
+	defer txStrategiesMu.Unlock()
+	if _, ok := txStrategies[name]; ok {
+		return fmt.Errorf("tx strategy %q is already registered", name)
+	}
+	txStrategies[name] = factory
+	return nil
+}
+
+// NewTxStrategy creates the TxStrategy registered under cfg.Name for the given subject.
+func NewTxStrategy(subject uuid.UUID, cfg TxStrategyConfig) (txmgrtypes.TxStrategy, error) {
+	txStrategiesMu.RLock()
+	factory, ok := txStrategies[cfg.Name]
+	txStrategiesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown tx strategy %q", cfg.Name)
+	}
+	return factory(subject, cfg)
+}
+
+func requireSubject(name string, subject uuid.UUID) error {
+	if subject == uuid.Nil {
+		return fmt.Errorf("%s strategy requires a subject", name)
+	}
+	return nil
+}
+
+var _ txmgrtypes.TxScheduler = SchedulingStrategy{}
+
+// SchedulingStrategy queues txes like NewQueueingTxStrategy and additionally controls the order and rate
+// in which the Broadcaster picks up the unstarted txes of its subject.
+type SchedulingStrategy struct {
+	txmgrtypes.TxStrategy
+	subject     uuid.UUID
+	priority    int32
+	minInterval time.Duration
+}
+
+// NewSchedulingStrategy creates a new TxScheduler for the given subject.
+func NewSchedulingStrategy(subject uuid.UUID, queueSize uint32, priority int32, minInterval time.Duration) SchedulingStrategy {
+	return SchedulingStrategy{
+		TxStrategy:  NewQueueingTxStrategy(subject, queueSize),
+		subject:     subject,
+		priority:    priority,
+		minInterval: minInterval,
+	}
+}
+
+func (s SchedulingStrategy) Subject() uuid.NullUUID {
+	return uuid.NullUUID{UUID: s.subject, Valid: true}
+}
+
+func (s SchedulingStrategy) Priority() int32 { return s.priority }
+
+func (s SchedulingStrategy) MinInterval() time.Duration { return s.minInterval }
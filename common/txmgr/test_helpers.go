@@ -53,3 +53,13 @@ func (er *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) XXXTestRes
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) XXXTestAbandon(addr ADDR) (err error) {
 	return b.abandon(addr)
 }
+
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) XXXTestSetScheduleIdleTimeout(timeout time.Duration) {
+	eb.scheduleIdleTimeout = timeout
+}
+
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) XXXTestScheduledSubjects() int {
+	eb.schedulersMu.Lock()
+	defer eb.schedulersMu.Unlock()
+	return len(eb.schedulers)
+}
@@ -51,6 +51,8 @@ type TxManager[
 	RegisterResumeCallback(fn ResumeCallback)
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
+	// RepairSequenceGaps finds and, unless dryRun is set, closes the sequence gaps blocking the transactions of the address
+	RepairSequenceGaps(ctx context.Context, addr ADDR, mode SequenceRepairMode, gasLimit uint64, dryRun bool) (SequenceGapReport[ADDR, SEQ], error)
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -112,6 +114,7 @@ type Txm[
 	fwdMgr             txmgrtypes.ForwarderManager[ADDR]
 	txAttemptBuilder   txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	newErrorClassifier NewErrorClassifier
+	budget             txmgrtypes.TxBudget[ADDR]
 }
 
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterResumeCallback(fn ResumeCallback) {
@@ -120,6 +123,13 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterRe
 	b.confirmer.SetResumeCallback(fn)
 }
 
+// SetTxBudget enforces the budget on new txes, or defers the broadcast of txes exceeding it.
+// It must be called before the Txm is started.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetTxBudget(budget txmgrtypes.TxBudget[ADDR]) {
+	b.budget = budget
+	b.broadcaster.SetTxBudget(budget)
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm[
 	CHAIN_ID types.ID,
@@ -187,6 +197,11 @@ func NewTxm[
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Start(ctx context.Context) (merr error) {
 	return b.StartOnce("Txm", func() error {
 		var ms services.MultiStart
+		if b.budget != nil {
+			if err := ms.Start(ctx, b.budget); err != nil {
+				return fmt.Errorf("Txm: TxBudget failed to start: %w", err)
+			}
+		}
 		if err := ms.Start(ctx, b.broadcaster); err != nil {
 			return fmt.Errorf("Txm: Broadcaster failed to start: %w", err)
 		}
@@ -283,6 +298,11 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (m
 		if err := b.txAttemptBuilder.Close(); err != nil {
 			merr = errors.Join(merr, fmt.Errorf("Txm: failed to close TxAttemptBuilder: %w", err))
 		}
+		if b.budget != nil {
+			if err := b.budget.Close(); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("Txm: failed to close TxBudget: %w", err))
+			}
+		}
 
 		return nil
 	})
@@ -301,6 +321,9 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealthRepo
 		services.CopyHealth(report, b.confirmer.HealthReport())
 		services.CopyHealth(report, b.txAttemptBuilder.HealthReport())
 		services.CopyHealth(report, b.finalizer.HealthReport())
+		if b.budget != nil {
+			services.CopyHealth(report, b.budget.HealthReport())
+		}
 	})
 
 	if b.txConfig.ForwardersEnabled() {
@@ -528,6 +551,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		return tx, err
 	}
 
+	if err = b.checkBudget(txRequest); err != nil {
+		return tx, err
+	}
+
 	if b.txConfig.ForwardersEnabled() && (!utils.IsZero(txRequest.ForwarderAddress)) {
 		fwdPayload, fwdErr := b.fwdMgr.ConvertPayload(txRequest.ToAddress, txRequest.EncodedPayload)
 		if fwdErr == nil {
@@ -587,6 +614,21 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) checkEnabl
 	return nil
 }
 
+// checkBudget rejects the request if its key or job exhausted its budget, unless exhausted txes are deferred
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) checkBudget(txRequest txmgrtypes.TxRequest[ADDR, TX_HASH]) error {
+	if b.budget == nil || b.budget.DeferExhausted() {
+		return nil
+	}
+	var jobID *int32
+	if txRequest.Meta != nil {
+		jobID = txRequest.Meta.JobID
+	}
+	if err := b.budget.CheckBudget(txRequest.FromAddress, jobID); err != nil {
+		return fmt.Errorf("cannot send transaction from %s on chain ID %s: %w", txRequest.FromAddress, b.chainID.String(), err)
+	}
+	return nil
+}
+
 // SendNativeToken creates a transaction that transfers the given value of native tokens
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	if utils.IsZero(to) {
@@ -599,6 +641,7 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SendNative
 		Value:          value,
 		FeeLimit:       gasLimit,
 		Strategy:       NewSendEveryStrategy(),
+		Priority:       txmgrtypes.TxPriorityLow,
 	}
 	etx, err = b.pruneQueueAndCreateTxn(ctx, txRequest, chainID)
 	if err != nil {
@@ -717,6 +760,11 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Res
 	return nil
 }
 
+// RepairSequenceGaps does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RepairSequenceGaps(ctx context.Context, addr ADDR, mode SequenceRepairMode, gasLimit uint64, dryRun bool) (report SequenceGapReport[ADDR, SEQ], err error) {
+	return report, errors.New(n.ErrMsg)
+}
+
 // SendNativeToken does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return etx, errors.New(n.ErrMsg)
@@ -773,6 +821,13 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueue
 	b.pruneQueueAndCreateLock.Lock()
 	defer b.pruneQueueAndCreateLock.Unlock()
 
+	if scheduler, ok := txRequest.Strategy.(txmgrtypes.TxScheduler); ok {
+		b.broadcaster.RegisterTxScheduler(scheduler)
+		if txRequest.Priority == txmgrtypes.TxPriorityDefault {
+			txRequest.Priority = scheduler.Priority()
+		}
+	}
+
 	pruned, err := txRequest.Strategy.PruneQueue(ctx, b.txStore)
 	if err != nil {
 		return tx, err
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// ErrTxBudgetExhausted is returned for txes of a key or job which spent its fee budget
+var ErrTxBudgetExhausted = errors.New("tx budget exhausted")
+
+// TxBudget is an optional guardrail capping the cumulative fees spent by keys and jobs
+type TxBudget[ADDR types.Hashable] interface {
+	services.Service
+	// CheckBudget returns an error wrapping ErrTxBudgetExhausted if the key, or the job if not nil, spent its budget
+	CheckBudget(fromAddress ADDR, jobID *int32) error
+	// DeferExhausted returns true if txes exceeding their budget are left unstarted until the budget
+	// replenishes, instead of being rejected on creation.
+	DeferExhausted() bool
+}
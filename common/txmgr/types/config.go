@@ -33,6 +33,7 @@ type BroadcasterFeeConfig interface {
 
 type BroadcasterTransactionsConfig interface {
 	MaxInFlight() uint32
+	SimulateBeforeBroadcast() bool
 }
 
 type BroadcasterListenerConfig interface {
@@ -673,6 +673,67 @@ func (_c *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, T
 	return _c
 }
 
+// FindSequenceGaps provides a mock function with given fields: ctx, fromAddress, chainID, from
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindSequenceGaps(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, from SEQ) ([]SEQ, error) {
+	ret := _m.Called(ctx, fromAddress, chainID, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSequenceGaps")
+	}
+
+	var r0 []SEQ
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID, SEQ) ([]SEQ, error)); ok {
+		return rf(ctx, fromAddress, chainID, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID, SEQ) []SEQ); ok {
+		r0 = rf(ctx, fromAddress, chainID, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]SEQ)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, CHAIN_ID, SEQ) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxStore_FindSequenceGaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSequenceGaps'
+type TxStore_FindSequenceGaps_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// FindSequenceGaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress ADDR
+//   - chainID CHAIN_ID
+//   - from SEQ
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindSequenceGaps(ctx interface{}, fromAddress interface{}, chainID interface{}, from interface{}) *TxStore_FindSequenceGaps_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_FindSequenceGaps_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("FindSequenceGaps", ctx, fromAddress, chainID, from)}
+}
+
+func (_c *TxStore_FindSequenceGaps_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, from SEQ)) *TxStore_FindSequenceGaps_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(CHAIN_ID), args[3].(SEQ))
+	})
+	return _c
+}
+
+func (_c *TxStore_FindSequenceGaps_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(_a0 []SEQ, _a1 error) *TxStore_FindSequenceGaps_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxStore_FindSequenceGaps_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, CHAIN_ID, SEQ) ([]SEQ, error)) *TxStore_FindSequenceGaps_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTransactionsConfirmedInBlockRange provides a mock function with given fields: ctx, highBlockNumber, lowBlockNumber, chainID
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber int64, lowBlockNumber int64, chainID CHAIN_ID) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ret := _m.Called(ctx, highBlockNumber, lowBlockNumber, chainID)
@@ -1462,6 +1523,67 @@ func (_c *TxStore_FindTxsRequiringResubmissionDueToInsufficientFunds_Call[ADDR,
 	return _c
 }
 
+// FindUnstartedTransactionsFromAddress provides a mock function with given fields: ctx, fromAddress, chainID, limit
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindUnstartedTransactionsFromAddress(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, limit uint32) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, fromAddress, chainID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnstartedTransactionsFromAddress")
+	}
+
+	var r0 []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID, uint32) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, fromAddress, chainID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID, uint32) []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, fromAddress, chainID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, CHAIN_ID, uint32) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxStore_FindUnstartedTransactionsFromAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnstartedTransactionsFromAddress'
+type TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// FindUnstartedTransactionsFromAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress ADDR
+//   - chainID CHAIN_ID
+//   - limit uint32
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindUnstartedTransactionsFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}, limit interface{}) *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("FindUnstartedTransactionsFromAddress", ctx, fromAddress, chainID, limit)}
+}
+
+func (_c *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, limit uint32)) *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(CHAIN_ID), args[3].(uint32))
+	})
+	return _c
+}
+
+func (_c *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(_a0 []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], _a1 error) *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, CHAIN_ID, uint32) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxStore_FindUnstartedTransactionsFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAbandonedTransactionsByBatch provides a mock function with given fields: ctx, chainID, enabledAddrs, offset, limit
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetAbandonedTransactionsByBatch(ctx context.Context, chainID CHAIN_ID, enabledAddrs []ADDR, offset uint, limit uint) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ret := _m.Called(ctx, chainID, enabledAddrs, offset, limit)
@@ -2058,6 +2180,67 @@ func (_c *TxStore_ReapTxHistory_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ
 	return _c
 }
 
+// RewindSequences provides a mock function with given fields: ctx, fromAddress, chainID, from
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RewindSequences(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, from SEQ) ([]int64, error) {
+	ret := _m.Called(ctx, fromAddress, chainID, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RewindSequences")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID, SEQ) ([]int64, error)); ok {
+		return rf(ctx, fromAddress, chainID, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID, SEQ) []int64); ok {
+		r0 = rf(ctx, fromAddress, chainID, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, CHAIN_ID, SEQ) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxStore_RewindSequences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RewindSequences'
+type TxStore_RewindSequences_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// RewindSequences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress ADDR
+//   - chainID CHAIN_ID
+//   - from SEQ
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RewindSequences(ctx interface{}, fromAddress interface{}, chainID interface{}, from interface{}) *TxStore_RewindSequences_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_RewindSequences_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("RewindSequences", ctx, fromAddress, chainID, from)}
+}
+
+func (_c *TxStore_RewindSequences_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID, from SEQ)) *TxStore_RewindSequences_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(CHAIN_ID), args[3].(SEQ))
+	})
+	return _c
+}
+
+func (_c *TxStore_RewindSequences_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(_a0 []int64, _a1 error) *TxStore_RewindSequences_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxStore_RewindSequences_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, CHAIN_ID, SEQ) ([]int64, error)) *TxStore_RewindSequences_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveConfirmedMissingReceiptAttempt provides a mock function with given fields: ctx, timeout, attempt, broadcastAt
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], broadcastAt time.Time) error {
 	ret := _m.Called(ctx, timeout, attempt, broadcastAt)
@@ -2638,6 +2821,54 @@ func (_c *TxStore_UpdateTxForRebroadcast_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HAS
 	return _c
 }
 
+// UpdateTxRevertReason provides a mock function with given fields: ctx, id, revertReason
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) UpdateTxRevertReason(ctx context.Context, id int64, revertReason string) error {
+	ret := _m.Called(ctx, id, revertReason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxRevertReason")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, revertReason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxStore_UpdateTxRevertReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxRevertReason'
+type TxStore_UpdateTxRevertReason_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// UpdateTxRevertReason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - revertReason string
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) UpdateTxRevertReason(ctx interface{}, id interface{}, revertReason interface{}) *TxStore_UpdateTxRevertReason_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_UpdateTxRevertReason_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("UpdateTxRevertReason", ctx, id, revertReason)}
+}
+
+func (_c *TxStore_UpdateTxRevertReason_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, id int64, revertReason string)) *TxStore_UpdateTxRevertReason_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TxStore_UpdateTxRevertReason_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(_a0 error) *TxStore_UpdateTxRevertReason_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxStore_UpdateTxRevertReason_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, int64, string) error) *TxStore_UpdateTxRevertReason_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTxUnstartedToInProgress provides a mock function with given fields: ctx, etx, attempt
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) UpdateTxUnstartedToInProgress(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
 	ret := _m.Called(ctx, etx, attempt)
@@ -31,6 +31,17 @@ type TxStrategy interface {
 	PruneQueue(ctx context.Context, pruneService UnstartedTxQueuePruner) (ids []int64, err error)
 }
 
+// TxScheduler is an optional extension of TxStrategy, which controls when and in which order the
+// Broadcaster picks up the unstarted txes of the strategy's subject.
+type TxScheduler interface {
+	TxStrategy
+	// Priority of the subject's txes, used if the TxRequest does not set a priority.
+	// Unstarted txes with a higher priority are broadcast first.
+	Priority() int32
+	// MinInterval is the minimum time between broadcasting two txes of the subject
+	MinInterval() time.Duration
+}
+
 type TxAttemptState int8
 
 type TxState string
@@ -93,8 +104,25 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Priority of the tx. Unstarted txes with a higher priority are broadcast first, see TxPriorityHigh and TxPriorityLow.
+	// If zero, the priority of the Strategy is used, if it is a TxScheduler.
+	Priority int32
+
+	// BlobSidecar is the encoded EIP-4844 blob sidecar of a blob tx, see the EVM txmgr's NewBlobSidecar
+	BlobSidecar []byte
 }
 
+// Common tx priorities. Any other value can be used to fine tune the order between them.
+const (
+	// TxPriorityHigh is used for time critical txes, e.g. OCR transmissions and VRF fulfillments
+	TxPriorityHigh int32 = 100
+	// TxPriorityDefault is used for txes without a priority
+	TxPriorityDefault int32 = 0
+	// TxPriorityLow is used for txes that can wait, e.g. balance transfers and blockhash stores
+	TxPriorityLow int32 = -100
+)
+
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
 // on chain.
 type TransmitCheckerSpec[ADDR types.Hashable] struct {
@@ -108,6 +136,10 @@ type TransmitCheckerSpec[ADDR types.Hashable] struct {
 	// VRFRequestBlockNumber is the block number in which the provided VRF request has been made.
 	// This should be set iff CheckerType is TransmitCheckerTypeVRFV2.
 	VRFRequestBlockNumber *big.Int `json:",omitempty"`
+
+	// Simulate overrides the chain's SimulateBeforeBroadcast setting for this transaction. If true, the transaction is
+	// simulated before the check of CheckerType, and fatally errored if it reverts. Nil follows the chain's setting.
+	Simulate *bool `json:",omitempty"`
 }
 
 // TransmitCheckerType describes the type of check that should be performed before a transaction is
@@ -151,6 +183,9 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	// Used for Keystone Workflows
 	WorkflowExecutionID *string `json:"WorkflowExecutionID,omitempty"`
 
+	// Used by the funding manager, the kind of funding transfer (TopUp or Sweep)
+	FundingKind *string `json:"FundingKind,omitempty"`
+
 	// Used only for forwarded txs, tracks the original destination address.
 	// When this is set, it indicates tx is forwarded through To address.
 	FwdrDestAddress *ADDR `json:"ForwarderDestAddress,omitempty"`
@@ -232,6 +267,15 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// Priority of the tx, unstarted txes with a higher priority are broadcast first
+	Priority int32
+
+	// RevertReason is the decoded reason of the latest on-chain revert of the tx
+	RevertReason null.String
+
+	// BlobSidecar is the encoded blob sidecar of a blob tx. It is kept until the tx is finalized.
+	BlobSidecar []byte
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
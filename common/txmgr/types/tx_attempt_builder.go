@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -10,6 +11,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
 
+// ErrPermanentAttempt marks errors of building an attempt, which fail the same way when retried. The Broadcaster marks
+// the tx as fatally errored.
+var ErrPermanentAttempt = errors.New("attempt cannot be built")
+
 // TxAttemptBuilder takes the base unsigned transaction + optional parameters (tx type, gas parameters)
 // and returns a signed TxAttempt
 // it is able to estimate fees and sign transactions
@@ -443,6 +443,7 @@ func makeTestEvmTxm(
 		broadcaster,
 		headSaver,
 		mailbox.NewMonitor("contract_transmitter_test", logger.NullLogger),
+		nil,
 	)
 	require.NoError(t, ht.Start(testutils.Context(t)), "failed to start head tracker")
 	t.Cleanup(func() { require.NoError(t, ht.Close()) })
@@ -547,23 +548,32 @@ var _ evmconfig.HeadTracker = (*TestHeadTrackerConfig)(nil)
 
 type TestEvmConfig struct {
 	evmconfig.EVM
-	HeadTrackerConfig    evmconfig.HeadTracker
-	MaxInFlight          uint32
-	ReaperInterval       time.Duration
-	ReaperThreshold      time.Duration
-	ResendAfterThreshold time.Duration
-	BumpThreshold        uint64
-	MaxQueued            uint64
-	Enabled              bool
-	Threshold            uint32
-	MinAttempts          uint32
-	DetectionApiUrl      *url.URL
+	HeadTrackerConfig       evmconfig.HeadTracker
+	MaxInFlight             uint32
+	ReaperInterval          time.Duration
+	ReaperThreshold         time.Duration
+	ResendAfterThreshold    time.Duration
+	SimulateBeforeBroadcast bool
+	BumpThreshold           uint64
+	MaxQueued               uint64
+	Enabled                 bool
+	Threshold               uint32
+	MinAttempts             uint32
+	DetectionApiUrl         *url.URL
 }
 
 func (e *TestEvmConfig) FinalityTagEnabled() bool {
 	return false
 }
 
+func (e *TestEvmConfig) FinalityBlockTag() string {
+	return "finalized"
+}
+
+func (e *TestEvmConfig) FinalityHybridEnabled() bool {
+	return false
+}
+
 func (e *TestEvmConfig) FinalityDepth() uint32 {
 	return 42
 }
@@ -596,6 +606,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Mempool() evmconfig.Mempool {
+	return &TestMempoolConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -648,6 +662,10 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestMempoolConfig struct {
+	evmconfig.Mempool
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -660,7 +678,9 @@ func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.
 func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) SimulateBeforeBroadcast() bool        { return t.e.SimulateBeforeBroadcast }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) Budget() evmconfig.TxBudget           { return &txBudgetConfig{} }
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -668,6 +688,12 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type txBudgetConfig struct {
+	evmconfig.TxBudget
+}
+
+func (b *txBudgetConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
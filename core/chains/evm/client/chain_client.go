@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 	"time"
 
@@ -36,6 +37,9 @@ type Client interface {
 	// NodeStates returns a map of node Name->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[string]string
+	// NodeLatencyScores returns a map of node Name->latency score, for the nodes that were scored
+	// It might be nil or empty, e.g. for mock clients etc
+	NodeLatencyScores() map[string]time.Duration
 
 	TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -61,6 +65,10 @@ type Client interface {
 	// CAUTION: Using this method might cause local finality violations. It's highly recommended
 	// to use HeadTracker to get latest finalized block.
 	LatestFinalizedBlock(ctx context.Context) (head *evmtypes.Head, err error)
+	// LatestSafeBlock - returns the latest safe block as it's returned from an RPC.
+	// CAUTION: Using this method might cause local finality violations. It's highly recommended
+	// to use HeadTracker to get latest finalized block.
+	LatestSafeBlock(ctx context.Context) (head *evmtypes.Head, err error)
 
 	SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (commonclient.SendTxReturnCode, error)
 
@@ -121,6 +129,10 @@ type chainClient struct {
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
+	// hedger is nil, unless hedged reads are enabled
+	hedger *hedger
+	// quorumReader is nil, unless quorum reads are enabled
+	quorumReader *quorumReader
 }
 
 func NewChainClient(
@@ -134,6 +146,8 @@ func NewChainClient(
 	chainType chaintype.ChainType,
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
+	hedgedReads evmconfig.HedgedReads,
+	quorumReads evmconfig.QuorumReads,
 ) Client {
 	multiNode := commonclient.NewMultiNode(
 		lggr,
@@ -154,6 +168,8 @@ func NewChainClient(
 		multiNode:    multiNode,
 		logger:       logger.Sugared(lggr),
 		clientErrors: clientErrors,
+		hedger:       newHedger(lggr, chainID.String(), hedgedReads),
+		quorumReader: newQuorumReader(lggr, chainID.String(), quorumReads),
 	}
 }
 
@@ -188,19 +204,47 @@ func (c *chainClient) BlockByHash(ctx context.Context, hash common.Hash) (b *typ
 
 // TODO-1663: return custom Block type instead of geth's once client.go is deprecated.
 func (c *chainClient) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
-	rpc, err := c.multiNode.SelectNodeRPC()
-	if err != nil {
-		return b, err
-	}
-	return rpc.BlockByNumberGeth(ctx, number)
+	return doHedged(ctx, c, "eth_getBlockByNumber", func(ctx context.Context, rpc RPCClient) (*types.Block, error) {
+		return rpc.BlockByNumberGeth(ctx, number)
+	})
 }
 
 func (c *chainClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if c.useQuorum(ctx) {
+		var raw json.RawMessage
+		var err error
+		if method == "eth_call" && len(args) == 2 && args[1] == rpc.LatestBlockNumber.String() {
+			raw, err = doQuorumAtHeight(ctx, c, method, latestHeight, func(ctx context.Context, rpc RPCClient, height *big.Int) (raw json.RawMessage, err error) {
+				err = rpc.CallContext(ctx, &raw, method, args[0], ToBlockNumArg(height))
+				return raw, err
+			}, rawJSONResultKey)
+		} else {
+			raw, err = doQuorum(ctx, c, method, func(ctx context.Context, rpc RPCClient) (raw json.RawMessage, err error) {
+				err = rpc.CallContext(ctx, &raw, method, args...)
+				return raw, err
+			}, rawJSONResultKey)
+		}
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(raw, result)
+	}
 	return c.multiNode.CallContext(ctx, result, method, args...)
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return c.multiNode.CallContract(ctx, msg, blockNumber)
+	call := func(ctx context.Context, rpc RPCClient) ([]byte, error) {
+		return rpc.CallContract(ctx, msg, blockNumber)
+	}
+	if c.useQuorum(ctx) {
+		if blockNumber == nil {
+			return doQuorumAtHeight(ctx, c, "eth_call", latestHeight, func(ctx context.Context, rpc RPCClient, height *big.Int) ([]byte, error) {
+				return rpc.CallContract(ctx, msg, height)
+			}, bytesResultKey)
+		}
+		return doQuorum(ctx, c, "eth_call", call, bytesResultKey)
+	}
+	return doHedged(ctx, c, "eth_call", call)
 }
 
 func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
@@ -233,7 +277,9 @@ func (c *chainClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (u
 	return c.multiNode.EstimateGas(ctx, call)
 }
 func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	return c.multiNode.FilterEvents(ctx, q)
+	return doHedged(ctx, c, "eth_getLogs", func(ctx context.Context, rpc RPCClient) ([]types.Log, error) {
+		return rpc.FilterEvents(ctx, q)
+	})
 }
 
 func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *types.Header, err error) {
@@ -257,7 +303,9 @@ func (c *chainClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.
 }
 
 func (c *chainClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
-	return c.multiNode.BlockByNumber(ctx, n)
+	return doHedged(ctx, c, "eth_getBlockByNumber", func(ctx context.Context, rpc RPCClient) (*evmtypes.Head, error) {
+		return rpc.BlockByNumber(ctx, n)
+	})
 }
 
 func (c *chainClient) IsL2() bool {
@@ -276,6 +324,10 @@ func (c *chainClient) NodeStates() map[string]string {
 	return c.multiNode.NodeStates()
 }
 
+func (c *chainClient) NodeLatencyScores() map[string]time.Duration {
+	return c.multiNode.NodeLatencyScores()
+}
+
 func (c *chainClient) PendingCodeAt(ctx context.Context, account common.Address) (b []byte, err error) {
 	rpc, err := c.multiNode.SelectNodeRPC()
 	if err != nil {
@@ -351,9 +403,18 @@ func (c *chainClient) TransactionReceipt(ctx context.Context, txHash common.Hash
 }
 
 func (c *chainClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	if c.useQuorum(ctx) {
+		return doQuorumAtHeight(ctx, c, "LatestFinalizedBlock", latestFinalizedHeight, func(ctx context.Context, rpc RPCClient, height *big.Int) (*evmtypes.Head, error) {
+			return rpc.BlockByNumber(ctx, height)
+		}, headResultKey)
+	}
 	return c.multiNode.LatestFinalizedBlock(ctx)
 }
 
+func (c *chainClient) LatestSafeBlock(ctx context.Context) (*evmtypes.Head, error) {
+	return c.HeadByNumber(ctx, big.NewInt(rpc.SafeBlockNumber.Int64()))
+}
+
 func (c *chainClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error) {
 	rpc, err := c.multiNode.SelectNodeRPC()
 	if err != nil {
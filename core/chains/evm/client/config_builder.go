@@ -61,6 +61,10 @@ func NewClientConfigs(
 		FinalizedBlockPollInterval: commonconfig.MustNewDuration(finalizedBlockPollInterval),
 		NewHeadsPollInterval:       commonconfig.MustNewDuration(newHeadsPollInterval),
 	}
+	// hedged and quorum reads are not supported for clients built from the basic configs
+	disabled := false
+	nodePool.HedgedReads.Enabled = &disabled
+	nodePool.QuorumReads.Enabled = &disabled
 	nodePoolCfg := &evmconfig.NodePoolConfig{C: nodePool}
 	chainConfig := &evmconfig.EVMConfig{
 		C: &toml.EVMConfig{
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
@@ -503,6 +506,36 @@ func (err JsonError) String() string {
 	return fmt.Sprintf("json-rpc error { Code = %d, Message = '%s', Data = '%v' }", err.Code, err.Message, err.Data)
 }
 
+// RevertData returns the ABI encoded revert data of a reverted call, or nil if the RPC did not include it.
+// Some RPCs prefix the data with "Reverted ", see ExtractRPCError.
+func (err JsonError) RevertData() []byte {
+	s, ok := err.Data.(string)
+	if !ok {
+		return nil
+	}
+	data, dErr := hexutil.Decode(strings.TrimSpace(strings.TrimPrefix(s, "Reverted ")))
+	if dErr != nil {
+		return nil
+	}
+	return data
+}
+
+// RevertReason decodes the reason of a reverted call from its revert data. Error(string) and Panic(uint256) reverts
+// are decoded, custom errors are identified by their selector. If there is no revert data, the message is returned.
+func (err JsonError) RevertReason() string {
+	data := err.RevertData()
+	if len(data) == 0 {
+		return err.Message
+	}
+	if reason, uErr := abi.UnpackRevert(data); uErr == nil {
+		return reason
+	}
+	if len(data) >= 4 {
+		return fmt.Sprintf("custom error %s", hexutil.Encode(data[:4]))
+	}
+	return err.Message
+}
+
 func ExtractRPCErrorOrNil(err error) *JsonError {
 	jErr, eErr := ExtractRPCError(err)
 	if eErr != nil {
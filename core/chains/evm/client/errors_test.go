@@ -533,3 +533,24 @@ func Test_IsTooManyResultsError(t *testing.T) {
 		})
 	}
 }
+
+func Test_JsonError_RevertReason(t *testing.T) {
+	errorString := "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f7420616c6c6f776564000000000000000000000000000000000000000000"
+	tests := []struct {
+		name   string
+		jErr   evmclient.JsonError
+		expect string
+	}{
+		{"Error(string)", evmclient.JsonError{Code: 3, Message: "execution reverted: not allowed", Data: errorString}, "not allowed"},
+		{"Error(string) with parity prefix", evmclient.JsonError{Code: -32015, Message: "VM execution error.", Data: "Reverted " + errorString}, "not allowed"},
+		{"Panic(uint256)", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0x4e487b710000000000000000000000000000000000000000000000000000000000000012"}, "division or modulo by zero"},
+		{"custom error", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0x1425571c0000000000000000000000000000000000000000000000000000000000000001"}, "custom error 0x1425571c"},
+		{"no data", evmclient.JsonError{Code: 3, Message: "execution reverted"}, "execution reverted"},
+		{"non hex data", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: map[string]interface{}{"foo": "bar"}}, "execution reverted"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, test.jErr.RevertReason())
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+var (
+	promEVMHedgedReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_hedged_reads",
+		Help: "The total number of read requests that were sent to a second RPC node, because the active node did not respond in time",
+	}, []string{"evmChainID", "method"})
+	promEVMHedgedReadsWon = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_hedged_reads_won",
+		Help: "The total number of hedged read requests where the second RPC node responded first",
+	}, []string{"evmChainID", "method"})
+)
+
+const (
+	// hedgeLatencyWindow is the number of most recent successful read latencies of a method used to compute its hedge
+	// delay.
+	hedgeLatencyWindow = 200
+	// hedgeMinSamples is the number of latencies of a method that need to be observed before its reads are hedged.
+	hedgeMinSamples = 20
+)
+
+// hedger sends read requests to a second RPC node, if the active node does not respond within the configured
+// percentile of recently observed latencies. The latencies are tracked per method, as the latencies of eth_call,
+// eth_getLogs and eth_getBlockByNumber differ by orders of magnitude.
+type hedger struct {
+	lggr       logger.SugaredLogger
+	chainID    string
+	percentile uint8
+	minDelay   time.Duration
+
+	mu      sync.Mutex
+	windows map[string]*latencyWindow
+}
+
+// latencyWindow is a ring buffer of recent latencies
+type latencyWindow struct {
+	latencies []time.Duration
+	next      int
+}
+
+func newHedger(lggr logger.Logger, chainID string, cfg evmconfig.HedgedReads) *hedger {
+	if cfg == nil || !cfg.Enabled() {
+		return nil
+	}
+	return &hedger{
+		lggr:       logger.Sugared(logger.Named(lggr, "Hedger")),
+		chainID:    chainID,
+		percentile: cfg.LatencyPercentile(),
+		minDelay:   cfg.MinDelay(),
+		windows:    make(map[string]*latencyWindow),
+	}
+}
+
+func (h *hedger) observe(method string, latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	w, ok := h.windows[method]
+	if !ok {
+		w = &latencyWindow{latencies: make([]time.Duration, 0, hedgeLatencyWindow)}
+		h.windows[method] = w
+	}
+	if len(w.latencies) < hedgeLatencyWindow {
+		w.latencies = append(w.latencies, latency)
+		return
+	}
+	w.latencies[w.next] = latency
+	w.next = (w.next + 1) % hedgeLatencyWindow
+}
+
+// delay returns how long to wait for the active node before hedging a request of the method.
+// Returns false if not enough latencies of the method were observed yet.
+func (h *hedger) delay(method string) (time.Duration, bool) {
+	h.mu.Lock()
+	w, ok := h.windows[method]
+	if !ok || len(w.latencies) < hedgeMinSamples {
+		h.mu.Unlock()
+		return 0, false
+	}
+	sorted := slices.Clone(w.latencies)
+	h.mu.Unlock()
+
+	slices.Sort(sorted)
+	idx := (len(sorted)*int(h.percentile) + 99) / 100
+	return max(sorted[idx-1], h.minDelay), true
+}
+
+type hedgedResult[T any] struct {
+	val    T
+	err    error
+	hedged bool
+}
+
+// doHedged calls the active RPC and, if it fails to respond within the hedge delay, the next best RPC as well.
+// The first successful response is returned. Errors are only returned once all the called RPCs have failed.
+// An error returned by the active RPC before the hedge delay has elapsed is returned as is, as it is most likely
+// deterministic (e.g. an execution revert).
+func doHedged[T any](ctx context.Context, c *chainClient, method string, call func(ctx context.Context, rpc RPCClient) (T, error)) (res T, err error) {
+	if c.hedger == nil {
+		rpc, err := c.multiNode.SelectNodeRPC()
+		if err != nil {
+			return res, err
+		}
+		return call(ctx, rpc)
+	}
+
+	rpcs, err := c.multiNode.SelectNodeRPCs(2)
+	if err != nil {
+		return res, err
+	}
+	delay, ok := c.hedger.delay(method)
+	if len(rpcs) < 2 || !ok {
+		start := time.Now()
+		res, err = call(ctx, rpcs[0])
+		if err == nil {
+			c.hedger.observe(method, time.Since(start))
+		}
+		return res, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// buffered, so that the losing call does not block
+	results := make(chan hedgedResult[T], len(rpcs))
+	send := func(rpc RPCClient, hedged bool) {
+		val, err := call(ctx, rpc)
+		results <- hedgedResult[T]{val: val, err: err, hedged: hedged}
+	}
+
+	start := time.Now()
+	go send(rpcs[0], false)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	pending := 1
+	var firstErr error
+	for {
+		select {
+		case <-timer.C:
+			c.hedger.lggr.Debugw("Active RPC did not respond in time, hedging read request", "method", method, "delay", delay)
+			promEVMHedgedReads.WithLabelValues(c.hedger.chainID, method).Inc()
+			pending++
+			go send(rpcs[1], true)
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				c.hedger.observe(method, time.Since(start))
+				if r.hedged {
+					promEVMHedgedReadsWon.WithLabelValues(c.hedger.chainID, method).Inc()
+				}
+				return r.val, nil
+			}
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			if pending == 0 {
+				return res, firstErr
+			}
+		}
+	}
+}
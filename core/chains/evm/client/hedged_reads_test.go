@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+type testHedgedReadsConfig struct {
+	percentile uint8
+	minDelay   time.Duration
+}
+
+func (c testHedgedReadsConfig) Enabled() bool            { return true }
+func (c testHedgedReadsConfig) LatencyPercentile() uint8 { return c.percentile }
+func (c testHedgedReadsConfig) MinDelay() time.Duration  { return c.minDelay }
+
+// staticMultiNode always selects the given RPCs, in order
+type staticMultiNode struct {
+	commonclient.MultiNode[*big.Int, evmtypes.Nonce, common.Address, common.Hash, *types.Transaction, common.Hash,
+		types.Log, ethereum.FilterQuery, *evmtypes.Receipt, *assets.Wei, *evmtypes.Head, RPCClient, rpc.BatchElem]
+	rpcs []RPCClient
+}
+
+func (m *staticMultiNode) SelectNodeRPC() (RPCClient, error) {
+	return m.rpcs[0], nil
+}
+
+func (m *staticMultiNode) SelectNodeRPCs(n int) ([]RPCClient, error) {
+	return m.rpcs[:min(n, len(m.rpcs))], nil
+}
+
+// fakeRPC implements only the RPCClient methods used by hedged and quorum reads
+type fakeRPC struct {
+	RPCClient
+	callContract         func(ctx context.Context) ([]byte, error)
+	callContractAt       func(ctx context.Context, blockNumber *big.Int) ([]byte, error)
+	filterEvents         func(ctx context.Context) ([]types.Log, error)
+	latestFinalizedBlock func(ctx context.Context) (*evmtypes.Head, error)
+	latestBlockHeight    func(ctx context.Context) (*big.Int, error)
+	blockByNumber        func(ctx context.Context, number *big.Int) (*evmtypes.Head, error)
+}
+
+func (f *fakeRPC) CallContract(ctx context.Context, _ interface{}, blockNumber *big.Int) ([]byte, error) {
+	if f.callContractAt != nil {
+		return f.callContractAt(ctx, blockNumber)
+	}
+	return f.callContract(ctx)
+}
+
+func (f *fakeRPC) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	return f.latestFinalizedBlock(ctx)
+}
+
+func (f *fakeRPC) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
+	return f.latestBlockHeight(ctx)
+}
+
+func (f *fakeRPC) BlockByNumber(ctx context.Context, number *big.Int) (*evmtypes.Head, error) {
+	return f.blockByNumber(ctx, number)
+}
+
+func (f *fakeRPC) FilterEvents(ctx context.Context, _ ethereum.FilterQuery) ([]types.Log, error) {
+	return f.filterEvents(ctx)
+}
+
+func returnCall(val []byte, err error) func(context.Context) ([]byte, error) {
+	return func(context.Context) ([]byte, error) { return val, err }
+}
+
+func newHedgedChainClient(t *testing.T, cfg testHedgedReadsConfig, rpcs ...RPCClient) *chainClient {
+	h := newHedger(logger.Test(t), "1", cfg)
+	// warm up the latency windows, so that hedging is active
+	for _, method := range []string{"eth_call", "eth_getLogs", "eth_getBlockByNumber"} {
+		for i := 0; i < hedgeMinSamples; i++ {
+			h.observe(method, 10*time.Millisecond)
+		}
+	}
+	return &chainClient{
+		multiNode: &staticMultiNode{rpcs: rpcs},
+		logger:    logger.Sugared(logger.Test(t)),
+		hedger:    h,
+	}
+}
+
+func TestHedger_delay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, newHedger(logger.Test(t), "1", nil))
+	})
+
+	t.Run("requires minimum number of samples", func(t *testing.T) {
+		h := newHedger(logger.Test(t), "1", testHedgedReadsConfig{percentile: 90})
+		for i := 0; i < hedgeMinSamples-1; i++ {
+			h.observe("eth_call", time.Second)
+		}
+		_, ok := h.delay("eth_call")
+		assert.False(t, ok)
+		h.observe("eth_call", time.Second)
+		delay, ok := h.delay("eth_call")
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, delay)
+	})
+
+	t.Run("returns percentile of observed latencies", func(t *testing.T) {
+		h := newHedger(logger.Test(t), "1", testHedgedReadsConfig{percentile: 90})
+		for i := 1; i <= 100; i++ {
+			h.observe("eth_call", time.Duration(i)*time.Millisecond)
+		}
+		delay, ok := h.delay("eth_call")
+		assert.True(t, ok)
+		assert.Equal(t, 90*time.Millisecond, delay)
+	})
+
+	t.Run("respects min delay", func(t *testing.T) {
+		h := newHedger(logger.Test(t), "1", testHedgedReadsConfig{percentile: 90, minDelay: time.Second})
+		for i := 0; i < hedgeMinSamples; i++ {
+			h.observe("eth_call", time.Millisecond)
+		}
+		delay, _ := h.delay("eth_call")
+		assert.Equal(t, time.Second, delay)
+	})
+
+	t.Run("tracks latencies per method", func(t *testing.T) {
+		h := newHedger(logger.Test(t), "1", testHedgedReadsConfig{percentile: 90})
+		for i := 0; i < hedgeMinSamples; i++ {
+			h.observe("eth_call", 10*time.Millisecond)
+			h.observe("eth_getLogs", time.Second)
+		}
+		delay, ok := h.delay("eth_call")
+		assert.True(t, ok)
+		assert.Equal(t, 10*time.Millisecond, delay)
+		delay, ok = h.delay("eth_getLogs")
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, delay)
+		_, ok = h.delay("eth_getBlockByNumber")
+		assert.False(t, ok)
+	})
+
+	t.Run("only keeps most recent latencies", func(t *testing.T) {
+		h := newHedger(logger.Test(t), "1", testHedgedReadsConfig{percentile: 99})
+		for i := 0; i < hedgeLatencyWindow; i++ {
+			h.observe("eth_call", time.Hour)
+		}
+		for i := 0; i < hedgeLatencyWindow; i++ {
+			h.observe("eth_call", time.Millisecond)
+		}
+		delay, _ := h.delay("eth_call")
+		assert.Equal(t, time.Millisecond, delay)
+	})
+}
+
+func TestChainClient_HedgedReads(t *testing.T) {
+	t.Parallel()
+
+	msg := ethereum.CallMsg{To: &common.Address{}}
+	blockNumber := big.NewInt(10)
+	unexpectedCall := func(t *testing.T) func(context.Context) ([]byte, error) {
+		return func(context.Context) ([]byte, error) {
+			assert.Fail(t, "unexpected call to backup RPC")
+			return nil, nil
+		}
+	}
+
+	t.Run("returns result of active RPC if it responds in time", func(t *testing.T) {
+		active := &fakeRPC{callContract: returnCall([]byte{1}, nil)}
+		backup := &fakeRPC{callContract: unexpectedCall(t)}
+		c := newHedgedChainClient(t, testHedgedReadsConfig{percentile: 90, minDelay: time.Minute}, active, backup)
+
+		result, err := c.CallContract(tests.Context(t), msg, blockNumber)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, result)
+	})
+
+	t.Run("returns result of backup RPC if active is too slow", func(t *testing.T) {
+		active := &fakeRPC{callContract: func(ctx context.Context) ([]byte, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}}
+		backup := &fakeRPC{callContract: returnCall([]byte{2}, nil)}
+		c := newHedgedChainClient(t, testHedgedReadsConfig{percentile: 90}, active, backup)
+
+		result, err := c.CallContract(tests.Context(t), msg, blockNumber)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, result)
+	})
+
+	t.Run("returns error of active RPC without hedging", func(t *testing.T) {
+		active := &fakeRPC{callContract: returnCall(nil, errors.New("execution reverted"))}
+		backup := &fakeRPC{callContract: unexpectedCall(t)}
+		c := newHedgedChainClient(t, testHedgedReadsConfig{percentile: 90, minDelay: time.Minute}, active, backup)
+
+		_, err := c.CallContract(tests.Context(t), msg, blockNumber)
+		require.EqualError(t, err, "execution reverted")
+	})
+
+	t.Run("returns first error if all RPCs fail", func(t *testing.T) {
+		active := &fakeRPC{callContract: func(ctx context.Context) ([]byte, error) {
+			time.Sleep(100 * time.Millisecond)
+			return nil, errors.New("active failed")
+		}}
+		backup := &fakeRPC{callContract: returnCall(nil, errors.New("backup failed"))}
+		c := newHedgedChainClient(t, testHedgedReadsConfig{percentile: 90}, active, backup)
+
+		_, err := c.CallContract(tests.Context(t), msg, blockNumber)
+		require.EqualError(t, err, "backup failed")
+	})
+
+	t.Run("does not hedge without second RPC", func(t *testing.T) {
+		active := &fakeRPC{filterEvents: func(ctx context.Context) ([]types.Log, error) {
+			time.Sleep(50 * time.Millisecond)
+			return []types.Log{{Index: 1}}, nil
+		}}
+		c := newHedgedChainClient(t, testHedgedReadsConfig{percentile: 90}, active)
+
+		logs, err := c.FilterLogs(tests.Context(t), ethereum.FilterQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, []types.Log{{Index: 1}}, logs)
+	})
+}
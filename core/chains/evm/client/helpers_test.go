@@ -93,6 +93,8 @@ type TestNodePoolConfig struct {
 	EnforceRepeatableReadVal       bool
 	NodeDeathDeclarationDelay      time.Duration
 	NodeNewHeadsPollInterval       time.Duration
+	NodeHedgedReads                config.HedgedReads
+	NodeQuorumReads                config.QuorumReads
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -127,6 +129,14 @@ func (tc TestNodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return tc.NodeDeathDeclarationDelay
 }
 
+func (tc TestNodePoolConfig) HedgedReads() config.HedgedReads {
+	return tc.NodeHedgedReads
+}
+
+func (tc TestNodePoolConfig) QuorumReads() config.QuorumReads {
+	return tc.NodeQuorumReads
+}
+
 func NewChainClientWithTestNode(
 	t *testing.T,
 	nodeCfg commonclient.NodeConfig,
@@ -168,7 +178,7 @@ func NewChainClientWithTestNode(
 
 	var chainType chaintype.ChainType
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, noNewHeadsThreshold, primaries, sendonlys, chainID, chainType, &clientErrors, 0)
+	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, noNewHeadsThreshold, primaries, sendonlys, chainID, chainType, &clientErrors, 0, nil, nil)
 	t.Cleanup(c.Close)
 	return c, nil
 }
@@ -183,7 +193,7 @@ func NewChainClientWithEmptyNode(
 	lggr := logger.Test(t)
 
 	var chainType chaintype.ChainType
-	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, nil, nil, chainID, chainType, nil, 0)
+	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, nil, nil, chainID, chainType, nil, 0, nil, nil)
 	t.Cleanup(c.Close)
 	return c
 }
@@ -209,7 +219,7 @@ func NewChainClientWithMockedRpc(
 		cfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, *parsed, nil, "eth-primary-node-0", 1, chainID, 1, rpc, "EVM")
 	primaries := []commonclient.Node[*big.Int, *evmtypes.Head, RPCClient]{n}
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, primaries, nil, chainID, chainType, &clientErrors, 0)
+	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, primaries, nil, chainID, chainType, &clientErrors, 0, nil, nil)
 	t.Cleanup(c.Close)
 	return c
 }
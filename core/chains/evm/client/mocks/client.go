@@ -23,6 +23,8 @@ import (
 
 	rpc "github.com/ethereum/go-ethereum/rpc"
 
+	time "time"
+
 	types "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -1356,6 +1358,111 @@ func (_c *Client_LatestFinalizedBlock_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// LatestSafeBlock provides a mock function with given fields: ctx
+func (_m *Client) LatestSafeBlock(ctx context.Context) (*evmtypes.Head, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestSafeBlock")
+	}
+
+	var r0 *evmtypes.Head
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*evmtypes.Head, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *evmtypes.Head); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.Head)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_LatestSafeBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestSafeBlock'
+type Client_LatestSafeBlock_Call struct {
+	*mock.Call
+}
+
+// LatestSafeBlock is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Client_Expecter) LatestSafeBlock(ctx interface{}) *Client_LatestSafeBlock_Call {
+	return &Client_LatestSafeBlock_Call{Call: _e.mock.On("LatestSafeBlock", ctx)}
+}
+
+func (_c *Client_LatestSafeBlock_Call) Run(run func(ctx context.Context)) *Client_LatestSafeBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Client_LatestSafeBlock_Call) Return(_a0 *evmtypes.Head, _a1 error) *Client_LatestSafeBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_LatestSafeBlock_Call) RunAndReturn(run func(context.Context) (*evmtypes.Head, error)) *Client_LatestSafeBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NodeLatencyScores provides a mock function with no fields
+func (_m *Client) NodeLatencyScores() map[string]time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeLatencyScores")
+	}
+
+	var r0 map[string]time.Duration
+	if rf, ok := ret.Get(0).(func() map[string]time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]time.Duration)
+		}
+	}
+
+	return r0
+}
+
+// Client_NodeLatencyScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeLatencyScores'
+type Client_NodeLatencyScores_Call struct {
+	*mock.Call
+}
+
+// NodeLatencyScores is a helper method to define mock.On call
+func (_e *Client_Expecter) NodeLatencyScores() *Client_NodeLatencyScores_Call {
+	return &Client_NodeLatencyScores_Call{Call: _e.mock.On("NodeLatencyScores")}
+}
+
+func (_c *Client_NodeLatencyScores_Call) Run(run func()) *Client_NodeLatencyScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_NodeLatencyScores_Call) Return(_a0 map[string]time.Duration) *Client_NodeLatencyScores_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_NodeLatencyScores_Call) RunAndReturn(run func() map[string]time.Duration) *Client_NodeLatencyScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NodeStates provides a mock function with given fields:
 func (_m *Client) NodeStates() map[string]string {
 	ret := _m.Called()
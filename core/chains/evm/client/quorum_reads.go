@@ -0,0 +1,179 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var promEVMQuorumReadsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "evm_pool_rpc_quorum_reads_failed",
+	Help: "The total number of critical read requests that were rejected, because not enough RPC nodes agreed on the result",
+}, []string{"evmChainID", "method"})
+
+// quorumReader sends critical read requests to multiple RPC nodes and only accepts the result if enough of them agree on it.
+// Only requests flagged with commonclient.CtxAddQuorumReadFlag are verified.
+type quorumReader struct {
+	lggr    logger.SugaredLogger
+	chainID string
+	nodes   int
+	quorum  int
+}
+
+func newQuorumReader(lggr logger.Logger, chainID string, cfg evmconfig.QuorumReads) *quorumReader {
+	if cfg == nil || !cfg.Enabled() {
+		return nil
+	}
+	return &quorumReader{
+		lggr:    logger.Sugared(logger.Named(lggr, "QuorumReader")),
+		chainID: chainID,
+		nodes:   int(cfg.Nodes()),
+		quorum:  int(cfg.Quorum()),
+	}
+}
+
+// useQuorum returns true if the request has to be verified by a quorum of RPCs.
+func (c *chainClient) useQuorum(ctx context.Context) bool {
+	return c.quorumReader != nil && commonclient.CtxIsQuorumReadRequest(ctx)
+}
+
+// doQuorum sends the request to the configured number of RPCs and returns the result agreed on by the quorum.
+func doQuorum[T any](ctx context.Context, c *chainClient, method string, call func(ctx context.Context, rpc RPCClient) (T, error),
+	resultKey func(T) (string, error)) (res T, err error) {
+	rpcs, err := c.multiNode.SelectNodeRPCs(c.quorumReader.nodes)
+	if err != nil {
+		return res, err
+	}
+	res, err = commonclient.QuorumRead(ctx, rpcs, c.quorumReader.quorum, call, resultKey, quorumErrorClass)
+	c.quorumReader.checkQuorum(method, err)
+	return res, err
+}
+
+// doQuorumAtHeight verifies a request on the latest or the finalized block. Healthy RPCs are routinely a block or two
+// apart, so the request is sent for the block at the highest height reached by the quorum, rather than for the latest
+// or finalized block of each RPC.
+func doQuorumAtHeight[T any](ctx context.Context, c *chainClient, method string, height func(ctx context.Context, rpc RPCClient) (int64, error),
+	call func(ctx context.Context, rpc RPCClient, height *big.Int) (T, error), resultKey func(T) (string, error)) (res T, err error) {
+	rpcs, err := c.multiNode.SelectNodeRPCs(c.quorumReader.nodes)
+	if err != nil {
+		return res, err
+	}
+	agreed, err := c.quorumReader.agreedHeight(ctx, rpcs, height)
+	if err == nil {
+		res, err = commonclient.QuorumRead(ctx, rpcs, c.quorumReader.quorum, func(ctx context.Context, rpc RPCClient) (T, error) {
+			return call(ctx, rpc, big.NewInt(agreed))
+		}, resultKey, quorumErrorClass)
+	}
+	c.quorumReader.checkQuorum(method, err)
+	return res, err
+}
+
+// agreedHeight returns the highest block height reached by at least a quorum of the RPCs.
+func (q *quorumReader) agreedHeight(ctx context.Context, rpcs []RPCClient, height func(ctx context.Context, rpc RPCClient) (int64, error)) (int64, error) {
+	type result struct {
+		height int64
+		err    error
+	}
+	// buffered, so that late responses do not block
+	results := make(chan result, len(rpcs))
+	for _, rpc := range rpcs {
+		go func(rpc RPCClient) {
+			h, err := height(ctx, rpc)
+			results <- result{height: h, err: err}
+		}(rpc)
+	}
+
+	var heights []int64
+	for range rpcs {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case r := <-results:
+			if r.err == nil {
+				heights = append(heights, r.height)
+			}
+		}
+	}
+	if len(heights) < q.quorum {
+		return 0, fmt.Errorf("%w: only %d of %d RPCs returned their block height, but quorum of %d is required",
+			commonclient.ErrQuorumNotReached, len(heights), len(rpcs), q.quorum)
+	}
+	slices.Sort(heights)
+	return heights[len(heights)-q.quorum], nil
+}
+
+func (q *quorumReader) checkQuorum(method string, err error) {
+	if errors.Is(err, commonclient.ErrQuorumNotReached) {
+		q.lggr.Criticalw("RPCs did not agree on the result of a critical read request", "method", method, "err", err)
+		promEVMQuorumReadsFailed.WithLabelValues(q.chainID, method).Inc()
+	}
+}
+
+func latestHeight(ctx context.Context, rpc RPCClient) (int64, error) {
+	height, err := rpc.LatestBlockHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return height.Int64(), nil
+}
+
+func latestFinalizedHeight(ctx context.Context, rpc RPCClient) (int64, error) {
+	head, err := rpc.LatestFinalizedBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return head.Number, nil
+}
+
+// quorumErrorClass buckets the errors of critical read requests, so that RPCs agree on an error even if they word it
+// differently.
+func quorumErrorClass(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, ethereum.NotFound):
+		return "not found"
+	}
+	if jErr := ExtractRPCErrorOrNil(err); jErr != nil {
+		if jErr.Code == 3 || strings.Contains(strings.ToLower(jErr.Message), "revert") {
+			return "execution reverted"
+		}
+		return fmt.Sprintf("json-rpc error %d", jErr.Code)
+	}
+	return "request failed"
+}
+
+func bytesResultKey(b []byte) (string, error) {
+	return hexutil.Encode(b), nil
+}
+
+func headResultKey(h *evmtypes.Head) (string, error) {
+	if h == nil {
+		return "", nil
+	}
+	return h.Hash.Hex(), nil
+}
+
+func rawJSONResultKey(raw json.RawMessage) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
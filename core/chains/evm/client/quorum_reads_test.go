@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+type testQuorumReadsConfig struct {
+	nodes  uint32
+	quorum uint32
+}
+
+func (c testQuorumReadsConfig) Enabled() bool  { return true }
+func (c testQuorumReadsConfig) Nodes() uint32  { return c.nodes }
+func (c testQuorumReadsConfig) Quorum() uint32 { return c.quorum }
+
+func newQuorumChainClient(t *testing.T, cfg testQuorumReadsConfig, rpcs ...RPCClient) *chainClient {
+	return &chainClient{
+		multiNode:    &staticMultiNode{rpcs: rpcs},
+		logger:       logger.Sugared(logger.Test(t)),
+		quorumReader: newQuorumReader(logger.Test(t), "1", cfg),
+	}
+}
+
+func returnHead(number int64) func(context.Context) (*evmtypes.Head, error) {
+	return func(context.Context) (*evmtypes.Head, error) {
+		return &evmtypes.Head{Number: number, Hash: common.BigToHash(big.NewInt(number))}, nil
+	}
+}
+
+func returnBlock(hash common.Hash) func(context.Context, *big.Int) (*evmtypes.Head, error) {
+	return func(_ context.Context, number *big.Int) (*evmtypes.Head, error) {
+		return &evmtypes.Head{Number: number.Int64(), Hash: hash}, nil
+	}
+}
+
+// returnCallAt returns the block number the call was sent for
+func returnCallAt(_ context.Context, blockNumber *big.Int) ([]byte, error) {
+	return blockNumber.Bytes(), nil
+}
+
+func returnHeight(height int64) func(context.Context) (*big.Int, error) {
+	return func(context.Context) (*big.Int, error) { return big.NewInt(height), nil }
+}
+
+func TestChainClient_QuorumReads(t *testing.T) {
+	t.Parallel()
+
+	msg := ethereum.CallMsg{To: &common.Address{}}
+	blockNumber := big.NewInt(10)
+	cfg := testQuorumReadsConfig{nodes: 3, quorum: 2}
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, newQuorumReader(logger.Test(t), "1", nil))
+	})
+
+	t.Run("only verifies flagged requests", func(t *testing.T) {
+		c := newQuorumChainClient(t, cfg,
+			&fakeRPC{callContract: returnCall([]byte{1}, nil)},
+			&fakeRPC{callContract: returnCall([]byte{2}, nil)},
+			&fakeRPC{callContract: returnCall([]byte{2}, nil)},
+		)
+
+		result, err := c.CallContract(tests.Context(t), msg, blockNumber)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, result)
+
+		result, err = c.CallContract(commonclient.CtxAddQuorumReadFlag(tests.Context(t)), msg, blockNumber)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, result)
+	})
+
+	t.Run("rejects result if RPCs disagree", func(t *testing.T) {
+		failed := func(context.Context, *big.Int) (*evmtypes.Head, error) {
+			return nil, errors.New("connection refused")
+		}
+		c := newQuorumChainClient(t, cfg,
+			&fakeRPC{latestFinalizedBlock: returnHead(10), blockByNumber: returnBlock(common.HexToHash("0x1"))},
+			&fakeRPC{latestFinalizedBlock: returnHead(10), blockByNumber: returnBlock(common.HexToHash("0x2"))},
+			&fakeRPC{latestFinalizedBlock: returnHead(10), blockByNumber: failed},
+		)
+
+		_, err := c.LatestFinalizedBlock(commonclient.CtxAddQuorumReadFlag(tests.Context(t)))
+		require.ErrorIs(t, err, commonclient.ErrQuorumNotReached)
+	})
+
+	t.Run("returns block agreed on by quorum", func(t *testing.T) {
+		c := newQuorumChainClient(t, cfg,
+			&fakeRPC{latestFinalizedBlock: returnHead(10), blockByNumber: returnBlock(common.HexToHash("0x1"))},
+			&fakeRPC{latestFinalizedBlock: returnHead(10), blockByNumber: returnBlock(common.HexToHash("0x2"))},
+			&fakeRPC{latestFinalizedBlock: returnHead(10), blockByNumber: returnBlock(common.HexToHash("0x1"))},
+		)
+
+		head, err := c.LatestFinalizedBlock(commonclient.CtxAddQuorumReadFlag(tests.Context(t)))
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToHash("0x1"), head.Hash)
+	})
+
+	t.Run("verifies the finalized block at the height reached by the quorum", func(t *testing.T) {
+		hash := common.HexToHash("0x1")
+		c := newQuorumChainClient(t, cfg,
+			&fakeRPC{latestFinalizedBlock: returnHead(12), blockByNumber: returnBlock(hash)},
+			&fakeRPC{latestFinalizedBlock: returnHead(10), blockByNumber: returnBlock(hash)},
+			&fakeRPC{latestFinalizedBlock: returnHead(11), blockByNumber: returnBlock(hash)},
+		)
+
+		head, err := c.LatestFinalizedBlock(commonclient.CtxAddQuorumReadFlag(tests.Context(t)))
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), head.Number)
+	})
+
+	t.Run("rejects the finalized block if not enough RPCs return their height", func(t *testing.T) {
+		failed := func(context.Context) (*evmtypes.Head, error) { return nil, errors.New("connection refused") }
+		c := newQuorumChainClient(t, cfg,
+			&fakeRPC{latestFinalizedBlock: returnHead(12)},
+			&fakeRPC{latestFinalizedBlock: failed},
+			&fakeRPC{latestFinalizedBlock: failed},
+		)
+
+		_, err := c.LatestFinalizedBlock(commonclient.CtxAddQuorumReadFlag(tests.Context(t)))
+		require.ErrorIs(t, err, commonclient.ErrQuorumNotReached)
+	})
+
+	t.Run("verifies calls on the latest block at the height reached by the quorum", func(t *testing.T) {
+		c := newQuorumChainClient(t, cfg,
+			&fakeRPC{latestBlockHeight: returnHeight(101), callContractAt: returnCallAt},
+			&fakeRPC{latestBlockHeight: returnHeight(99), callContractAt: returnCallAt},
+			&fakeRPC{latestBlockHeight: returnHeight(100), callContractAt: returnCallAt},
+		)
+
+		result, err := c.CallContract(commonclient.CtxAddQuorumReadFlag(tests.Context(t)), msg, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100).Bytes(), result)
+	})
+
+	t.Run("agrees on errors of the same class", func(t *testing.T) {
+		c := newQuorumChainClient(t, cfg,
+			&fakeRPC{callContract: returnCall(nil, &JsonError{Code: 3, Message: "execution reverted: a"})},
+			&fakeRPC{callContract: returnCall([]byte{1}, nil)},
+			&fakeRPC{callContract: returnCall(nil, &JsonError{Code: -32015, Message: "VM execution error: Reverted"})},
+		)
+
+		_, err := c.CallContract(commonclient.CtxAddQuorumReadFlag(tests.Context(t)), msg, blockNumber)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, commonclient.ErrQuorumNotReached)
+		assert.Equal(t, "execution reverted", quorumErrorClass(err))
+	})
+
+	t.Run("rejects result if not enough RPCs are available", func(t *testing.T) {
+		c := newQuorumChainClient(t, cfg, &fakeRPC{callContract: returnCall([]byte{1}, nil)})
+
+		_, err := c.CallContract(commonclient.CtxAddQuorumReadFlag(tests.Context(t)), msg, blockNumber)
+		require.ErrorIs(t, err, commonclient.ErrQuorumNotReached)
+	})
+}
@@ -149,6 +149,9 @@ type rpcClient struct {
 	highestUserObservations commonclient.ChainInfo
 	// most recent chain info observed during current lifecycle (reseted on DisconnectAll)
 	latestChainInfo commonclient.ChainInfo
+
+	// latencyObserver is set by the node before the rpcClient is used, and scores the node on the outcome of requests
+	latencyObserver func(rtt time.Duration, err error)
 }
 
 // NewRPCCLient returns a new *rpcClient as commonclient.RPC
@@ -307,6 +310,32 @@ func (r *rpcClient) logResult(
 			callName,                       // rpc call name
 		).
 		Observe(float64(callDuration))
+	r.observeLatency(callName, callDuration, err)
+}
+
+// SetLatencyObserver sets the function notified of the outcome of requests. It must be called before the rpcClient is
+// used.
+func (r *rpcClient) SetLatencyObserver(observe func(rtt time.Duration, err error)) {
+	r.latencyObserver = observe
+}
+
+// observeLatency reports the outcome of a request to the latency observer. Subscriptions, batches and log queries are
+// not reported, as their round-trip time depends more on the request than on the node. Errors returned by the RPC
+// server, such as reverts, still measure a full round-trip and are not counted as failures, while requests canceled by
+// the caller measure nothing.
+func (r *rpcClient) observeLatency(callName string, rtt time.Duration, err error) {
+	if r.latencyObserver == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	switch callName {
+	case "EthSubscribe", "SubscribeFilterLogs", "BatchCallContext", "FilterLogs":
+		return
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		err = nil
+	}
+	r.latencyObserver(rtt, err)
 }
 
 func (r *rpcClient) getRPCDomain() string {
@@ -1514,9 +1543,14 @@ func (r *rpcClient) GetInterceptedChainInfo() (latest, highestUserObservations c
 	return r.latestChainInfo, r.highestUserObservations
 }
 
+// ToBlockNumArg encodes the block number of an eth_getBlockByNumber request. Negative numbers are the block tags
+// defined in the rpc module, e.g. rpc.SafeBlockNumber.
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
 	}
+	if number.Sign() < 0 && number.IsInt64() {
+		return rpc.BlockNumber(number.Int64()).String()
+	}
 	return hexutil.EncodeBig(number)
 }
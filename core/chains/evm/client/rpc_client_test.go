@@ -621,3 +621,47 @@ func TestAstarCustomFinality(t *testing.T) {
 		})
 	}
 }
+
+func TestRPCClient_LatencyObserver(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	chainID := big.NewInt(123456)
+	rpcURL := testutils.NewWSServer(t, chainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		switch method {
+		case "web3_clientVersion":
+			resp.Result = `"geth"`
+		case "eth_call":
+			resp.Error.Code = 3
+			resp.Error.Message = "execution reverted"
+		case "eth_getLogs":
+			resp.Result = `[]`
+		}
+		return
+	}).WSURL()
+
+	rpc := client.NewRPCClient(logger.Test(t), *rpcURL, nil, "rpc", 1, chainID, commonclient.Primary, 0, 0, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+	var observed []error
+	rpc.(interface {
+		SetLatencyObserver(func(time.Duration, error))
+	}).SetLatencyObserver(func(_ time.Duration, err error) {
+		observed = append(observed, err)
+	})
+	require.NoError(t, rpc.Dial(ctx))
+	defer rpc.Close()
+	observed = nil
+
+	_, err := rpc.ClientVersion(ctx)
+	require.NoError(t, err)
+	_, err = rpc.CallContract(ctx, ethereum.CallMsg{}, nil)
+	require.Error(t, err)
+	_, err = rpc.FilterEvents(ctx, ethereum.FilterQuery{})
+	require.NoError(t, err)
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = rpc.ClientVersion(canceledCtx)
+	require.Error(t, err)
+
+	// the revert is a full round-trip, while log queries and canceled requests are not reported
+	assert.Equal(t, []error{nil, nil}, observed)
+}
@@ -528,6 +528,9 @@ func (c *SimulatedBackendClient) Backend() *backends.SimulatedBackend {
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
 
+// NodeLatencyScores implements evmclient.Client
+func (c *SimulatedBackendClient) NodeLatencyScores() map[string]time.Duration { return nil }
+
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
 func (c *SimulatedBackendClient) Commit() common.Hash {
@@ -725,6 +728,17 @@ func (c *SimulatedBackendClient) LatestFinalizedBlock(ctx context.Context) (*evm
 	}, nil
 }
 
+func (c *SimulatedBackendClient) LatestSafeBlock(ctx context.Context) (*evmtypes.Head, error) {
+	block := c.b.Blockchain().CurrentSafeBlock()
+	return &evmtypes.Head{
+		EVMChainID: ubig.NewI(c.chainId.Int64()),
+		Hash:       block.Hash(),
+		Number:     block.Number.Int64(),
+		ParentHash: block.ParentHash,
+		Timestamp:  time.Unix(int64(block.Time), 0),
+	}, nil
+}
+
 func (c *SimulatedBackendClient) ethGetLogs(ctx context.Context, result interface{}, args ...interface{}) error {
 	var from, to *big.Int
 	var hash *common.Hash
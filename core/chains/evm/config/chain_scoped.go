@@ -52,6 +52,10 @@ func (e *EVMConfig) BalanceMonitor() BalanceMonitor {
 	return &balanceMonitorConfig{c: e.C.BalanceMonitor}
 }
 
+func (e *EVMConfig) Funding() Funding {
+	return &fundingConfig{c: e.C.Funding, k: e.C.KeySpecific}
+}
+
 func (e *EVMConfig) Transactions() Transactions {
 	return &transactionsConfig{c: e.C.Transactions}
 }
@@ -60,6 +64,10 @@ func (e *EVMConfig) HeadTracker() HeadTracker {
 	return &headTrackerConfig{c: e.C.HeadTracker}
 }
 
+func (e *EVMConfig) FinalityProvider() FinalityProvider {
+	return &finalityProviderConfig{c: e.C.FinalityProvider}
+}
+
 func (e *EVMConfig) OCR() OCR {
 	return &ocrConfig{c: e.C.OCR}
 }
@@ -104,6 +112,14 @@ func (e *EVMConfig) FinalityTagEnabled() bool {
 	return *e.C.FinalityTagEnabled
 }
 
+func (e *EVMConfig) FinalityBlockTag() string {
+	return *e.C.FinalityBlockTag
+}
+
+func (e *EVMConfig) FinalityHybridEnabled() bool {
+	return *e.C.FinalityHybridEnabled
+}
+
 func (e *EVMConfig) LogKeepBlocksDepth() uint32 {
 	return *e.C.LogKeepBlocksDepth
 }
@@ -112,6 +128,10 @@ func (e *EVMConfig) BackupLogPollerBlockDelay() uint64 {
 	return *e.C.BackupLogPollerBlockDelay
 }
 
+func (e *EVMConfig) LogSubscriptionEnabled() bool {
+	return *e.C.LogSubscriptionEnabled
+}
+
 func (e *EVMConfig) NonceAutoSync() bool {
 	return *e.C.NonceAutoSync
 }
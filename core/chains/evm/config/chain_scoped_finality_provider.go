@@ -0,0 +1,17 @@
+package config
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+type finalityProviderConfig struct {
+	c toml.FinalityProvider
+}
+
+func (f *finalityProviderConfig) Type() string {
+	return *f.c.Type
+}
+
+func (f *finalityProviderConfig) L1Confirmations() uint32 {
+	return *f.c.L1Confirmations
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+type fundingConfig struct {
+	c toml.Funding
+	k toml.KeySpecificConfig
+}
+
+func (f *fundingConfig) Enabled() bool {
+	return *f.c.Enabled
+}
+
+func (f *fundingConfig) TreasuryKey() gethcommon.Address {
+	if f.c.TreasuryKey == nil {
+		return gethcommon.Address{}
+	}
+	return f.c.TreasuryKey.Address()
+}
+
+func (f *fundingConfig) MinBalance(addr gethcommon.Address) *assets.Wei {
+	if ks := f.keySpecific(addr); ks != nil && ks.MinBalance != nil {
+		return ks.MinBalance
+	}
+	return f.c.MinBalance
+}
+
+func (f *fundingConfig) TargetBalance(addr gethcommon.Address) *assets.Wei {
+	if ks := f.keySpecific(addr); ks != nil && ks.TargetBalance != nil {
+		return ks.TargetBalance
+	}
+	return f.c.TargetBalance
+}
+
+func (f *fundingConfig) SweepEnabled() bool {
+	return *f.c.SweepEnabled
+}
+
+func (f *fundingConfig) SweepThreshold() *assets.Wei {
+	return f.c.SweepThreshold
+}
+
+func (f *fundingConfig) keySpecific(addr gethcommon.Address) *toml.KeySpecificFunding {
+	for i := range f.k {
+		if f.k[i].Key.Address() == addr {
+			return &f.k[i].Funding
+		}
+	}
+	return nil
+}
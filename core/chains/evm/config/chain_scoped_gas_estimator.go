@@ -42,6 +42,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) Mempool() Mempool {
+	return &mempoolConfig{c: g.c.Mempool}
+}
+
 func (g *gasEstimatorConfig) EIP1559DynamicFees() bool {
 	return *g.c.EIP1559DynamicFees
 }
@@ -190,3 +194,15 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type mempoolConfig struct {
+	c toml.MempoolEstimator
+}
+
+func (m *mempoolConfig) Blocks() uint16 {
+	return *m.c.Blocks
+}
+
+func (m *mempoolConfig) PollPeriod() time.Duration {
+	return m.c.PollPeriod.Duration()
+}
@@ -51,3 +51,39 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) HedgedReads() HedgedReads { return &hedgedReadsConfig{c: n.C.HedgedReads} }
+
+func (n *NodePoolConfig) QuorumReads() QuorumReads { return &quorumReadsConfig{c: n.C.QuorumReads} }
+
+type hedgedReadsConfig struct {
+	c toml.HedgedReads
+}
+
+func (h *hedgedReadsConfig) Enabled() bool {
+	return *h.c.Enabled
+}
+
+func (h *hedgedReadsConfig) LatencyPercentile() uint8 {
+	return *h.c.LatencyPercentile
+}
+
+func (h *hedgedReadsConfig) MinDelay() time.Duration {
+	return h.c.MinDelay.Duration()
+}
+
+type quorumReadsConfig struct {
+	c toml.QuorumReads
+}
+
+func (q *quorumReadsConfig) Enabled() bool {
+	return *q.c.Enabled
+}
+
+func (q *quorumReadsConfig) Nodes() uint32 {
+	return *q.c.Nodes
+}
+
+func (q *quorumReadsConfig) Quorum() uint32 {
+	return *q.c.Quorum
+}
@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -35,10 +36,18 @@ func (t *transactionsConfig) MaxQueued() uint64 {
 	return uint64(*t.c.MaxQueued)
 }
 
+func (t *transactionsConfig) SimulateBeforeBroadcast() bool {
+	return *t.c.SimulateBeforeBroadcast
+}
+
 func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) Budget() TxBudget {
+	return &txBudgetConfig{c: t.c.Budget}
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
@@ -58,3 +67,31 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+type txBudgetConfig struct {
+	c toml.TxBudget
+}
+
+func (b *txBudgetConfig) Enabled() bool {
+	return *b.c.Enabled
+}
+
+func (b *txBudgetConfig) Mode() string {
+	return *b.c.Mode
+}
+
+func (b *txBudgetConfig) KeyHourly() *assets.Wei {
+	return b.c.KeyHourly
+}
+
+func (b *txBudgetConfig) KeyDaily() *assets.Wei {
+	return b.c.KeyDaily
+}
+
+func (b *txBudgetConfig) JobHourly() *assets.Wei {
+	return b.c.JobHourly
+}
+
+func (b *txBudgetConfig) JobDaily() *assets.Wei {
+	return b.c.JobDaily
+}
@@ -14,10 +14,12 @@ const (
 	ChainGnosis          ChainType = "gnosis"
 	ChainHedera          ChainType = "hedera"
 	ChainKroma           ChainType = "kroma"
+	ChainLinea           ChainType = "linea"
 	ChainMantle          ChainType = "mantle"
 	ChainMetis           ChainType = "metis"
 	ChainOptimismBedrock ChainType = "optimismBedrock"
 	ChainScroll          ChainType = "scroll"
+	ChainTaiko           ChainType = "taiko"
 	ChainWeMix           ChainType = "wemix"
 	ChainXLayer          ChainType = "xlayer"
 	ChainZkEvm           ChainType = "zkevm"
@@ -38,7 +40,7 @@ func (c ChainType) IsL2() bool {
 
 func (c ChainType) IsValid() bool {
 	switch c {
-	case "", ChainArbitrum, ChainAstar, ChainCelo, ChainGnosis, ChainHedera, ChainKroma, ChainMantle, ChainMetis, ChainOptimismBedrock, ChainScroll, ChainWeMix, ChainXLayer, ChainZkEvm, ChainZkSync:
+	case "", ChainArbitrum, ChainAstar, ChainCelo, ChainGnosis, ChainHedera, ChainKroma, ChainLinea, ChainMantle, ChainMetis, ChainOptimismBedrock, ChainScroll, ChainTaiko, ChainWeMix, ChainXLayer, ChainZkEvm, ChainZkSync:
 		return true
 	}
 	return false
@@ -58,6 +60,8 @@ func FromSlug(slug string) ChainType {
 		return ChainHedera
 	case "kroma":
 		return ChainKroma
+	case "linea":
+		return ChainLinea
 	case "mantle":
 		return ChainMantle
 	case "metis":
@@ -66,6 +70,8 @@ func FromSlug(slug string) ChainType {
 		return ChainOptimismBedrock
 	case "scroll":
 		return ChainScroll
+	case "taiko":
+		return ChainTaiko
 	case "wemix":
 		return ChainWeMix
 	case "xlayer":
@@ -132,10 +138,12 @@ var ErrInvalid = fmt.Errorf("must be one of %s or omitted", strings.Join([]strin
 	string(ChainGnosis),
 	string(ChainHedera),
 	string(ChainKroma),
+	string(ChainLinea),
 	string(ChainMantle),
 	string(ChainMetis),
 	string(ChainOptimismBedrock),
 	string(ChainScroll),
+	string(ChainTaiko),
 	string(ChainWeMix),
 	string(ChainXLayer),
 	string(ChainZkEvm),
@@ -16,7 +16,9 @@ import (
 
 type EVM interface {
 	HeadTracker() HeadTracker
+	FinalityProvider() FinalityProvider
 	BalanceMonitor() BalanceMonitor
+	Funding() Funding
 	Transactions() Transactions
 	GasEstimator() GasEstimator
 	OCR() OCR
@@ -32,11 +34,14 @@ type EVM interface {
 	ChainType() chaintype.ChainType
 	FinalityDepth() uint32
 	FinalityTagEnabled() bool
+	FinalityBlockTag() string
+	FinalityHybridEnabled() bool
 	FlagsContractAddress() string
 	LinkContractAddress() string
 	LogBackfillBatchSize() uint32
 	LogKeepBlocksDepth() uint32
 	BackupLogPollerBlockDelay() uint64
+	LogSubscriptionEnabled() bool
 	LogPollInterval() time.Duration
 	LogPrunePageSize() uint32
 	MinContractPayment() *commonassets.Link
@@ -79,10 +84,24 @@ type HeadTracker interface {
 	PersistenceEnabled() bool
 }
 
+type FinalityProvider interface {
+	Type() string
+	L1Confirmations() uint32
+}
+
 type BalanceMonitor interface {
 	Enabled() bool
 }
 
+type Funding interface {
+	Enabled() bool
+	TreasuryKey() gethcommon.Address
+	MinBalance(addr gethcommon.Address) *assets.Wei
+	TargetBalance(addr gethcommon.Address) *assets.Wei
+	SweepEnabled() bool
+	SweepThreshold() *assets.Wei
+}
+
 type ClientErrors interface {
 	NonceTooLow() string
 	NonceTooHigh() string
@@ -108,7 +127,9 @@ type Transactions interface {
 	ReaperThreshold() time.Duration
 	MaxInFlight() uint32
 	MaxQueued() uint64
+	SimulateBeforeBroadcast() bool
 	AutoPurge() AutoPurgeConfig
+	Budget() TxBudget
 }
 
 type AutoPurgeConfig interface {
@@ -118,9 +139,21 @@ type AutoPurgeConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+// TxBudget caps the fees spent by each key and job over rolling windows. Zero limits are disabled.
+type TxBudget interface {
+	Enabled() bool
+	// Mode is either Reject or Defer
+	Mode() string
+	KeyHourly() *assets.Wei
+	KeyDaily() *assets.Wei
+	JobHourly() *assets.Wei
+	JobDaily() *assets.Wei
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	Mempool() Mempool
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -166,6 +199,11 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type Mempool interface {
+	Blocks() uint16
+	PollPeriod() time.Duration
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -184,6 +222,20 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	HedgedReads() HedgedReads
+	QuorumReads() QuorumReads
+}
+
+type HedgedReads interface {
+	Enabled() bool
+	LatencyPercentile() uint8
+	MinDelay() time.Duration
+}
+
+type QuorumReads interface {
+	Enabled() bool
+	Nodes() uint32
+	Quorum() uint32
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
@@ -344,6 +344,8 @@ type Chain struct {
 	ChainType                    *chaintype.Config
 	FinalityDepth                *uint32
 	FinalityTagEnabled           *bool
+	FinalityBlockTag             *string
+	FinalityHybridEnabled        *bool
 	FlagsContractAddress         *types.EIP55Address
 	LinkContractAddress          *types.EIP55Address
 	LogBackfillBatchSize         *uint32
@@ -351,6 +353,7 @@ type Chain struct {
 	LogKeepBlocksDepth           *uint32
 	LogPrunePageSize             *uint32
 	BackupLogPollerBlockDelay    *uint64
+	LogSubscriptionEnabled       *bool
 	MinIncomingConfirmations     *uint32
 	MinContractPayment           *commonassets.Link
 	NonceAutoSync                *bool
@@ -362,15 +365,17 @@ type Chain struct {
 	FinalizedBlockOffset         *uint32
 	NoNewFinalizedHeadsThreshold *commonconfig.Duration
 
-	Transactions   Transactions      `toml:",omitempty"`
-	BalanceMonitor BalanceMonitor    `toml:",omitempty"`
-	GasEstimator   GasEstimator      `toml:",omitempty"`
-	HeadTracker    HeadTracker       `toml:",omitempty"`
-	KeySpecific    KeySpecificConfig `toml:",omitempty"`
-	NodePool       NodePool          `toml:",omitempty"`
-	OCR            OCR               `toml:",omitempty"`
-	OCR2           OCR2              `toml:",omitempty"`
-	Workflow       Workflow          `toml:",omitempty"`
+	Transactions     Transactions      `toml:",omitempty"`
+	BalanceMonitor   BalanceMonitor    `toml:",omitempty"`
+	Funding          Funding           `toml:",omitempty"`
+	GasEstimator     GasEstimator      `toml:",omitempty"`
+	HeadTracker      HeadTracker       `toml:",omitempty"`
+	FinalityProvider FinalityProvider  `toml:",omitempty"`
+	KeySpecific      KeySpecificConfig `toml:",omitempty"`
+	NodePool         NodePool          `toml:",omitempty"`
+	OCR              OCR               `toml:",omitempty"`
+	OCR2             OCR2              `toml:",omitempty"`
+	Workflow         Workflow          `toml:",omitempty"`
 }
 
 func (c *Chain) ValidateConfig() (err error) {
@@ -387,6 +392,16 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FinalityDepth", Value: *c.FinalityDepth,
 			Msg: "must be greater than or equal to 1"})
 	}
+	switch *c.FinalityBlockTag {
+	case FinalityBlockTagFinalized, FinalityBlockTagSafe:
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FinalityBlockTag", Value: *c.FinalityBlockTag,
+			Msg: fmt.Sprintf("must be %s or %s", FinalityBlockTagFinalized, FinalityBlockTagSafe)})
+	}
+	if *c.FinalityProvider.Type == FinalityProviderArbitrumL1Batch && c.ChainType.ChainType() != chaintype.ChainArbitrum {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FinalityProvider.Type", Value: *c.FinalityProvider.Type,
+			Msg: fmt.Sprintf("must be used with ChainType %s", chaintype.ChainArbitrum)})
+	}
 	if *c.MinIncomingConfirmations < 1 {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "MinIncomingConfirmations", Value: *c.MinIncomingConfirmations,
 			Msg: "must be greater than or equal to 1"})
@@ -397,6 +412,16 @@ func (c *Chain) ValidateConfig() (err error) {
 			Msg: "must be greater than or equal to FinalizedBlockOffset"})
 	}
 
+	// Keys are swept down to their target balance, so a key specific one must be below the chain's sweep threshold.
+	if f := c.Funding; f.Enabled != nil && *f.Enabled && f.SweepEnabled != nil && *f.SweepEnabled && f.SweepThreshold != nil {
+		for _, k := range c.KeySpecific {
+			if t := k.Funding.TargetBalance; t != nil && t.Cmp(f.SweepThreshold) >= 0 {
+				err = multierr.Append(err, commonconfig.ErrInvalid{Name: "KeySpecific.Funding.TargetBalance", Value: t,
+					Msg: fmt.Sprintf("must be less than Funding.SweepThreshold for key %s", k.Key)})
+			}
+		}
+	}
+
 	// AutoPurge configs depend on ChainType so handling validation on per chain basis
 	if c.Transactions.AutoPurge.Enabled != nil && *c.Transactions.AutoPurge.Enabled {
 		chainType := c.ChainType.ChainType()
@@ -447,14 +472,16 @@ func (c *Chain) ValidateConfig() (err error) {
 }
 
 type Transactions struct {
-	ForwardersEnabled    *bool
-	MaxInFlight          *uint32
-	MaxQueued            *uint32
-	ReaperInterval       *commonconfig.Duration
-	ReaperThreshold      *commonconfig.Duration
-	ResendAfterThreshold *commonconfig.Duration
+	ForwardersEnabled       *bool
+	MaxInFlight             *uint32
+	MaxQueued               *uint32
+	ReaperInterval          *commonconfig.Duration
+	ReaperThreshold         *commonconfig.Duration
+	ResendAfterThreshold    *commonconfig.Duration
+	SimulateBeforeBroadcast *bool
 
 	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	Budget    TxBudget        `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -476,7 +503,11 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	if v := f.SimulateBeforeBroadcast; v != nil {
+		t.SimulateBeforeBroadcast = v
+	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.Budget.setFrom(&f.Budget)
 }
 
 type AutoPurgeConfig struct {
@@ -501,6 +532,50 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+type TxBudget struct {
+	Enabled   *bool
+	Mode      *string
+	KeyHourly *assets.Wei
+	KeyDaily  *assets.Wei
+	JobHourly *assets.Wei
+	JobDaily  *assets.Wei
+}
+
+func (b *TxBudget) setFrom(f *TxBudget) {
+	if v := f.Enabled; v != nil {
+		b.Enabled = v
+	}
+	if v := f.Mode; v != nil {
+		b.Mode = v
+	}
+	if v := f.KeyHourly; v != nil {
+		b.KeyHourly = v
+	}
+	if v := f.KeyDaily; v != nil {
+		b.KeyDaily = v
+	}
+	if v := f.JobHourly; v != nil {
+		b.JobHourly = v
+	}
+	if v := f.JobDaily; v != nil {
+		b.JobDaily = v
+	}
+}
+
+func (b *TxBudget) ValidateConfig() (err error) {
+	if b.Enabled == nil || !*b.Enabled {
+		return
+	}
+	if b.Mode != nil {
+		switch *b.Mode {
+		case "Reject", "Defer":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mode", Value: *b.Mode, Msg: "must be Reject or Defer"})
+		}
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -548,6 +623,55 @@ func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	}
 }
 
+type Funding struct {
+	Enabled        *bool
+	TreasuryKey    *types.EIP55Address
+	MinBalance     *assets.Wei
+	TargetBalance  *assets.Wei
+	SweepEnabled   *bool
+	SweepThreshold *assets.Wei
+}
+
+func (f *Funding) setFrom(o *Funding) {
+	if v := o.Enabled; v != nil {
+		f.Enabled = v
+	}
+	if v := o.TreasuryKey; v != nil {
+		f.TreasuryKey = v
+	}
+	if v := o.MinBalance; v != nil {
+		f.MinBalance = v
+	}
+	if v := o.TargetBalance; v != nil {
+		f.TargetBalance = v
+	}
+	if v := o.SweepEnabled; v != nil {
+		f.SweepEnabled = v
+	}
+	if v := o.SweepThreshold; v != nil {
+		f.SweepThreshold = v
+	}
+}
+
+func (f *Funding) ValidateConfig() (err error) {
+	if f.Enabled == nil || !*f.Enabled {
+		return
+	}
+	if f.TreasuryKey == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "TreasuryKey", Msg: "must be set when Enabled"})
+	}
+	if f.MinBalance != nil && f.TargetBalance != nil && f.TargetBalance.Cmp(f.MinBalance) <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TargetBalance", Value: f.TargetBalance,
+			Msg: "must be greater than MinBalance"})
+	}
+	if f.SweepEnabled != nil && *f.SweepEnabled && f.SweepThreshold != nil && f.TargetBalance != nil &&
+		f.SweepThreshold.Cmp(f.TargetBalance) <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "SweepThreshold", Value: f.SweepThreshold,
+			Msg: "must be greater than TargetBalance"})
+	}
+	return
+}
+
 type GasEstimator struct {
 	Mode *string
 
@@ -575,6 +699,7 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Mempool      MempoolEstimator      `toml:",omitempty"`
 }
 
 func (e *GasEstimator) ValidateConfig() (err error) {
@@ -611,6 +736,10 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "Mempool" && *e.Mempool.Blocks <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mempool.Blocks", Value: *e.Mempool.Blocks,
+			Msg: "must be greater than or equal to 1 with Mempool Mode"})
+	}
 
 	return
 }
@@ -670,6 +799,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Mempool.setFrom(&f.Mempool)
 }
 
 type GasLimitJobType struct {
@@ -742,6 +872,20 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+type MempoolEstimator struct {
+	Blocks     *uint16
+	PollPeriod *commonconfig.Duration
+}
+
+func (u *MempoolEstimator) setFrom(f *MempoolEstimator) {
+	if v := f.Blocks; v != nil {
+		u.Blocks = v
+	}
+	if v := f.PollPeriod; v != nil {
+		u.PollPeriod = v
+	}
+}
+
 type KeySpecificConfig []KeySpecific
 
 func (ks KeySpecificConfig) ValidateConfig() (err error) {
@@ -753,6 +897,10 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 		} else {
 			addrs[addr] = struct{}{}
 		}
+		if f := k.Funding; f.MinBalance != nil && f.TargetBalance != nil && f.TargetBalance.Cmp(f.MinBalance) <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Funding.TargetBalance", Value: f.TargetBalance,
+				Msg: fmt.Sprintf("must be greater than Funding.MinBalance for key %s", addr)})
+		}
 	}
 	return
 }
@@ -760,6 +908,7 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 type KeySpecific struct {
 	Key          *types.EIP55Address
 	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	Funding      KeySpecificFunding      `toml:",omitempty"`
 }
 
 type KeySpecificGasEstimator struct {
@@ -772,6 +921,20 @@ func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	}
 }
 
+type KeySpecificFunding struct {
+	MinBalance    *assets.Wei
+	TargetBalance *assets.Wei
+}
+
+func (e *KeySpecificFunding) setFrom(f *KeySpecificFunding) {
+	if v := f.MinBalance; v != nil {
+		e.MinBalance = v
+	}
+	if v := f.TargetBalance; v != nil {
+		e.TargetBalance = v
+	}
+}
+
 type HeadTracker struct {
 	HistoryDepth            *uint32
 	MaxBufferSize           *uint32
@@ -812,6 +975,42 @@ func (t *HeadTracker) ValidateConfig() (err error) {
 	return
 }
 
+const (
+	FinalityBlockTagFinalized = "finalized"
+	FinalityBlockTagSafe      = "safe"
+
+	FinalityProviderArbitrumL1Batch = "ArbitrumL1Batch"
+)
+
+type FinalityProvider struct {
+	Type            *string
+	L1Confirmations *uint32
+}
+
+func (p *FinalityProvider) setFrom(f *FinalityProvider) {
+	if v := f.Type; v != nil {
+		p.Type = v
+	}
+	if v := f.L1Confirmations; v != nil {
+		p.L1Confirmations = v
+	}
+}
+
+func (p *FinalityProvider) ValidateConfig() (err error) {
+	switch *p.Type {
+	case "":
+	case FinalityProviderArbitrumL1Batch:
+		if *p.L1Confirmations < 1 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "L1Confirmations", Value: *p.L1Confirmations,
+				Msg: "must be greater than or equal to 1"})
+		}
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Type", Value: *p.Type,
+			Msg: fmt.Sprintf("must be empty or %s", FinalityProviderArbitrumL1Batch)})
+	}
+	return
+}
+
 type ClientErrors struct {
 	NonceTooLow                       *string `toml:",omitempty"`
 	NonceTooHigh                      *string `toml:",omitempty"`
@@ -891,6 +1090,8 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	HedgedReads                HedgedReads `toml:",omitempty"`
+	QuorumReads                QuorumReads `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -929,6 +1130,63 @@ func (p *NodePool) setFrom(f *NodePool) {
 	}
 
 	p.Errors.setFrom(&f.Errors)
+	p.HedgedReads.setFrom(&f.HedgedReads)
+	p.QuorumReads.setFrom(&f.QuorumReads)
+}
+
+type HedgedReads struct {
+	Enabled           *bool
+	LatencyPercentile *uint8
+	MinDelay          *commonconfig.Duration
+}
+
+func (h *HedgedReads) setFrom(f *HedgedReads) {
+	if v := f.Enabled; v != nil {
+		h.Enabled = v
+	}
+	if v := f.LatencyPercentile; v != nil {
+		h.LatencyPercentile = v
+	}
+	if v := f.MinDelay; v != nil {
+		h.MinDelay = v
+	}
+}
+
+func (h *HedgedReads) ValidateConfig() (err error) {
+	if h.LatencyPercentile != nil && (*h.LatencyPercentile < 1 || *h.LatencyPercentile > 99) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "LatencyPercentile", Value: *h.LatencyPercentile,
+			Msg: "must be between 1 and 99"})
+	}
+	return
+}
+
+type QuorumReads struct {
+	Enabled *bool
+	Nodes   *uint32
+	Quorum  *uint32
+}
+
+func (q *QuorumReads) setFrom(f *QuorumReads) {
+	if v := f.Enabled; v != nil {
+		q.Enabled = v
+	}
+	if v := f.Nodes; v != nil {
+		q.Nodes = v
+	}
+	if v := f.Quorum; v != nil {
+		q.Quorum = v
+	}
+}
+
+func (q *QuorumReads) ValidateConfig() (err error) {
+	if q.Quorum != nil && *q.Quorum == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Quorum", Value: *q.Quorum, Msg: "must be greater than 0"})
+	}
+	if q.Nodes != nil && q.Quorum != nil && *q.Quorum > *q.Nodes {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Quorum", Value: *q.Quorum,
+			Msg: fmt.Sprintf("must not be greater than Nodes (%d)", *q.Nodes)})
+	}
+	return
 }
 
 type OCR struct {
@@ -188,6 +188,12 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.FinalityTagEnabled; v != nil {
 		c.FinalityTagEnabled = v
 	}
+	if v := f.FinalityBlockTag; v != nil {
+		c.FinalityBlockTag = v
+	}
+	if v := f.FinalityHybridEnabled; v != nil {
+		c.FinalityHybridEnabled = v
+	}
 	if v := f.FlagsContractAddress; v != nil {
 		c.FlagsContractAddress = v
 	}
@@ -209,6 +215,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.BackupLogPollerBlockDelay; v != nil {
 		c.BackupLogPollerBlockDelay = v
 	}
+	if v := f.LogSubscriptionEnabled; v != nil {
+		c.LogSubscriptionEnabled = v
+	}
 	if v := f.MinIncomingConfirmations; v != nil {
 		c.MinIncomingConfirmations = v
 	}
@@ -243,6 +252,7 @@ func (c *Chain) SetFrom(f *Chain) {
 
 	c.Transactions.setFrom(&f.Transactions)
 	c.BalanceMonitor.setFrom(&f.BalanceMonitor)
+	c.Funding.setFrom(&f.Funding)
 	c.GasEstimator.setFrom(&f.GasEstimator)
 
 	if ks := f.KeySpecific; ks != nil {
@@ -252,11 +262,13 @@ func (c *Chain) SetFrom(f *Chain) {
 				c.KeySpecific = append(c.KeySpecific, v)
 			} else {
 				c.KeySpecific[i].GasEstimator.setFrom(&v.GasEstimator)
+				c.KeySpecific[i].Funding.setFrom(&v.Funding)
 			}
 		}
 	}
 
 	c.HeadTracker.setFrom(&f.HeadTracker)
+	c.FinalityProvider.setFrom(&f.FinalityProvider)
 	c.NodePool.setFrom(&f.NodePool)
 	c.OCR.setFrom(&f.OCR)
 	c.OCR2.setFrom(&f.OCR2)
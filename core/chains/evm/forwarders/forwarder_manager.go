@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	evmlogpoller "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -261,45 +262,72 @@ func (f *FwdMgr) getCachedSenders(addr common.Address) ([]common.Address, bool)
 	return addrs, ok
 }
 
+// runLoop handles the confirmed auth changes of the forwarders. The log poller notifies of new auth changes as soon as
+// they are saved, so that the db is only queried while there are auth changes to be handled. If the notifications are
+// unavailable, e.g. because the subscription lagged, the db is queried on every tick until it can resubscribe.
 func (f *FwdMgr) runLoop(ctx context.Context) {
 	ticker := services.NewTicker(time.Minute)
 	defer ticker.Stop()
 
+	var sub *evmlogpoller.LogSubscription
+	defer func() {
+		if sub != nil {
+			sub.Close()
+		}
+	}()
+	// pending is set while auth changes up to notifiedBlock may not have been handled yet
+	var pending bool
+	var notifiedBlock int64
+
 	for {
+		var events <-chan evmlogpoller.LogEvent
+		if sub != nil {
+			events = sub.Events()
+		}
+
 		select {
+		case event, ok := <-events:
+			if !ok {
+				f.logger.Warnw("Auth change notifications closed, polling for auth changes", "err", sub.Err())
+				sub = nil
+				pending = true
+				continue
+			}
+			if event.ReorgedFromBlock > 0 {
+				// the auth changes of the canonical blocks are notified again
+				notifiedBlock = min(notifiedBlock, event.ReorgedFromBlock-1)
+			}
+			for _, log := range event.Logs {
+				if _, tracked := f.getCachedSenders(log.Address); tracked {
+					notifiedBlock = max(notifiedBlock, log.BlockNumber)
+					pending = true
+				}
+			}
+
 		case <-ticker.C:
 			if err := f.logpoller.Ready(); err != nil {
 				f.logger.Warnw("Skipping log syncing", "err", err)
 				continue
 			}
 
-			addrs := f.collectAddresses()
-			if len(addrs) == 0 {
-				f.logger.Debug("Skipping log syncing, no forwarders tracked.")
+			if sub == nil {
+				var err error
+				sub, err = f.logpoller.SubscribeToExpressions([]query.Expression{evmlogpoller.NewEventSigFilter(authChangedTopic)})
+				if err != nil {
+					f.logger.Debugw("Unable to subscribe to auth change notifications, polling for auth changes", "err", err)
+				}
+				// auth changes saved before subscribing are not notified
+				pending = true
+			}
+			if !pending {
 				continue
 			}
 
-			logs, err := f.logpoller.LatestLogEventSigsAddrsWithConfs(
-				ctx,
-				f.latestBlock,
-				[]common.Hash{authChangedTopic},
-				addrs,
-				evmtypes.Confirmations(f.cfg.FinalityDepth()),
-			)
-			if err != nil {
+			if err := f.syncAuthChanges(ctx); err != nil {
 				f.logger.Errorw("Failed to retrieve latest log round", "err", err)
 				continue
 			}
-			if len(logs) == 0 {
-				f.logger.Debugf("Empty auth update round for addrs: %s, skipping", addrs)
-				continue
-			}
-			f.logger.Debugf("Handling new %d auth updates", len(logs))
-			for _, log := range logs {
-				if err = f.handleAuthChange(log); err != nil {
-					f.logger.Warnw("Error handling auth change", "TxHash", log.TxHash, "err", err)
-				}
-			}
+			pending = sub == nil || f.latestBlock < notifiedBlock
 
 		case <-ctx.Done():
 			return
@@ -307,6 +335,37 @@ func (f *FwdMgr) runLoop(ctx context.Context) {
 	}
 }
 
+// syncAuthChanges handles the confirmed auth changes of the forwarders from the latest handled block on.
+func (f *FwdMgr) syncAuthChanges(ctx context.Context) error {
+	addrs := f.collectAddresses()
+	if len(addrs) == 0 {
+		f.logger.Debug("Skipping log syncing, no forwarders tracked.")
+		return nil
+	}
+
+	logs, err := f.logpoller.LatestLogEventSigsAddrsWithConfs(
+		ctx,
+		f.latestBlock,
+		[]common.Hash{authChangedTopic},
+		addrs,
+		evmtypes.Confirmations(f.cfg.FinalityDepth()),
+	)
+	if err != nil {
+		return err
+	}
+	if len(logs) == 0 {
+		f.logger.Debugf("Empty auth update round for addrs: %s, skipping", addrs)
+		return nil
+	}
+	f.logger.Debugf("Handling new %d auth updates", len(logs))
+	for _, log := range logs {
+		if err = f.handleAuthChange(log); err != nil {
+			f.logger.Warnw("Error handling auth change", "TxHash", log.TxHash, "err", err)
+		}
+	}
+	return nil
+}
+
 func (f *FwdMgr) handleAuthChange(log evmlogpoller.Log) error {
 	if f.latestBlock > log.BlockNumber {
 		return nil
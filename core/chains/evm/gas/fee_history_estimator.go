@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
@@ -50,6 +51,12 @@ var (
 	},
 		[]string{"evmChainID"},
 	)
+	promFeeHistoryEstimatorBlobBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "blob_base_fee_updater",
+		Help: "Sets latest blob BaseFee (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
 )
 
 const (
@@ -70,6 +77,7 @@ type FeeHistoryEstimatorConfig struct {
 type feeHistoryEstimatorClient interface {
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 	FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error)
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
 }
 
 type FeeHistoryEstimator struct {
@@ -425,6 +433,66 @@ func LimitBumpedFee(originalFee *assets.Wei, currentFee *assets.Wei, bumpedFee *
 	return bumpedFee, nil
 }
 
+// GetBlobFee fetches the latest blob base fee and adds a buffer on top of it, to catch fluctuations in the next blocks.
+// Blob fees are only needed by blob transactions, so they are fetched on demand instead of being cached.
+func (f *FeeHistoryEstimator) GetBlobFee(ctx context.Context, maxPrice *assets.Wei) (*assets.Wei, error) {
+	blobBaseFee, err := f.RefreshBlobBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFeePerBlobGas := blobBaseFee.AddPercentage(BaseFeeBufferPercentage)
+	if maxFeePerBlobGas.Cmp(maxPrice) > 0 {
+		f.logger.Warnf("estimated maxFeePerBlobGas: %v is greater than the maximum price configured: %v, returning the maximum price instead.",
+			maxFeePerBlobGas, maxPrice)
+		return maxPrice, nil
+	}
+	return maxFeePerBlobGas, nil
+}
+
+// RefreshBlobBaseFee uses eth_blobBaseFee to fetch the blob base fee of the next block.
+func (f *FeeHistoryEstimator) RefreshBlobBaseFee(ctx context.Context) (*assets.Wei, error) {
+	ctx, cancel := f.stopCh.Ctx(ctx)
+	defer cancel()
+
+	var blobBaseFee hexutil.Big
+	if err := f.client.CallContext(ctx, &blobBaseFee, "eth_blobBaseFee"); err != nil {
+		return nil, fmt.Errorf("failed to fetch blob base fee: %w", err)
+	}
+
+	promFeeHistoryEstimatorBlobBaseFee.WithLabelValues(f.chainID.String()).Set(float64(blobBaseFee.ToInt().Int64()))
+
+	blobBaseFeeWei := assets.NewWei(blobBaseFee.ToInt())
+	f.logger.Debugf("fetched new blob base fee: %v", blobBaseFeeWei)
+	return blobBaseFeeWei, nil
+}
+
+// BumpBlobFee provides a bumped maxFeePerBlobGas by bumping the previous one by BlobFeeBumpPercentage, which is the minimum bump
+// blob pools accept for replacement blob transactions. It aggregates the market, bumped, and max price to provide a correct value.
+func (f *FeeHistoryEstimator) BumpBlobFee(ctx context.Context, originalFee *assets.Wei, maxPrice *assets.Wei) (*assets.Wei, error) {
+	// Sanitize original fee input
+	if originalFee == nil || originalFee.Cmp(maxPrice) >= 0 {
+		return nil, fmt.Errorf("%w: error while retrieving original blob fee: originalFeePerBlobGas: %s. Maximum price configured: %s",
+			commonfee.ErrBump, originalFee, maxPrice)
+	}
+
+	currentBlobFee, err := f.GetBlobFee(ctx, maxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	minBumpedFee := originalFee.AddPercentage(BlobFeeBumpPercentage)
+	bumpedFee := assets.WeiMin(assets.WeiMax(currentBlobFee, minBumpedFee), maxPrice)
+	if bumpedFee.Cmp(minBumpedFee) < 0 {
+		return nil, fmt.Errorf("%w: %s is bumped less than minimum allowed percentage(%s) from originalFeePerBlobGas: %s - maxPrice: %s",
+			commonfee.ErrBump, bumpedFee, strconv.Itoa(BlobFeeBumpPercentage), originalFee, maxPrice)
+	}
+
+	f.logger.Debugw("bumped blob fee", "originalFeePerBlobGas", originalFee, "marketFeePerBlobGas", currentBlobFee, "bumpedFeePerBlobGas", bumpedFee)
+
+	return bumpedFee, nil
+}
+
 func (f *FeeHistoryEstimator) getPriorityFeeThreshold() (*assets.Wei, error) {
 	f.priorityFeeThresholdMu.RLock()
 	defer f.priorityFeeThresholdMu.RUnlock()
@@ -1,11 +1,13 @@
 package gas_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
@@ -511,3 +514,95 @@ func TestFeeHistoryEstimatorBumpDynamicFee(t *testing.T) {
 		assert.Equal(t, maxFeePerGas, bumpedFee.FeeCap)
 	})
 }
+
+func TestFeeHistoryEstimatorGetBlobFee(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.NewWeiI(100)
+	chainID := big.NewInt(0)
+	cfg := gas.FeeHistoryEstimatorConfig{}
+
+	t.Run("fetches the blob base fee and adds a buffer", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Big) = hexutil.Big(*big.NewInt(10))
+		}).Return(nil).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		blobFee, err := u.GetBlobFee(tests.Context(t), maxPrice)
+		assert.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10).AddPercentage(gas.BaseFeeBufferPercentage), blobFee)
+	})
+
+	t.Run("will return max price if the estimation exceeds it", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Big) = hexutil.Big(*big.NewInt(90))
+		}).Return(nil).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		blobFee, err := u.GetBlobFee(tests.Context(t), maxPrice)
+		assert.NoError(t, err)
+		assert.Equal(t, maxPrice, blobFee)
+	})
+
+	t.Run("fails if the RPC doesn't support blob fees", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Return(errors.New("method not found")).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.GetBlobFee(tests.Context(t), maxPrice)
+		assert.ErrorContains(t, err, "failed to fetch blob base fee: method not found")
+	})
+}
+
+func TestFeeHistoryEstimatorBumpBlobFee(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.NewWeiI(100)
+	chainID := big.NewInt(0)
+	cfg := gas.FeeHistoryEstimatorConfig{}
+
+	t.Run("bumps a previous attempt by BlobFeeBumpPercentage", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Big) = hexutil.Big(*big.NewInt(5))
+		}).Return(nil).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		blobFee, err := u.BumpBlobFee(tests.Context(t), assets.NewWeiI(20), maxPrice)
+		assert.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(40), blobFee)
+	})
+
+	t.Run("returns market blob fee if it's higher", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Big) = hexutil.Big(*big.NewInt(50))
+		}).Return(nil).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		blobFee, err := u.BumpBlobFee(tests.Context(t), assets.NewWeiI(20), maxPrice)
+		assert.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50).AddPercentage(gas.BaseFeeBufferPercentage), blobFee)
+	})
+
+	t.Run("fails if the bumped blob fee exceeds max price", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Big) = hexutil.Big(*big.NewInt(5))
+		}).Return(nil).Once()
+
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.BumpBlobFee(tests.Context(t), assets.NewWeiI(60), maxPrice)
+		assert.ErrorIs(t, err, commonfee.ErrBump)
+	})
+
+	t.Run("fails if the original blob fee is invalid", func(t *testing.T) {
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), nil, cfg, chainID, nil)
+		_, err := u.BumpBlobFee(tests.Context(t), nil, maxPrice)
+		assert.ErrorIs(t, err, commonfee.ErrBump)
+		_, err = u.BumpBlobFee(tests.Context(t), maxPrice, maxPrice)
+		assert.ErrorIs(t, err, commonfee.ErrBump)
+	})
+}
@@ -0,0 +1,310 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+)
+
+// metrics are thread safe
+var (
+	promMempoolEstimatorGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mempool_estimator_gas_price",
+		Help: "Sets latest predicted inclusion gas price (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+	promMempoolEstimatorTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mempool_estimator_tip_cap",
+		Help: "Sets latest predicted inclusion maxPriorityFeePerGas (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+)
+
+type MempoolEstimatorConfig struct {
+	FeeHistoryEstimatorConfig
+
+	// Blocks is the number of upcoming blocks the transactions should be included within
+	Blocks uint64
+	// PollPeriod is how often the mempool is inspected
+	PollPeriod time.Duration
+}
+
+// mempoolTx is a pending transaction of the mempool as returned by txpool_content and eth_getBlockByNumber
+type mempoolTx struct {
+	Gas                  hexutil.Uint64 `json:"gas"`
+	GasPrice             *hexutil.Big   `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+}
+
+type mempoolBlock struct {
+	GasLimit      hexutil.Uint64 `json:"gasLimit"`
+	BaseFeePerGas *hexutil.Big   `json:"baseFeePerGas"`
+	Transactions  []mempoolTx    `json:"transactions"`
+}
+
+// mempoolPrediction is the price of the cheapest transaction still included within the next Blocks blocks
+type mempoolPrediction struct {
+	gasPrice *assets.Wei
+	tipCap   *assets.Wei
+}
+
+// MempoolEstimator predicts the price to be included within the next Blocks blocks by ordering the pending transactions
+// of the mempool by their effective tip, like block builders do. The mempool is polled every PollPeriod and read from
+// the pending block; the whole txpool_content is only fetched if txpool_status reports more pending transactions than
+// the pending block holds. The FeeHistoryEstimator provides the price if the mempool isn't congested or can't be
+// inspected, and the predictions only ever raise it.
+type MempoolEstimator struct {
+	services.StateMachine
+	*FeeHistoryEstimator
+
+	client  feeHistoryEstimatorClient
+	logger  logger.Logger
+	config  MempoolEstimatorConfig
+	chainID *big.Int
+
+	predictionMu sync.RWMutex
+	prediction   *mempoolPrediction
+
+	wg     *sync.WaitGroup
+	stopCh services.StopChan
+}
+
+func NewMempoolEstimator(lggr logger.Logger, client feeHistoryEstimatorClient, cfg MempoolEstimatorConfig, chainID *big.Int, l1Oracle rollups.L1Oracle) *MempoolEstimator {
+	return &MempoolEstimator{
+		FeeHistoryEstimator: NewFeeHistoryEstimator(lggr, client, cfg.FeeHistoryEstimatorConfig, chainID, l1Oracle),
+		client:              client,
+		logger:              logger.Named(lggr, "MempoolEstimator"),
+		config:              cfg,
+		chainID:             chainID,
+		wg:                  new(sync.WaitGroup),
+		stopCh:              make(chan struct{}),
+	}
+}
+
+func (m *MempoolEstimator) Start(ctx context.Context) error {
+	return m.StartOnce("MempoolEstimator", func() error {
+		if m.config.Blocks == 0 {
+			return fmt.Errorf("Blocks: must be greater than or equal to 1")
+		}
+		if m.config.PollPeriod <= 0 {
+			return fmt.Errorf("PollPeriod: must be greater than 0")
+		}
+		if err := m.FeeHistoryEstimator.Start(ctx); err != nil {
+			return err
+		}
+		m.wg.Add(1)
+		go m.run()
+
+		return nil
+	})
+}
+
+func (m *MempoolEstimator) Close() error {
+	return m.StopOnce("MempoolEstimator", func() error {
+		close(m.stopCh)
+		m.wg.Wait()
+		return m.FeeHistoryEstimator.Close()
+	})
+}
+
+func (m *MempoolEstimator) run() {
+	defer m.wg.Done()
+
+	t := services.TickerConfig{
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(m.config.PollPeriod)
+
+	for {
+		select {
+		case <-m.stopCh:
+			return
+		case <-t.C:
+			if err := m.RefreshPrediction(); err != nil {
+				m.logger.Warnw("failed to inspect the mempool, falling back to FeeHistory prices", "err", err)
+			}
+		}
+	}
+}
+
+// GetLegacyGas returns the gas price of the FeeHistoryEstimator, raised to the predicted inclusion gas price if the
+// mempool is congested.
+func (m *MempoolEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint64, maxPrice *assets.Wei, opts ...feetypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	gasPrice, chainSpecificGasLimit, err = m.FeeHistoryEstimator.GetLegacyGas(ctx, calldata, gasLimit, maxPrice, opts...)
+	if err != nil {
+		return
+	}
+
+	prediction := m.getPrediction()
+	if prediction == nil || prediction.gasPrice.Cmp(gasPrice) <= 0 {
+		return
+	}
+	if prediction.gasPrice.Cmp(maxPrice) > 0 {
+		m.logger.Warnf("predicted gas price: %s is greater than the maximum gas price configured: %s, returning the maximum price instead.", prediction.gasPrice, maxPrice)
+		return maxPrice, chainSpecificGasLimit, nil
+	}
+	m.logger.Debugw("raising gas price to the predicted inclusion price", "feeHistoryGasPrice", gasPrice, "predictedGasPrice", prediction.gasPrice)
+	return prediction.gasPrice, chainSpecificGasLimit, nil
+}
+
+// GetDynamicFee returns the dynamic fee of the FeeHistoryEstimator, with its maxPriorityFeePerGas raised to the predicted
+// inclusion tip if the mempool is congested. The maxFeePerGas is raised by the same amount to keep its base fee buffer.
+func (m *MempoolEstimator) GetDynamicFee(ctx context.Context, maxPrice *assets.Wei) (fee DynamicFee, err error) {
+	if fee, err = m.FeeHistoryEstimator.GetDynamicFee(ctx, maxPrice); err != nil {
+		return
+	}
+
+	prediction := m.getPrediction()
+	if prediction == nil || prediction.tipCap.Cmp(fee.TipCap) <= 0 {
+		return
+	}
+	m.logger.Debugw("raising maxPriorityFeePerGas to the predicted inclusion tip", "feeHistoryFee", fee, "predictedTipCap", prediction.tipCap)
+	fee = DynamicFee{
+		FeeCap: fee.FeeCap.Add(prediction.tipCap.Sub(fee.TipCap)),
+		TipCap: prediction.tipCap,
+	}
+
+	if fee.FeeCap.Cmp(maxPrice) > 0 {
+		m.logger.Warnf("predicted maxFeePerGas: %v is greater than the maximum price configured: %v, returning the maximum price instead.",
+			fee.FeeCap, maxPrice)
+		fee.FeeCap = maxPrice
+		if fee.TipCap.Cmp(maxPrice) > 0 {
+			m.logger.Warnf("predicted maxPriorityFeePerGas: %v is greater than the maximum price configured: %v, returning the maximum price instead.",
+				fee.TipCap, maxPrice)
+			fee.TipCap = maxPrice
+		}
+	}
+	return
+}
+
+// RefreshPrediction inspects the mempool and caches the price of the cheapest pending transaction still included
+// within the next Blocks blocks. The prediction is cleared if the pending transactions fit in these blocks, or if the
+// mempool can't be inspected.
+func (m *MempoolEstimator) RefreshPrediction() error {
+	ctx, cancel := m.stopCh.CtxCancel(evmclient.ContextWithDefaultTimeout())
+	defer cancel()
+
+	prediction, err := m.predict(ctx)
+
+	m.predictionMu.Lock()
+	m.prediction = prediction
+	m.predictionMu.Unlock()
+
+	if prediction != nil {
+		promMempoolEstimatorGasPrice.WithLabelValues(m.chainID.String()).Set(float64(prediction.gasPrice.Int64()))
+		promMempoolEstimatorTipCap.WithLabelValues(m.chainID.String()).Set(float64(prediction.tipCap.Int64()))
+	}
+	return err
+}
+
+func (m *MempoolEstimator) predict(ctx context.Context) (*mempoolPrediction, error) {
+	var pending mempoolBlock
+	if err := m.client.CallContext(ctx, &pending, "eth_getBlockByNumber", "pending", true); err != nil {
+		return nil, fmt.Errorf("failed to fetch pending block: %w", err)
+	}
+
+	txs := pending.Transactions
+	// txpool_content can be very large, only fetch it if the pending block doesn't hold the whole mempool
+	var status struct {
+		Pending hexutil.Uint64 `json:"pending"`
+	}
+	if err := m.client.CallContext(ctx, &status, "txpool_status"); err != nil {
+		m.logger.Debugw("txpool_status is not supported, inspecting the pending block instead", "err", err)
+	} else if uint64(status.Pending) > uint64(len(pending.Transactions)) {
+		var content struct {
+			Pending map[string]map[string]mempoolTx `json:"pending"`
+		}
+		if err := m.client.CallContext(ctx, &content, "txpool_content"); err != nil {
+			m.logger.Debugw("txpool_content is not supported, inspecting the pending block instead", "err", err)
+		} else {
+			txs = nil
+			for _, nonces := range content.Pending {
+				for _, tx := range nonces {
+					txs = append(txs, tx)
+				}
+			}
+		}
+	}
+
+	prediction := predictInclusionPrice(txs, (*big.Int)(pending.BaseFeePerGas), m.config.Blocks*uint64(pending.GasLimit))
+	if prediction == nil {
+		m.logger.Debugw("mempool is not congested", "pendingTxs", len(txs), "blocks", m.config.Blocks)
+		return nil, nil
+	}
+	m.logger.Debugw("predicted inclusion price", "pendingTxs", len(txs), "blocks", m.config.Blocks,
+		"gasPrice", prediction.gasPrice, "tipCap", prediction.tipCap)
+	return prediction, nil
+}
+
+// predictInclusionPrice orders the transactions by their effective tip at the given base fee and returns the price of
+// the first one exceeding the gas capacity, or nil if they all fit.
+func predictInclusionPrice(txs []mempoolTx, baseFee *big.Int, capacity uint64) *mempoolPrediction {
+	type includableTx struct {
+		gas      uint64
+		gasPrice *big.Int
+		tip      *big.Int
+	}
+	if baseFee == nil {
+		baseFee = big.NewInt(0)
+	}
+
+	includable := make([]includableTx, 0, len(txs))
+	for _, tx := range txs {
+		var tip *big.Int
+		switch {
+		case tx.MaxFeePerGas != nil && tx.MaxPriorityFeePerGas != nil:
+			tip = new(big.Int).Sub(tx.MaxFeePerGas.ToInt(), baseFee)
+			if tip.Cmp(tx.MaxPriorityFeePerGas.ToInt()) > 0 {
+				tip = tx.MaxPriorityFeePerGas.ToInt()
+			}
+		case tx.GasPrice != nil:
+			tip = new(big.Int).Sub(tx.GasPrice.ToInt(), baseFee)
+		default:
+			continue
+		}
+		// not includable until the base fee drops
+		if tip.Sign() < 0 {
+			continue
+		}
+		includable = append(includable, includableTx{gas: uint64(tx.Gas), gasPrice: new(big.Int).Add(baseFee, tip), tip: tip})
+	}
+
+	slices.SortStableFunc(includable, func(a, b includableTx) int { return b.tip.Cmp(a.tip) })
+
+	var gas uint64
+	for _, tx := range includable {
+		gas += tx.gas
+		if gas > capacity {
+			return &mempoolPrediction{gasPrice: assets.NewWei(tx.gasPrice), tipCap: assets.NewWei(tx.tip)}
+		}
+	}
+	return nil
+}
+
+func (m *MempoolEstimator) getPrediction() *mempoolPrediction {
+	m.predictionMu.RLock()
+	defer m.predictionMu.RUnlock()
+	return m.prediction
+}
+
+func (m *MempoolEstimator) Name() string { return m.logger.Name() }
+func (m *MempoolEstimator) HealthReport() map[string]error {
+	return map[string]error{m.Name(): m.Healthy(), m.FeeHistoryEstimator.Name(): nil}
+}
@@ -0,0 +1,277 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+// pending block with a 100k gas limit and a base fee of 10 wei, overflowing with a 120k gas transaction tipping 5 wei
+const mempoolPendingBlock = `{"gasLimit":"0x186a0","baseFeePerGas":"0xa","transactions":[
+	{"gas":"0x1d4c0","maxFeePerGas":"0x64","maxPriorityFeePerGas":"0x5"}
+]}`
+
+// 180k gas of includable transactions at the base fee of 10 wei, with effective tips of 40, 30, 20, 15 (capped by the
+// maxFeePerGas) and 5. The last transaction is not includable.
+const mempoolTxpoolContent = `{"pending":{
+	"0x0000000000000000000000000000000000000001":{
+		"0":{"gas":"0xc350","gasPrice":"0x32"},
+		"1":{"gas":"0x7530","gasPrice":"0x28"}
+	},
+	"0x0000000000000000000000000000000000000002":{
+		"0":{"gas":"0x9c40","maxFeePerGas":"0x64","maxPriorityFeePerGas":"0x14"},
+		"1":{"gas":"0x7530","maxFeePerGas":"0x19","maxPriorityFeePerGas":"0x64"},
+		"2":{"gas":"0x7530","maxFeePerGas":"0x64","maxPriorityFeePerGas":"0x5"},
+		"3":{"gas":"0x7530","maxFeePerGas":"0x5","maxPriorityFeePerGas":"0x5"}
+	}
+}}`
+
+// the 6 transactions of mempoolTxpoolContent outnumber the pending block
+const mempoolTxpoolStatus = `{"pending":"0x6","queued":"0x0"}`
+
+// mockMempool mocks the pending block and txpool_status, and txpool_content if it is given
+func mockMempool(t *testing.T, client *mocks.FeeHistoryEstimatorClient, pendingBlock string, txpoolStatus string, txpoolContent string, txpoolErr error) {
+	client.On("CallContext", mock.Anything, mock.Anything, "eth_getBlockByNumber", "pending", true).Return(nil).Run(func(args mock.Arguments) {
+		require.NoError(t, json.Unmarshal([]byte(pendingBlock), args.Get(1)))
+	}).Once()
+	client.On("CallContext", mock.Anything, mock.Anything, "txpool_status").Return(txpoolErr).Run(func(args mock.Arguments) {
+		if txpoolErr == nil {
+			require.NoError(t, json.Unmarshal([]byte(txpoolStatus), args.Get(1)))
+		}
+	}).Once()
+	if txpoolErr == nil && txpoolContent != "" {
+		client.On("CallContext", mock.Anything, mock.Anything, "txpool_content").Return(nil).Run(func(args mock.Arguments) {
+			require.NoError(t, json.Unmarshal([]byte(txpoolContent), args.Get(1)))
+		}).Once()
+	}
+}
+
+func TestMempoolEstimatorLifecycle(t *testing.T) {
+	t.Parallel()
+	chainID := big.NewInt(0)
+
+	t.Run("fails to start if Blocks is zero", func(t *testing.T) {
+		cfg := gas.MempoolEstimatorConfig{
+			FeeHistoryEstimatorConfig: gas.FeeHistoryEstimatorConfig{BumpPercent: 20, RewardPercentile: 10, CacheTimeout: 10 * time.Second},
+			PollPeriod:                time.Minute,
+		}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), nil, cfg, chainID, nil)
+		assert.ErrorContains(t, u.Start(tests.Context(t)), "Blocks")
+	})
+
+	t.Run("fails to start if PollPeriod is zero", func(t *testing.T) {
+		cfg := gas.MempoolEstimatorConfig{
+			FeeHistoryEstimatorConfig: gas.FeeHistoryEstimatorConfig{BumpPercent: 20, RewardPercentile: 10, CacheTimeout: 10 * time.Second},
+			Blocks:                    2,
+		}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), nil, cfg, chainID, nil)
+		assert.ErrorContains(t, u.Start(tests.Context(t)), "PollPeriod")
+	})
+
+	t.Run("starts if configs are correct", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(10), nil).Maybe()
+		client.On("CallContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("not found")).Maybe()
+
+		cfg := gas.MempoolEstimatorConfig{
+			FeeHistoryEstimatorConfig: gas.FeeHistoryEstimatorConfig{BumpPercent: 20, RewardPercentile: 10, CacheTimeout: 10 * time.Second},
+			Blocks:                    2,
+			PollPeriod:                time.Minute,
+		}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		require.NoError(t, u.Start(tests.Context(t)))
+		assert.NoError(t, u.Close())
+	})
+}
+
+func TestMempoolEstimatorGetLegacyGas(t *testing.T) {
+	t.Parallel()
+
+	var gasLimit uint64 = 21000
+	maxPrice := assets.NewWeiI(100)
+	chainID := big.NewInt(0)
+
+	t.Run("raises the gas price to the predicted inclusion price from txpool_content", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(10), nil).Once()
+		mockMempool(t, client, mempoolPendingBlock, mempoolTxpoolStatus, mempoolTxpoolContent, nil)
+
+		cfg := gas.MempoolEstimatorConfig{Blocks: 1}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.RefreshGasPrice()
+		require.NoError(t, err)
+		require.NoError(t, u.RefreshPrediction())
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		// the transactions tipping 40 and 30 fill 80k gas, the one tipping 20 exceeds the 100k block
+		assert.Equal(t, assets.NewWeiI(30), gasPrice)
+	})
+
+	t.Run("keeps the FeeHistory price if the mempool fits in the next blocks", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(10), nil).Once()
+		mockMempool(t, client, mempoolPendingBlock, mempoolTxpoolStatus, mempoolTxpoolContent, nil)
+
+		cfg := gas.MempoolEstimatorConfig{Blocks: 2}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.RefreshGasPrice()
+		require.NoError(t, err)
+		require.NoError(t, u.RefreshPrediction())
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10), gasPrice)
+	})
+
+	t.Run("falls back to the pending block if the txpool namespace is not supported", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(10), nil).Once()
+		mockMempool(t, client, mempoolPendingBlock, "", "", errors.New("the method txpool_status does not exist"))
+
+		cfg := gas.MempoolEstimatorConfig{Blocks: 1}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.RefreshGasPrice()
+		require.NoError(t, err)
+		require.NoError(t, u.RefreshPrediction())
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(15), gasPrice)
+	})
+
+	t.Run("skips txpool_content if the pending block holds the whole mempool", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(10), nil).Once()
+		mockMempool(t, client, mempoolPendingBlock, `{"pending":"0x1","queued":"0x3"}`, "", nil)
+
+		cfg := gas.MempoolEstimatorConfig{Blocks: 1}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.RefreshGasPrice()
+		require.NoError(t, err)
+		require.NoError(t, u.RefreshPrediction())
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(15), gasPrice)
+	})
+
+	t.Run("keeps the FeeHistory price if it is higher than the prediction", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(50), nil).Once()
+		mockMempool(t, client, mempoolPendingBlock, mempoolTxpoolStatus, mempoolTxpoolContent, nil)
+
+		cfg := gas.MempoolEstimatorConfig{Blocks: 1}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.RefreshGasPrice()
+		require.NoError(t, err)
+		require.NoError(t, u.RefreshPrediction())
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50), gasPrice)
+	})
+
+	t.Run("will return max price if the prediction exceeds it", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(10), nil).Once()
+		mockMempool(t, client, mempoolPendingBlock, mempoolTxpoolStatus, mempoolTxpoolContent, nil)
+
+		cfg := gas.MempoolEstimatorConfig{Blocks: 1}
+
+		maxPrice := assets.NewWeiI(25)
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.RefreshGasPrice()
+		require.NoError(t, err)
+		require.NoError(t, u.RefreshPrediction())
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, maxPrice, gasPrice)
+	})
+
+	t.Run("clears the prediction if the mempool can't be inspected", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(10), nil).Once()
+		mockMempool(t, client, mempoolPendingBlock, mempoolTxpoolStatus, mempoolTxpoolContent, nil)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_getBlockByNumber", "pending", true).Return(errors.New("failed")).Once()
+
+		cfg := gas.MempoolEstimatorConfig{Blocks: 1}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.RefreshGasPrice()
+		require.NoError(t, err)
+		require.NoError(t, u.RefreshPrediction())
+		assert.Error(t, u.RefreshPrediction())
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10), gasPrice)
+	})
+}
+
+func TestMempoolEstimatorGetDynamicFee(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.NewWeiI(100)
+	chainID := big.NewInt(0)
+
+	mockFeeHistory := func(client *mocks.FeeHistoryEstimatorClient) {
+		feeHistoryResult := &ethereum.FeeHistory{
+			OldestBlock: big.NewInt(1),
+			Reward:      [][]*big.Int{{big.NewInt(5), big.NewInt(5)}},
+			BaseFee:     []*big.Int{big.NewInt(10)},
+		}
+		client.On("FeeHistory", mock.Anything, mock.Anything, mock.Anything).Return(feeHistoryResult, nil).Once()
+	}
+
+	t.Run("raises the tip to the predicted inclusion tip", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		mockFeeHistory(client)
+		mockMempool(t, client, mempoolPendingBlock, mempoolTxpoolStatus, mempoolTxpoolContent, nil)
+
+		cfg := gas.MempoolEstimatorConfig{FeeHistoryEstimatorConfig: gas.FeeHistoryEstimatorConfig{BlockHistorySize: 1}, Blocks: 1}
+
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		require.NoError(t, u.RefreshDynamicPrice())
+		feeHistoryFee, err := u.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		require.NoError(t, u.RefreshPrediction())
+		dynamicFee, err := u.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), dynamicFee.TipCap)
+		assert.Equal(t, feeHistoryFee.FeeCap.Add(assets.NewWeiI(15)), dynamicFee.FeeCap)
+	})
+
+	t.Run("will return max price if the prediction exceeds it", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		mockFeeHistory(client)
+		mockMempool(t, client, mempoolPendingBlock, mempoolTxpoolStatus, mempoolTxpoolContent, nil)
+
+		cfg := gas.MempoolEstimatorConfig{FeeHistoryEstimatorConfig: gas.FeeHistoryEstimatorConfig{BlockHistorySize: 1}, Blocks: 1}
+
+		maxPrice := assets.NewWeiI(25)
+		u := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, nil)
+		require.NoError(t, u.RefreshDynamicPrice())
+		require.NoError(t, u.RefreshPrediction())
+		dynamicFee, err := u.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, maxPrice, dynamicFee.FeeCap)
+		assert.Equal(t, assets.NewWeiI(20), dynamicFee.TipCap)
+	})
+}
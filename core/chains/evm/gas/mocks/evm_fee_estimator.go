@@ -147,6 +147,65 @@ func (_c *EvmFeeEstimator_Close_Call) RunAndReturn(run func() error) *EvmFeeEsti
 	return _c
 }
 
+// GetBlobFee provides a mock function with given fields: ctx, maxFeePrice
+func (_m *EvmFeeEstimator) GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (*assets.Wei, error) {
+	ret := _m.Called(ctx, maxFeePrice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobFee")
+	}
+
+	var r0 *assets.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) (*assets.Wei, error)); ok {
+		return rf(ctx, maxFeePrice)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) *assets.Wei); ok {
+		r0 = rf(ctx, maxFeePrice)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *assets.Wei) error); ok {
+		r1 = rf(ctx, maxFeePrice)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmFeeEstimator_GetBlobFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobFee'
+type EvmFeeEstimator_GetBlobFee_Call struct {
+	*mock.Call
+}
+
+// GetBlobFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxFeePrice *assets.Wei
+func (_e *EvmFeeEstimator_Expecter) GetBlobFee(ctx interface{}, maxFeePrice interface{}) *EvmFeeEstimator_GetBlobFee_Call {
+	return &EvmFeeEstimator_GetBlobFee_Call{Call: _e.mock.On("GetBlobFee", ctx, maxFeePrice)}
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Run(run func(ctx context.Context, maxFeePrice *assets.Wei)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*assets.Wei))
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Return(_a0 *assets.Wei, _a1 error) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) RunAndReturn(run func(context.Context, *assets.Wei) (*assets.Wei, error)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts
 func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt) (gas.EvmFee, uint64, error) {
 	_va := make([]interface{}, len(opts))
@@ -309,6 +368,82 @@ func (_c *EvmFeeEstimator_GetMaxCost_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetTotalCost provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts
+func (_m *EvmFeeEstimator) GetTotalCost(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt) (gas.TotalCost, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalCost")
+	}
+
+	var r0 gas.TotalCost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) (gas.TotalCost, error)); ok {
+		return rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) gas.TotalCost); ok {
+		r0 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
+	} else {
+		r0 = ret.Get(0).(gas.TotalCost)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) error); ok {
+		r1 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmFeeEstimator_GetTotalCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalCost'
+type EvmFeeEstimator_GetTotalCost_Call struct {
+	*mock.Call
+}
+
+// GetTotalCost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calldata []byte
+//   - feeLimit uint64
+//   - maxFeePrice *assets.Wei
+//   - fromAddress *common.Address
+//   - toAddress *common.Address
+//   - opts ...types.Opt
+func (_e *EvmFeeEstimator_Expecter) GetTotalCost(ctx interface{}, calldata interface{}, feeLimit interface{}, maxFeePrice interface{}, fromAddress interface{}, toAddress interface{}, opts ...interface{}) *EvmFeeEstimator_GetTotalCost_Call {
+	return &EvmFeeEstimator_GetTotalCost_Call{Call: _e.mock.On("GetTotalCost",
+		append([]interface{}{ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress}, opts...)...)}
+}
+
+func (_c *EvmFeeEstimator_GetTotalCost_Call) Run(run func(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt)) *EvmFeeEstimator_GetTotalCost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]types.Opt, len(args)-6)
+		for i, a := range args[6:] {
+			if a != nil {
+				variadicArgs[i] = a.(types.Opt)
+			}
+		}
+		run(args[0].(context.Context), args[1].([]byte), args[2].(uint64), args[3].(*assets.Wei), args[4].(*common.Address), args[5].(*common.Address), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetTotalCost_Call) Return(_a0 gas.TotalCost, _a1 error) *EvmFeeEstimator_GetTotalCost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetTotalCost_Call) RunAndReturn(run func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) (gas.TotalCost, error)) *EvmFeeEstimator_GetTotalCost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HealthReport provides a mock function with given fields:
 func (_m *EvmFeeEstimator) HealthReport() map[string]error {
 	ret := _m.Called()
@@ -24,6 +24,65 @@ func (_m *FeeHistoryEstimatorClient) EXPECT() *FeeHistoryEstimatorClient_Expecte
 	return &FeeHistoryEstimatorClient_Expecter{mock: &_m.Mock}
 }
 
+// CallContext provides a mock function with given fields: ctx, result, method, args
+func (_m *FeeHistoryEstimatorClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, result, method)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallContext")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string, ...interface{}) error); ok {
+		r0 = rf(ctx, result, method, args...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorClient_CallContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallContext'
+type FeeHistoryEstimatorClient_CallContext_Call struct {
+	*mock.Call
+}
+
+// CallContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - result interface{}
+//   - method string
+//   - args ...interface{}
+func (_e *FeeHistoryEstimatorClient_Expecter) CallContext(ctx interface{}, result interface{}, method interface{}, args ...interface{}) *FeeHistoryEstimatorClient_CallContext_Call {
+	return &FeeHistoryEstimatorClient_CallContext_Call{Call: _e.mock.On("CallContext",
+		append([]interface{}{ctx, result, method}, args...)...)}
+}
+
+func (_c *FeeHistoryEstimatorClient_CallContext_Call) Run(run func(ctx context.Context, result interface{}, method string, args ...interface{})) *FeeHistoryEstimatorClient_CallContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FeeHistoryEstimatorClient_CallContext_Call) Return(_a0 error) *FeeHistoryEstimatorClient_CallContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeeHistoryEstimatorClient_CallContext_Call) RunAndReturn(run func(context.Context, interface{}, string, ...interface{}) error) *FeeHistoryEstimatorClient_CallContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FeeHistory provides a mock function with given fields: ctx, blockCount, rewardPercentiles
 func (_m *FeeHistoryEstimatorClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
 	ret := _m.Called(ctx, blockCount, rewardPercentiles)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -29,6 +30,9 @@ import (
 // EstimateGasBuffer is a multiplier applied to estimated gas when the EstimateLimit feature is enabled
 const EstimateGasBuffer = float32(1.15)
 
+// BlobFeeBumpPercentage is the minimum bump of all fees of a replacement blob transaction, based on geth's blob pool
+const BlobFeeBumpPercentage = 100
+
 // EvmFeeEstimator provides a unified interface that wraps EvmEstimator and can determine if legacy or dynamic fee estimation should be used
 type EvmFeeEstimator interface {
 	services.Service
@@ -38,9 +42,14 @@ type EvmFeeEstimator interface {
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
+	// GetBlobFee returns the maxFeePerBlobGas of a new EIP-4844 blob transaction. It returns an error if the estimator doesn't support blob fees.
+	GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (*assets.Wei, error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
 	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error)
+	// GetTotalCost returns the fee and fee limit of GetFee along with the total cost of the transaction on rollups =
+	// max price x fee units + L1 data fee of the L1Oracle
+	GetTotalCost(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (TotalCost, error)
 }
 
 type feeEstimatorClient interface {
@@ -122,6 +131,21 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config,
 			}
 			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, ethClient.ConfiguredChainID(), l1Oracle)
 		}
+	case "Mempool":
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			ccfg := MempoolEstimatorConfig{
+				FeeHistoryEstimatorConfig: FeeHistoryEstimatorConfig{
+					BumpPercent:      geCfg.BumpPercent(),
+					CacheTimeout:     geCfg.FeeHistory().CacheTimeout(),
+					EIP1559:          geCfg.EIP1559DynamicFees(),
+					BlockHistorySize: uint64(geCfg.BlockHistory().BlockHistorySize()),
+					RewardPercentile: float64(geCfg.BlockHistory().TransactionPercentile()),
+				},
+				Blocks:     uint64(geCfg.Mempool().Blocks()),
+				PollPeriod: geCfg.Mempool().PollPeriod(),
+			}
+			return NewMempoolEstimator(lggr, ethClient, ccfg, ethClient.ConfiguredChainID(), l1Oracle)
+		}
 
 	default:
 		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
@@ -174,6 +198,16 @@ type EvmEstimator interface {
 	L1Oracle() rollups.L1Oracle
 }
 
+// BlobFeeEstimator is implemented by the EvmEstimators, that support the fees of EIP-4844 blob transactions
+type BlobFeeEstimator interface {
+	// GetBlobFee Calculates the initial maxFeePerBlobGas for blob transactions
+	// maxBlobFeeWei parameter is the highest possible fee cap that the function will return
+	GetBlobFee(ctx context.Context, maxBlobFeeWei *assets.Wei) (*assets.Wei, error)
+	// BumpBlobFee Increases the maxFeePerBlobGas for blob transactions by at least BlobFeeBumpPercentage
+	// if the bumped fee is greater than maxBlobFeeWei, the method returns an error
+	BumpBlobFee(ctx context.Context, original *assets.Wei, maxBlobFeeWei *assets.Wei) (*assets.Wei, error)
+}
+
 var _ feetypes.Fee = (*EvmFee)(nil)
 
 type EvmFee struct {
@@ -183,9 +217,15 @@ type EvmFee struct {
 	// dynamic/EIP1559 fees
 	DynamicFeeCap *assets.Wei
 	DynamicTipCap *assets.Wei
+
+	// blob/EIP4844 fee, set in addition to the dynamic fees
+	BlobFeeCap *assets.Wei
 }
 
 func (fee EvmFee) String() string {
+	if fee.BlobFeeCap != nil {
+		return fmt.Sprintf("{Legacy: %s, DynamicFeeCap: %s, DynamicTipCap: %s, BlobFeeCap: %s}", fee.Legacy, fee.DynamicFeeCap, fee.DynamicTipCap, fee.BlobFeeCap)
+	}
 	return fmt.Sprintf("{Legacy: %s, DynamicFeeCap: %s, DynamicTipCap: %s}", fee.Legacy, fee.DynamicFeeCap, fee.DynamicTipCap)
 }
 
@@ -193,6 +233,26 @@ func (fee EvmFee) ValidDynamic() bool {
 	return fee.DynamicFeeCap != nil && fee.DynamicTipCap != nil
 }
 
+// TotalCost is the estimated cost of a transaction, including the L1 data fee on rollups
+type TotalCost struct {
+	Fee      EvmFee
+	FeeLimit uint64
+
+	// L2Fee is the max execution fee of the transaction = max price x fee units
+	L2Fee *assets.Wei
+	// L1DataFee is the L1 data fee of the transaction not covered by its L2Fee, zero if the chain has no L1Oracle
+	L1DataFee *assets.Wei
+}
+
+// Total returns the L2 execution fee plus the L1 data fee
+func (c TotalCost) Total() *assets.Wei {
+	return c.L2Fee.Add(c.L1DataFee)
+}
+
+func (c TotalCost) String() string {
+	return fmt.Sprintf("{Fee: %s, FeeLimit: %d, L2Fee: %s, L1DataFee: %s}", c.Fee, c.FeeLimit, c.L2Fee, c.L1DataFee)
+}
+
 // evmFeeEstimator provides a struct that wraps the EVM specific dynamic and legacy estimators into one estimator that conforms to the generic FeeEstimator
 type evmFeeEstimator struct {
 	services.StateMachine
@@ -308,6 +368,15 @@ func (e *evmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit
 	return
 }
 
+// GetBlobFee returns the maxFeePerBlobGas of a new blob transaction, if the underlying estimator supports blob fees
+func (e *evmFeeEstimator) GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (*assets.Wei, error) {
+	blobEstimator, ok := e.EvmEstimator.(BlobFeeEstimator)
+	if !ok {
+		return nil, fmt.Errorf("blob transactions are not supported by the %s", e.EvmEstimator.Name())
+	}
+	return blobEstimator.GetBlobFee(ctx, maxFeePrice)
+}
+
 func (e *evmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error) {
 	fees, gasLimit, err := e.GetFee(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
 	if err != nil {
@@ -326,12 +395,44 @@ func (e *evmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, cal
 	return amountWithFees, nil
 }
 
+func (e *evmFeeEstimator) GetTotalCost(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (cost TotalCost, err error) {
+	cost.Fee, cost.FeeLimit, err = e.GetFee(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
+	if err != nil {
+		return
+	}
+
+	gasPrice := cost.Fee.Legacy
+	if e.EIP1559Enabled {
+		gasPrice = cost.Fee.DynamicFeeCap
+	}
+	cost.L2Fee = gasPrice.Mul(new(big.Int).SetUint64(cost.FeeLimit))
+
+	cost.L1DataFee = assets.NewWeiI(0)
+	l1Oracle := e.L1Oracle()
+	if l1Oracle == nil {
+		return
+	}
+	var to common.Address
+	if toAddress != nil {
+		to = *toAddress
+	}
+	cost.L1DataFee, err = l1Oracle.DataFee(ctx, to, calldata)
+	if err != nil {
+		err = fmt.Errorf("failed to get L1 data fee: %w", err)
+	}
+	return
+}
+
 func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error) {
 	// validate only 1 fee type is present
 	if (!originalFee.ValidDynamic() && originalFee.Legacy == nil) || (originalFee.ValidDynamic() && originalFee.Legacy != nil) {
 		err = pkgerrors.New("only one dynamic or legacy fee can be defined")
 		return
 	}
+	if originalFee.BlobFeeCap != nil && !originalFee.ValidDynamic() {
+		err = pkgerrors.New("blob fee can only be defined with dynamic fees")
+		return
+	}
 
 	// bump fee based on what fee the tx has previously used (not based on config)
 	// bump dynamic original
@@ -348,6 +449,9 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 		chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
 		bumpedFee.DynamicFeeCap = bumpedDynamic.FeeCap
 		bumpedFee.DynamicTipCap = bumpedDynamic.TipCap
+		if err == nil && originalFee.BlobFeeCap != nil {
+			bumpedFee, err = e.bumpBlobFee(ctx, originalFee, bumpedFee, maxFeePrice)
+		}
 		return
 	}
 
@@ -360,6 +464,27 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 	return
 }
 
+// bumpBlobFee bumps the maxFeePerBlobGas of a blob transaction. Blob pools only accept a replacement blob transaction if all
+// of its fees are bumped by BlobFeeBumpPercentage, so the dynamic fees are raised to that minimum as well.
+func (e *evmFeeEstimator) bumpBlobFee(ctx context.Context, originalFee EvmFee, bumpedFee EvmFee, maxFeePrice *assets.Wei) (EvmFee, error) {
+	blobEstimator, ok := e.EvmEstimator.(BlobFeeEstimator)
+	if !ok {
+		return bumpedFee, fmt.Errorf("blob transactions are not supported by the %s", e.EvmEstimator.Name())
+	}
+	bumpedBlobFee, err := blobEstimator.BumpBlobFee(ctx, originalFee.BlobFeeCap, maxFeePrice)
+	if err != nil {
+		return bumpedFee, err
+	}
+	bumpedFee.BlobFeeCap = bumpedBlobFee
+	bumpedFee.DynamicFeeCap = assets.WeiMax(bumpedFee.DynamicFeeCap, originalFee.DynamicFeeCap.AddPercentage(BlobFeeBumpPercentage))
+	bumpedFee.DynamicTipCap = assets.WeiMax(bumpedFee.DynamicTipCap, originalFee.DynamicTipCap.AddPercentage(BlobFeeBumpPercentage))
+	if bumpedFee.DynamicFeeCap.Cmp(maxFeePrice) > 0 {
+		return bumpedFee, fmt.Errorf("%w: maxFeePerGas of blob transaction: %s can't be bumped by the minimum percentage(%s) without exceeding maxPrice: %s",
+			commonfee.ErrBump, originalFee.DynamicFeeCap, strconv.Itoa(BlobFeeBumpPercentage), maxFeePrice)
+	}
+	return bumpedFee, nil
+}
+
 func (e *evmFeeEstimator) estimateFeeLimit(ctx context.Context, feeLimit uint64, calldata []byte, fromAddress, toAddress *common.Address) (estimatedFeeLimit uint64, err error) {
 	// Use the feeLimit * LimitMultiplier as the provided gas limit since this multiplier is applied on top of the caller specified gas limit
 	providedGasLimit, err := commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
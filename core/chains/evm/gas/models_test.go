@@ -1,6 +1,7 @@
 package gas_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
 	"testing"
@@ -164,6 +165,40 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		assert.Equal(t, new(big.Int).Add(val.ToInt(), fee), total)
 	})
 
+	t.Run("GetTotalCost", func(t *testing.T) {
+		lggr := logger.Test(t)
+		calldata := []byte{1, 2, 3}
+		l1DataFee := assets.NewWeiI(42)
+
+		l1Oracle := rollupMocks.NewL1Oracle(t)
+		l1Oracle.On("DataFee", mock.Anything, toAddress, calldata).Return(l1DataFee, nil).Once()
+
+		evmEstimator := mocks.NewEvmEstimator(t)
+		evmEstimator.On("GetDynamicFee", mock.Anything, mock.Anything).Return(dynamicFee, nil).Once()
+		evmEstimator.On("L1Oracle").Return(l1Oracle).Once()
+
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return evmEstimator }, true, geCfg, nil)
+		cost, err := estimator.GetTotalCost(ctx, calldata, gasLimit, nil, &fromAddress, &toAddress)
+		require.NoError(t, err)
+		feeLimit := uint64(float32(gasLimit) * limitMultiplier)
+		assert.Equal(t, feeLimit, cost.FeeLimit)
+		assert.True(t, dynamicFee.FeeCap.Equal(cost.Fee.DynamicFeeCap))
+		assert.Equal(t, dynamicFee.FeeCap.Mul(big.NewInt(int64(feeLimit))), cost.L2Fee)
+		assert.Equal(t, l1DataFee, cost.L1DataFee)
+		assert.Equal(t, cost.L2Fee.Add(l1DataFee), cost.Total())
+
+		// L1s have no L1 data fee
+		evmEstimator.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(legacyFee, gasLimit, nil).Once()
+		evmEstimator.On("L1Oracle").Return(nil).Once()
+
+		estimator = gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return evmEstimator }, false, geCfg, nil)
+		cost, err = estimator.GetTotalCost(ctx, calldata, gasLimit, nil, &fromAddress, &toAddress)
+		require.NoError(t, err)
+		assert.Equal(t, legacyFee.Mul(big.NewInt(int64(feeLimit))), cost.L2Fee)
+		assert.Equal(t, assets.NewWeiI(0), cost.L1DataFee)
+		assert.Equal(t, cost.L2Fee, cost.Total())
+	})
+
 	t.Run("Name", func(t *testing.T) {
 		lggr := logger.Test(t)
 
@@ -406,3 +441,79 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+// blobEvmEstimator is an EvmEstimator that supports blob fees
+type blobEvmEstimator struct {
+	*mocks.EvmEstimator
+	blobFee *assets.Wei
+}
+
+func (e *blobEvmEstimator) GetBlobFee(context.Context, *assets.Wei) (*assets.Wei, error) {
+	return e.blobFee, nil
+}
+
+func (e *blobEvmEstimator) BumpBlobFee(_ context.Context, original *assets.Wei, _ *assets.Wei) (*assets.Wei, error) {
+	return original.AddPercentage(gas.BlobFeeBumpPercentage), nil
+}
+
+func TestWrappedEvmEstimator_BlobFee(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	geCfg := gas.NewMockGasConfig()
+	maxPrice := assets.NewWeiI(100)
+	originalFee := gas.EvmFee{
+		DynamicFeeCap: assets.NewWeiI(20),
+		DynamicTipCap: assets.NewWeiI(2),
+		BlobFeeCap:    assets.NewWeiI(5),
+	}
+
+	t.Run("GetBlobFee fails if the estimator doesn't support blob fees", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("Name").Return("MockEstimator")
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, nil)
+
+		_, err := estimator.GetBlobFee(ctx, maxPrice)
+		require.ErrorContains(t, err, "blob transactions are not supported by the MockEstimator")
+	})
+
+	t.Run("GetBlobFee", func(t *testing.T) {
+		est := &blobEvmEstimator{EvmEstimator: mocks.NewEvmEstimator(t), blobFee: assets.NewWeiI(7)}
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, nil)
+
+		blobFee, err := estimator.GetBlobFee(ctx, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(7), blobFee)
+	})
+
+	t.Run("BumpFee bumps all fees of blob txes by BlobFeeBumpPercentage", func(t *testing.T) {
+		est := &blobEvmEstimator{EvmEstimator: mocks.NewEvmEstimator(t)}
+		est.On("BumpDynamicFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.DynamicFee{FeeCap: assets.NewWeiI(22), TipCap: assets.NewWeiI(3)}, nil).Once()
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, nil)
+
+		fee, _, err := estimator.BumpFee(ctx, originalFee, 10, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(40), fee.DynamicFeeCap)
+		assert.Equal(t, assets.NewWeiI(4), fee.DynamicTipCap)
+		assert.Equal(t, assets.NewWeiI(10), fee.BlobFeeCap)
+	})
+
+	t.Run("BumpFee fails if the bumped fee cap of blob txes exceeds max price", func(t *testing.T) {
+		est := &blobEvmEstimator{EvmEstimator: mocks.NewEvmEstimator(t)}
+		est.On("BumpDynamicFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.DynamicFee{FeeCap: assets.NewWeiI(22), TipCap: assets.NewWeiI(3)}, nil).Once()
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, nil)
+
+		_, _, err := estimator.BumpFee(ctx, originalFee, 10, assets.NewWeiI(30), nil)
+		require.ErrorIs(t, err, commonfee.ErrBump)
+	})
+
+	t.Run("BumpFee fails for blob fee without dynamic fees", func(t *testing.T) {
+		est := &blobEvmEstimator{EvmEstimator: mocks.NewEvmEstimator(t)}
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, nil)
+
+		_, _, err := estimator.BumpFee(ctx, gas.EvmFee{Legacy: assets.NewWeiI(10), BlobFeeCap: assets.NewWeiI(5)}, 10, maxPrice, nil)
+		require.ErrorContains(t, err, "blob fee can only be defined with dynamic fees")
+	})
+}
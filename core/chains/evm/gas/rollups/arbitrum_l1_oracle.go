@@ -254,3 +254,9 @@ func (o *arbitrumL1Oracle) GetPricesInArbGas() (perL2Tx uint32, perL1CalldataUni
 	perL1CalldataUnit = uint32(perL1CalldataUnitU64)
 	return
 }
+
+// DataFee returns zero, since Arbitrum charges the L1 data cost of a transaction as additional L2 gas used,
+// i.e. the gasEstimateForL1 of ArbNodeInterface.gasEstimateL1Component is part of the gas limit of the transaction.
+func (o *arbitrumL1Oracle) DataFee(_ context.Context, _ common.Address, _ []byte) (*assets.Wei, error) {
+	return assets.NewWeiI(0), nil
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"slices"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -24,6 +25,10 @@ type L1Oracle interface {
 	services.Service
 
 	GasPrice(ctx context.Context) (*assets.Wei, error)
+	// DataFee returns the L1 data fee of a transaction to the given address with the given calldata, which is not
+	// covered by its L2 execution fee (gas price x gas used). It is zero on rollups charging their L1 costs through the
+	// L2 gas used or gas price instead, e.g. Arbitrum, zkSync, Linea and Taiko.
+	DataFee(ctx context.Context, to common.Address, calldata []byte) (*assets.Wei, error)
 }
 
 type l1OracleClient interface {
@@ -41,30 +46,53 @@ const (
 	PollPeriod = 6 * time.Second
 )
 
-var supportedChainTypes = []chaintype.ChainType{chaintype.ChainArbitrum, chaintype.ChainOptimismBedrock, chaintype.ChainKroma, chaintype.ChainScroll, chaintype.ChainZkSync, chaintype.ChainMantle}
+// l1OracleFactory creates the L1Oracle of a rollup stack for one of its chain types
+type l1OracleFactory func(lggr logger.Logger, ethClient l1OracleClient, chainType chaintype.ChainType) (L1Oracle, error)
+
+// l1OracleFactories holds the L1Oracle factory of each supported chain type. Supporting a new rollup stack only
+// requires an L1Oracle implementation and its entry here.
+var l1OracleFactories = map[chaintype.ChainType]l1OracleFactory{
+	chaintype.ChainOptimismBedrock: newOpStackL1Oracle,
+	chaintype.ChainKroma:           newOpStackL1Oracle,
+	chaintype.ChainScroll:          newOpStackL1Oracle,
+	chaintype.ChainMantle:          newOpStackL1Oracle,
+	chaintype.ChainArbitrum: func(lggr logger.Logger, ethClient l1OracleClient, _ chaintype.ChainType) (L1Oracle, error) {
+		return NewArbitrumL1GasOracle(lggr, ethClient)
+	},
+	chaintype.ChainZkSync: func(lggr logger.Logger, ethClient l1OracleClient, _ chaintype.ChainType) (L1Oracle, error) {
+		return NewZkSyncL1GasOracle(lggr, ethClient), nil
+	},
+	chaintype.ChainLinea: func(lggr logger.Logger, ethClient l1OracleClient, _ chaintype.ChainType) (L1Oracle, error) {
+		return NewLineaL1GasOracle(lggr, ethClient), nil
+	},
+	chaintype.ChainTaiko: func(lggr logger.Logger, _ l1OracleClient, _ chaintype.ChainType) (L1Oracle, error) {
+		return NewTaikoL1GasOracle(lggr), nil
+	},
+}
+
+func newOpStackL1Oracle(lggr logger.Logger, ethClient l1OracleClient, chainType chaintype.ChainType) (L1Oracle, error) {
+	return NewOpStackL1GasOracle(lggr, ethClient, chainType)
+}
 
 func IsRollupWithL1Support(chainType chaintype.ChainType) bool {
-	return slices.Contains(supportedChainTypes, chainType)
+	_, ok := l1OracleFactories[chainType]
+	return ok
 }
 
 func NewL1GasOracle(lggr logger.Logger, ethClient l1OracleClient, chainType chaintype.ChainType) (L1Oracle, error) {
-	if !IsRollupWithL1Support(chainType) {
+	newL1Oracle, ok := l1OracleFactories[chainType]
+	if !ok {
 		return nil, nil
 	}
-	var l1Oracle L1Oracle
-	var err error
-	switch chainType {
-	case chaintype.ChainOptimismBedrock, chaintype.ChainKroma, chaintype.ChainScroll, chaintype.ChainMantle:
-		l1Oracle, err = NewOpStackL1GasOracle(lggr, ethClient, chainType)
-	case chaintype.ChainArbitrum:
-		l1Oracle, err = NewArbitrumL1GasOracle(lggr, ethClient)
-	case chaintype.ChainZkSync:
-		l1Oracle = NewZkSyncL1GasOracle(lggr, ethClient)
-	default:
-		return nil, fmt.Errorf("received unsupported chaintype %s", chainType)
-	}
+	l1Oracle, err := newL1Oracle(lggr, ethClient, chainType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize L1 oracle for chaintype %s: %w", chainType, err)
 	}
 	return l1Oracle, nil
 }
+
+// encodeDataFeeTx returns the unsigned EIP-1559 envelope of a transaction to the given address with the given calldata,
+// whose size the L1 data fee is charged for. The fields not known yet are left empty, so it is a slight underestimate.
+func encodeDataFeeTx(to common.Address, calldata []byte) ([]byte, error) {
+	return gethtypes.NewTx(&gethtypes.DynamicFeeTx{To: &to, Data: calldata}).MarshalBinary()
+}
@@ -2,6 +2,8 @@ package rollups
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"strings"
 	"testing"
@@ -9,6 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -192,4 +196,114 @@ func TestL1Oracle_GasPrice(t *testing.T) {
 
 		assert.Equal(t, assets.NewWei(new(big.Int).Mul(gasPriceL2, gasPerPubByteL2)), gasPrice)
 	})
+
+	t.Run("Calling GasPrice on started Mantle L1Oracle returns l1GasPrice in MNT", func(t *testing.T) {
+		l1BaseFee := big.NewInt(100)
+		tokenRatio := big.NewInt(4000)
+
+		ethClient := mocks.NewL1OracleClient(t)
+		ethClient.On("BatchCallContext", mock.Anything, mock.IsType([]rpc.BatchElem{})).Run(func(args mock.Arguments) {
+			rpcElements := args.Get(1).([]rpc.BatchElem)
+			require.Len(t, rpcElements, 2)
+			res1 := rpcElements[0].Result.(*string)
+			*res1 = hexutil.Encode(common.BigToHash(l1BaseFee).Bytes())
+			res2 := rpcElements[1].Result.(*string)
+			*res2 = hexutil.Encode(common.BigToHash(tokenRatio).Bytes())
+		}).Return(nil)
+
+		oracle, err := NewL1GasOracle(logger.Test(t), ethClient, chaintype.ChainMantle)
+		require.NoError(t, err)
+		servicetest.RunHealthy(t, oracle)
+
+		gasPrice, err := oracle.GasPrice(tests.Context(t))
+		require.NoError(t, err)
+
+		assert.Equal(t, assets.NewWei(new(big.Int).Mul(l1BaseFee, tokenRatio)), gasPrice)
+	})
+
+	t.Run("Calling GasPrice on started Linea L1Oracle returns the variable cost", func(t *testing.T) {
+		// version 1, fixed cost 1000 kwei, variable cost 250 kwei, eth gas price 30 kwei
+		extraData := hexutil.MustDecode("0x01000003e8000000fa0000001e0000000000000000000000000000000000000000")
+
+		ethClient := mocks.NewL1OracleClient(t)
+		ethClient.On("BatchCallContext", mock.Anything, mock.IsType([]rpc.BatchElem{})).Run(func(args mock.Arguments) {
+			rpcElements := args.Get(1).([]rpc.BatchElem)
+			require.Len(t, rpcElements, 1)
+			require.Equal(t, "eth_getBlockByNumber", rpcElements[0].Method)
+			require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{"extraData":"%s"}`, hexutil.Encode(extraData))), rpcElements[0].Result))
+		}).Return(nil)
+
+		oracle, err := NewL1GasOracle(logger.Test(t), ethClient, chaintype.ChainLinea)
+		require.NoError(t, err)
+		servicetest.RunHealthy(t, oracle)
+
+		gasPrice, err := oracle.GasPrice(tests.Context(t))
+		require.NoError(t, err)
+
+		assert.Equal(t, assets.NewWeiI(250_000), gasPrice)
+	})
+
+	t.Run("Calling GasPrice on started Taiko L1Oracle returns zero", func(t *testing.T) {
+		oracle, err := NewL1GasOracle(logger.Test(t), mocks.NewL1OracleClient(t), chaintype.ChainTaiko)
+		require.NoError(t, err)
+		servicetest.RunHealthy(t, oracle)
+
+		gasPrice, err := oracle.GasPrice(tests.Context(t))
+		require.NoError(t, err)
+
+		assert.Equal(t, assets.NewWeiI(0), gasPrice)
+	})
+}
+
+func TestL1Oracle_DataFee(t *testing.T) {
+	t.Parallel()
+
+	to := common.HexToAddress("0x1234")
+	calldata := []byte{1, 2, 3, 4}
+	tx, err := encodeDataFeeTx(to, calldata)
+	require.NoError(t, err)
+
+	t.Run("OP stack L1Oracle returns getL1Fee of the encoded transaction", func(t *testing.T) {
+		l1Fee := big.NewInt(1234)
+		getL1FeeMethodAbi, err := abi.JSON(strings.NewReader(GetL1FeeAbiString))
+		require.NoError(t, err)
+
+		ethClient := mocks.NewL1OracleClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), mock.IsType(&big.Int{})).Run(func(args mock.Arguments) {
+			callMsg := args.Get(1).(ethereum.CallMsg)
+			payload, err := getL1FeeMethodAbi.Pack("getL1Fee", tx)
+			require.NoError(t, err)
+			require.Equal(t, payload, callMsg.Data)
+			require.Equal(t, common.HexToAddress(ScrollGasOracleAddress), *callMsg.To)
+		}).Return(common.BigToHash(l1Fee).Bytes(), nil)
+
+		oracle, err := NewL1GasOracle(logger.Test(t), ethClient, chaintype.ChainScroll)
+		require.NoError(t, err)
+
+		fee, err := oracle.DataFee(tests.Context(t), to, calldata)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWei(l1Fee), fee)
+	})
+
+	t.Run("OP stack L1Oracle returns error if getL1Fee returns bad data", func(t *testing.T) {
+		ethClient := mocks.NewL1OracleClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), mock.IsType(&big.Int{})).Return([]byte{1}, nil)
+
+		oracle, err := NewL1GasOracle(logger.Test(t), ethClient, chaintype.ChainOptimismBedrock)
+		require.NoError(t, err)
+
+		_, err = oracle.DataFee(tests.Context(t), to, calldata)
+		assert.EqualError(t, err, "getL1Fee() return data length (1) different than expected (32)")
+	})
+
+	for _, chainType := range []chaintype.ChainType{chaintype.ChainArbitrum, chaintype.ChainZkSync, chaintype.ChainLinea, chaintype.ChainTaiko} {
+		t.Run(fmt.Sprintf("%s L1Oracle returns zero", chainType), func(t *testing.T) {
+			oracle, err := NewL1GasOracle(logger.Test(t), mocks.NewL1OracleClient(t), chainType)
+			require.NoError(t, err)
+
+			fee, err := oracle.DataFee(tests.Context(t), to, calldata)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(0), fee)
+		})
+	}
 }
@@ -0,0 +1,187 @@
+package rollups
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
+
+// Reads the pricing parameters the Linea sequencer sets in the extraData of its blocks and caches the variable cost,
+// which is the L1 price of a byte of compressed transaction data.
+type lineaL1Oracle struct {
+	services.StateMachine
+	client     l1OracleClient
+	pollPeriod time.Duration
+	logger     logger.SugaredLogger
+
+	l1GasPriceMu sync.RWMutex
+	l1GasPrice   priceEntry
+
+	chInitialised chan struct{}
+	chStop        services.StopChan
+	chDone        chan struct{}
+}
+
+const (
+	// LineaExtraDataVersion is the version of the pricing parameters encoding in the extraData of Linea blocks
+	// https://docs.linea.build/get-started/how-to/gas-fees#extradata
+	LineaExtraDataVersion = 1
+	// lineaExtraDataLength is the length of the version and the fixed cost, variable cost and legacy gas price, each
+	// a uint32 in kwei.
+	lineaExtraDataLength = 1 + 3*4
+)
+
+func NewLineaL1GasOracle(lggr logger.Logger, ethClient l1OracleClient) *lineaL1Oracle {
+	return &lineaL1Oracle{
+		client:     ethClient,
+		pollPeriod: PollPeriod,
+		logger:     logger.Sugared(logger.Named(lggr, "L1GasOracle(linea)")),
+
+		chInitialised: make(chan struct{}),
+		chStop:        make(chan struct{}),
+		chDone:        make(chan struct{}),
+	}
+}
+
+func (o *lineaL1Oracle) Name() string {
+	return o.logger.Name()
+}
+
+func (o *lineaL1Oracle) Start(ctx context.Context) error {
+	return o.StartOnce(o.Name(), func() error {
+		go o.run()
+		<-o.chInitialised
+		return nil
+	})
+}
+func (o *lineaL1Oracle) Close() error {
+	return o.StopOnce(o.Name(), func() error {
+		close(o.chStop)
+		<-o.chDone
+		return nil
+	})
+}
+
+func (o *lineaL1Oracle) HealthReport() map[string]error {
+	return map[string]error{o.Name(): o.Healthy()}
+}
+
+func (o *lineaL1Oracle) run() {
+	defer close(o.chDone)
+
+	o.refresh()
+	close(o.chInitialised)
+
+	t := services.TickerConfig{
+		Initial:   o.pollPeriod,
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(o.pollPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-o.chStop:
+			return
+		case <-t.C:
+			o.refresh()
+		}
+	}
+}
+func (o *lineaL1Oracle) refresh() {
+	err := o.refreshWithError()
+	if err != nil {
+		o.logger.Criticalw("Failed to refresh gas price", "err", err)
+		o.SvcErrBuffer.Append(err)
+	}
+}
+
+func (o *lineaL1Oracle) refreshWithError() error {
+	ctx, cancel := o.chStop.CtxCancel(evmclient.ContextWithDefaultTimeout())
+	defer cancel()
+
+	price, err := o.GetVariableCost(ctx)
+	if err != nil {
+		return err
+	}
+
+	o.l1GasPriceMu.Lock()
+	defer o.l1GasPriceMu.Unlock()
+	o.l1GasPrice = priceEntry{price: assets.NewWei(price), timestamp: time.Now()}
+	return nil
+}
+
+// GetVariableCost returns the variable cost set by the sequencer in the extraData of the latest block, in wei per byte
+// of compressed transaction data.
+//
+// extraData = version (1 byte) | fixedCost (4 bytes) | variableCost (4 bytes) | ethGasPrice (4 bytes), costs in kwei
+func (o *lineaL1Oracle) GetVariableCost(ctx context.Context) (*big.Int, error) {
+	var head struct {
+		ExtraData hexutil.Bytes `json:"extraData"`
+	}
+	rpcBatchCalls := []rpc.BatchElem{
+		{
+			Method: "eth_getBlockByNumber",
+			Args:   []any{"latest", false},
+			Result: &head,
+		},
+	}
+	err := o.client.BatchCallContext(ctx, rpcBatchCalls)
+	if err != nil {
+		return nil, fmt.Errorf("fetch latest block failed: %w", err)
+	}
+	if rpcBatchCalls[0].Error != nil {
+		return nil, fmt.Errorf("fetch latest block failed: %w", rpcBatchCalls[0].Error)
+	}
+
+	extraData := head.ExtraData
+	if len(extraData) < lineaExtraDataLength {
+		return nil, fmt.Errorf("extraData length (%d) shorter than expected (%d)", len(extraData), lineaExtraDataLength)
+	}
+	if extraData[0] != LineaExtraDataVersion {
+		return nil, fmt.Errorf("unsupported extraData version %d, expected %d", extraData[0], LineaExtraDataVersion)
+	}
+	variableCost := binary.BigEndian.Uint32(extraData[5:9])
+	return new(big.Int).Mul(big.NewInt(int64(variableCost)), big.NewInt(1000)), nil
+}
+
+// GasPrice returns the variable cost of Linea, i.e. the L1 price of a byte of compressed transaction data
+func (o *lineaL1Oracle) GasPrice(_ context.Context) (l1GasPrice *assets.Wei, err error) {
+	var timestamp time.Time
+	ok := o.IfStarted(func() {
+		o.l1GasPriceMu.RLock()
+		l1GasPrice = o.l1GasPrice.price
+		timestamp = o.l1GasPrice.timestamp
+		o.l1GasPriceMu.RUnlock()
+	})
+	if !ok {
+		return l1GasPrice, fmt.Errorf("L1GasOracle is not started; cannot estimate gas")
+	}
+	if l1GasPrice == nil {
+		return l1GasPrice, fmt.Errorf("failed to get l1 gas price; gas price not set")
+	}
+	// Validate the price has been updated within the pollPeriod * 2
+	// Allowing double the poll period before declaring the price stale to give ample time for the refresh to process
+	if time.Since(timestamp) > o.pollPeriod*2 {
+		return l1GasPrice, fmt.Errorf("gas price is stale")
+	}
+	return
+}
+
+// DataFee returns zero, since Linea charges the L1 data cost of a transaction through its minimum gas price, which the
+// sequencer raises by the variable cost of the transaction data spread over its gas used.
+func (o *lineaL1Oracle) DataFee(_ context.Context, _ common.Address, _ []byte) (*assets.Wei, error) {
+	return assets.NewWeiI(0), nil
+}
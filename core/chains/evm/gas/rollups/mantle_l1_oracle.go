@@ -0,0 +1,70 @@
+package rollups
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Returns the L1 gas price of Mantle in MNT, since the fees on Mantle are paid in MNT while its GasPriceOracle reports
+// the l1BaseFee in ETH. The price is scaled by the tokenRatio of the oracle, which is the price of ETH in MNT.
+// https://github.com/mantlenetworkio/mantle-v2/blob/e29d360904db5e5ec81888885f7b7250f8e2bb07/packages/contracts-bedrock/contracts/L2/GasPriceOracle.sol
+func (o *optimismL1Oracle) getMantleGasPrice(ctx context.Context) (*big.Int, error) {
+	rpcBatchCalls := []rpc.BatchElem{
+		{
+			Method: "eth_call",
+			Args: []any{
+				map[string]interface{}{
+					"from": common.Address{},
+					"to":   o.l1OracleAddress,
+					"data": hexutil.Bytes(o.l1BaseFeeCalldata),
+				},
+				"latest",
+			},
+			Result: new(string),
+		},
+		{
+			Method: "eth_call",
+			Args: []any{
+				map[string]interface{}{
+					"from": common.Address{},
+					"to":   o.l1OracleAddress,
+					"data": hexutil.Bytes(o.tokenRatioCalldata),
+				},
+				"latest",
+			},
+			Result: new(string),
+		},
+	}
+
+	err := o.client.BatchCallContext(ctx, rpcBatchCalls)
+	if err != nil {
+		return nil, fmt.Errorf("fetch gas price parameters batch call failed: %w", err)
+	}
+	if rpcBatchCalls[0].Error != nil {
+		return nil, fmt.Errorf("%s call failed in a batch: %w", l1BaseFeeMethod, rpcBatchCalls[0].Error)
+	}
+	if rpcBatchCalls[1].Error != nil {
+		return nil, fmt.Errorf("%s call failed in a batch: %w", tokenRatioMethod, rpcBatchCalls[1].Error)
+	}
+
+	l1BaseFeeBytes, err := hexutil.Decode(*(rpcBatchCalls[0].Result.(*string)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s rpc result: %w", l1BaseFeeMethod, err)
+	}
+	tokenRatioBytes, err := hexutil.Decode(*(rpcBatchCalls[1].Result.(*string)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s rpc result: %w", tokenRatioMethod, err)
+	}
+
+	l1BaseFee := new(big.Int).SetBytes(l1BaseFeeBytes)
+	tokenRatio := new(big.Int).SetBytes(tokenRatioBytes)
+
+	o.logger.Debugw("gas price parameters", "l1BaseFee", l1BaseFee, "tokenRatio", tokenRatio)
+
+	return new(big.Int).Mul(l1BaseFee, tokenRatio), nil
+}
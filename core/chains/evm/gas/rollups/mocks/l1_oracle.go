@@ -7,6 +7,8 @@ import (
 
 	assets "github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 
+	common "github.com/ethereum/go-ethereum/common"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -68,6 +70,66 @@ func (_c *L1Oracle_Close_Call) RunAndReturn(run func() error) *L1Oracle_Close_Ca
 	return _c
 }
 
+// DataFee provides a mock function with given fields: ctx, to, calldata
+func (_m *L1Oracle) DataFee(ctx context.Context, to common.Address, calldata []byte) (*assets.Wei, error) {
+	ret := _m.Called(ctx, to, calldata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DataFee")
+	}
+
+	var r0 *assets.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) (*assets.Wei, error)); ok {
+		return rf(ctx, to, calldata)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) *assets.Wei); ok {
+		r0 = rf(ctx, to, calldata)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, []byte) error); ok {
+		r1 = rf(ctx, to, calldata)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// L1Oracle_DataFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DataFee'
+type L1Oracle_DataFee_Call struct {
+	*mock.Call
+}
+
+// DataFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - to common.Address
+//   - calldata []byte
+func (_e *L1Oracle_Expecter) DataFee(ctx interface{}, to interface{}, calldata interface{}) *L1Oracle_DataFee_Call {
+	return &L1Oracle_DataFee_Call{Call: _e.mock.On("DataFee", ctx, to, calldata)}
+}
+
+func (_c *L1Oracle_DataFee_Call) Run(run func(ctx context.Context, to common.Address, calldata []byte)) *L1Oracle_DataFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *L1Oracle_DataFee_Call) Return(_a0 *assets.Wei, _a1 error) *L1Oracle_DataFee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *L1Oracle_DataFee_Call) RunAndReturn(run func(context.Context, common.Address, []byte) (*assets.Wei, error)) *L1Oracle_DataFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GasPrice provides a mock function with given fields: ctx
 func (_m *L1Oracle) GasPrice(ctx context.Context) (*assets.Wei, error) {
 	ret := _m.Called(ctx)
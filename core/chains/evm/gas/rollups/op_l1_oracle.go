@@ -28,6 +28,7 @@ type optimismL1Oracle struct {
 	client     l1OracleClient
 	pollPeriod time.Duration
 	logger     logger.SugaredLogger
+	chainType  chaintype.ChainType
 
 	l1OracleAddress string
 	l1GasPriceMu    sync.RWMutex
@@ -84,6 +85,10 @@ const (
 	// decimals is a hex encoded call to:
 	// `function decimals() public pure returns (uint256);`
 	decimalsMethod = "decimals"
+	// tokenRatio fetches the ratio of the price of ETH to the price of the native token of the chain, e.g. MNT on Mantle
+	// tokenRatio is a hex encoded call to:
+	// `function tokenRatio() public view returns (uint256);`
+	tokenRatioMethod = "tokenRatio"
 	// OPGasOracleAddress is the address of the precompiled contract that exists on Optimism, Base and Mantle.
 	OPGasOracleAddress = "0x420000000000000000000000000000000000000F"
 	// KromaGasOracleAddress is the address of the precompiled contract that exists on Kroma.
@@ -184,10 +189,21 @@ func newOpStackL1GasOracle(lggr logger.Logger, ethClient l1OracleClient, chainTy
 		return nil, fmt.Errorf("failed to parse GasPriceOracle %s() calldata for chain: %s; %w", decimalsMethod, chainType, err)
 	}
 
+	// Encode calldata for tokenRatio method
+	tokenRatioMethodAbi, err := abi.JSON(strings.NewReader(MantleTokenRatioAbiString))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GasPriceOracle %s() method ABI for chain: %s; %w", tokenRatioMethod, chainType, err)
+	}
+	tokenRatioCalldata, err := tokenRatioMethodAbi.Pack(tokenRatioMethod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GasPriceOracle %s() calldata for chain: %s; %w", tokenRatioMethod, chainType, err)
+	}
+
 	return &optimismL1Oracle{
 		client:     ethClient,
 		pollPeriod: PollPeriod,
 		logger:     logger.Sugared(logger.Named(lggr, fmt.Sprintf("L1GasOracle(%s)", chainType))),
+		chainType:  chainType,
 
 		l1OracleAddress: precompileAddress,
 		isEcotone:       false,
@@ -204,6 +220,7 @@ func newOpStackL1GasOracle(lggr logger.Logger, ethClient l1OracleClient, chainTy
 		blobBaseFeeCalldata:       blobBaseFeeCalldata,
 		blobBaseFeeScalarCalldata: blobBaseFeeScalarCalldata,
 		decimalsCalldata:          decimalsCalldata,
+		tokenRatioCalldata:        tokenRatioCalldata,
 		isEcotoneCalldata:         isEcotoneCalldata,
 		isEcotoneMethodAbi:        isEcotoneMethodAbi,
 		isFjordCalldata:           isFjordCalldata,
@@ -300,7 +317,37 @@ func (o *optimismL1Oracle) GasPrice(_ context.Context) (l1GasPrice *assets.Wei,
 	return
 }
 
+// DataFee returns the L1 data fee of a transaction computed by the getL1Fee method of the gas price oracle contract
+func (o *optimismL1Oracle) DataFee(ctx context.Context, to common.Address, calldata []byte) (*assets.Wei, error) {
+	tx, err := encodeDataFeeTx(to, calldata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	data, err := o.getL1FeeMethodAbi.Pack(getL1FeeMethod, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s() calldata: %w", getL1FeeMethod, err)
+	}
+
+	l1OracleAddress := common.HexToAddress(o.l1OracleAddress)
+	b, err := o.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &l1OracleAddress,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s() call failed: %w", getL1FeeMethod, err)
+	}
+
+	if len(b) != 32 {
+		return nil, fmt.Errorf("%s() return data length (%d) different than expected (%d)", getL1FeeMethod, len(b), 32)
+	}
+	return assets.NewWei(new(big.Int).SetBytes(b)), nil
+}
+
 func (o *optimismL1Oracle) GetDAGasPrice(ctx context.Context) (*big.Int, error) {
+	if o.chainType == chaintype.ChainMantle {
+		return o.getMantleGasPrice(ctx)
+	}
+
 	err := o.checkForUpgrade(ctx)
 	if err != nil {
 		return nil, err
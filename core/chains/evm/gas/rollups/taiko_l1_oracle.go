@@ -0,0 +1,57 @@
+package rollups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+)
+
+// Prices the L1 data of Taiko, which has no L1 fee: the proposers pay for the blobs carrying the L2 blocks on L1, and
+// recover it through the L2 base fee and tips of the transactions. The L1 costs of a transaction are thus covered by
+// its L2 execution fee. The L1 blob base fee can not be read from Taiko either, since the eth_blobBaseFee method of its
+// RPC reports the blob base fee of the L2 itself.
+type taikoL1Oracle struct {
+	services.StateMachine
+	logger logger.SugaredLogger
+}
+
+func NewTaikoL1GasOracle(lggr logger.Logger) *taikoL1Oracle {
+	return &taikoL1Oracle{
+		logger: logger.Sugared(logger.Named(lggr, "L1GasOracle(taiko)")),
+	}
+}
+
+func (o *taikoL1Oracle) Name() string {
+	return o.logger.Name()
+}
+
+func (o *taikoL1Oracle) Start(ctx context.Context) error {
+	return o.StartOnce(o.Name(), func() error { return nil })
+}
+
+func (o *taikoL1Oracle) Close() error {
+	return o.StopOnce(o.Name(), func() error { return nil })
+}
+
+func (o *taikoL1Oracle) HealthReport() map[string]error {
+	return map[string]error{o.Name(): o.Healthy()}
+}
+
+// GasPrice returns zero, since Taiko charges no separate price for the data availability of its transactions
+func (o *taikoL1Oracle) GasPrice(_ context.Context) (*assets.Wei, error) {
+	if !o.IfStarted(func() {}) {
+		return nil, fmt.Errorf("L1GasOracle is not started; cannot estimate gas")
+	}
+	return assets.NewWeiI(0), nil
+}
+
+// DataFee returns zero, since the L1 costs of Taiko transactions are recovered through their L2 base fee and tips.
+func (o *taikoL1Oracle) DataFee(_ context.Context, _ common.Address, _ []byte) (*assets.Wei, error) {
+	return assets.NewWeiI(0), nil
+}
@@ -233,3 +233,9 @@ func (o *zkSyncL1Oracle) GetL2GasPerPubDataBytes(ctx context.Context) (gasPerPub
 	gasPerPubByteL2 = new(big.Int).SetBytes(b)
 	return
 }
+
+// DataFee returns zero, since zkSync charges the L1 pubdata cost of a transaction as additional L2 gas used,
+// at GetL2GasPerPubDataBytes gas per byte of pubdata.
+func (o *zkSyncL1Oracle) DataFee(_ context.Context, _ common.Address, _ []byte) (*assets.Wei, error) {
+	return assets.NewWeiI(0), nil
+}
@@ -0,0 +1,140 @@
+package headtracker
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+const (
+	// arbNodeInterfaceAddress is the address of the NodeInterface precompile, which is only available through RPC
+	// https://github.com/OffchainLabs/nitro/blob/e815395d2e91fb17f4634cad72198f6de79c6e61/nodeInterface/NodeInterface.go#L37
+	arbNodeInterfaceAddress = "0x00000000000000000000000000000000000000C8"
+	// getL1ConfirmationsAbiString is the ABI of NodeInterface.getL1Confirmations, returning the number of L1 blocks
+	// confirming the batch which contains the given L2 block, or 0 if it wasn't posted yet
+	getL1ConfirmationsAbiString = `[{"inputs":[{"internalType":"bytes32","name":"blockHash","type":"bytes32"}],"name":"getL1Confirmations","outputs":[{"internalType":"uint64","name":"confirmations","type":"uint64"}],"stateMutability":"view","type":"function"}]`
+	getL1ConfirmationsMethod    = "getL1Confirmations"
+)
+
+type finalityProviderClient interface {
+	HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// NewFinalityProvider returns the FinalityProvider of the given type, or nil if none is configured.
+func NewFinalityProvider(lggr logger.Logger, client finalityProviderClient, cfg config.FinalityProvider) (httypes.FinalityProvider, error) {
+	switch cfg.Type() {
+	case "":
+		return nil, nil
+	case toml.FinalityProviderArbitrumL1Batch:
+		return newArbitrumL1BatchFinality(lggr, client, cfg.L1Confirmations())
+	default:
+		return nil, fmt.Errorf("unsupported finality provider: %s", cfg.Type())
+	}
+}
+
+// arbitrumL1BatchFinality finalizes the L2 blocks whose batch was posted to the L1 at least l1Confirmations blocks ago,
+// as reported by the NodeInterface precompile. The latest finalized block is only searched forward from the previous
+// one: the next block is checked first, which is the single call made while no new batch is confirmed, then the search
+// gallops forward and binary searches the last step.
+type arbitrumL1BatchFinality struct {
+	lggr            logger.SugaredLogger
+	client          finalityProviderClient
+	l1Confirmations uint64
+	abi             abi.ABI
+
+	mu            sync.Mutex
+	lastFinalized int64
+}
+
+func newArbitrumL1BatchFinality(lggr logger.Logger, client finalityProviderClient, l1Confirmations uint32) (*arbitrumL1BatchFinality, error) {
+	parsed, err := abi.JSON(strings.NewReader(getL1ConfirmationsAbiString))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse NodeInterface ABI: %w", err)
+	}
+	return &arbitrumL1BatchFinality{
+		lggr:            logger.Sugared(logger.Named(lggr, "ArbitrumL1BatchFinality")),
+		client:          client,
+		l1Confirmations: uint64(l1Confirmations),
+		abi:             parsed,
+	}, nil
+}
+
+func (p *arbitrumL1BatchFinality) LatestFinalizedBlockNumber(ctx context.Context, latest *evmtypes.Head) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	lo, hi := p.lastFinalized, latest.BlockNumber()
+	if lo >= hi {
+		// latest was already finalized, e.g. the RPC is lagging behind
+		return hi, nil
+	}
+	// gallop forward from the last finalized block until a block isn't finalized yet, it bounds the binary search
+	for step := int64(1); lo < hi; step *= 2 {
+		next := min(lo+step, hi)
+		confirmations, err := p.getL1Confirmations(ctx, next)
+		if err != nil {
+			return 0, err
+		}
+		if confirmations < p.l1Confirmations {
+			hi = next - 1
+			break
+		}
+		lo = next
+	}
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		confirmations, err := p.getL1Confirmations(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+		if confirmations >= p.l1Confirmations {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	if lo != p.lastFinalized {
+		p.lggr.Debugw("L1 batch finalized new blocks", "finalized", lo, "previouslyFinalized", p.lastFinalized, "latest", latest.BlockNumber())
+	}
+	p.lastFinalized = lo
+	return lo, nil
+}
+
+func (p *arbitrumL1BatchFinality) getL1Confirmations(ctx context.Context, blockNumber int64) (uint64, error) {
+	head, err := p.client.HeadByNumber(ctx, big.NewInt(blockNumber))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block %d: %w", blockNumber, err)
+	}
+	if head == nil {
+		return 0, fmt.Errorf("block %d not found", blockNumber)
+	}
+
+	data, err := p.abi.Pack(getL1ConfirmationsMethod, head.Hash)
+	if err != nil {
+		return 0, fmt.Errorf("failed to pack %s call: %w", getL1ConfirmationsMethod, err)
+	}
+	to := common.HexToAddress(arbNodeInterfaceAddress)
+	b, err := p.client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s call failed for block %d: %w", getL1ConfirmationsMethod, blockNumber, err)
+	}
+	res, err := p.abi.Unpack(getL1ConfirmationsMethod, b)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unpack %s result: %w", getL1ConfirmationsMethod, err)
+	}
+	return res[0].(uint64), nil
+}
@@ -0,0 +1,147 @@
+package headtracker_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+func TestNewFinalityProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns nil if no provider is configured", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, nil)
+		provider, err := headtracker.NewFinalityProvider(logger.Test(t), evmclimocks.NewClient(t), cfg.EVM().FinalityProvider())
+		require.NoError(t, err)
+		assert.Nil(t, provider)
+	})
+	t.Run("returns error for unsupported provider", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.FinalityProvider.Type = ptr("Unknown")
+		})
+		_, err := headtracker.NewFinalityProvider(logger.Test(t), evmclimocks.NewClient(t), cfg.EVM().FinalityProvider())
+		require.ErrorContains(t, err, "unsupported finality provider: Unknown")
+	})
+}
+
+func TestArbitrumL1BatchFinality_LatestFinalizedBlockNumber(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	nodeInterface := common.HexToAddress("0x00000000000000000000000000000000000000C8")
+
+	newArbitrumChain := func(t *testing.T, confirmations func(n int64) uint64) *evmclimocks.Client {
+		client := evmclimocks.NewClient(t)
+		numbers := make(map[common.Hash]int64)
+		client.On("HeadByNumber", mock.Anything, mock.Anything).Return(func(_ context.Context, n *big.Int) (*evmtypes.Head, error) {
+			head := testutils.Head(n.Int64())
+			numbers[head.Hash] = n.Int64()
+			return head, nil
+		}).Maybe()
+		client.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+			require.Equal(t, nodeInterface, *msg.To)
+			require.Len(t, msg.Data, 36)
+			n, ok := numbers[common.BytesToHash(msg.Data[4:])]
+			require.True(t, ok)
+			return common.LeftPadBytes(new(big.Int).SetUint64(confirmations(n)).Bytes(), 32), nil
+		}).Maybe()
+		return client
+	}
+	newProvider := func(t *testing.T, client *evmclimocks.Client) httypes.FinalityProvider {
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.FinalityProvider.Type = ptr(toml.FinalityProviderArbitrumL1Batch)
+			c.FinalityProvider.L1Confirmations = ptr[uint32](64)
+		})
+		provider, err := headtracker.NewFinalityProvider(logger.Test(t), client, cfg.EVM().FinalityProvider())
+		require.NoError(t, err)
+		require.NotNil(t, provider)
+		return provider
+	}
+
+	t.Run("returns the latest block confirmed by enough L1 blocks", func(t *testing.T) {
+		l1Head := int64(100)
+		client := newArbitrumChain(t, func(n int64) uint64 {
+			return uint64(max(l1Head-n, 0))
+		})
+		provider := newProvider(t, client)
+
+		finalized, err := provider.LatestFinalizedBlockNumber(ctx, testutils.Head(90))
+		require.NoError(t, err)
+		assert.Equal(t, int64(36), finalized)
+
+		l1Head = 110
+		finalized, err = provider.LatestFinalizedBlockNumber(ctx, testutils.Head(95))
+		require.NoError(t, err)
+		assert.Equal(t, int64(46), finalized)
+	})
+	t.Run("never returns a block lower than the previously finalized one", func(t *testing.T) {
+		posted := true
+		client := newArbitrumChain(t, func(n int64) uint64 {
+			if posted && n <= 10 {
+				return 64
+			}
+			return 0
+		})
+		provider := newProvider(t, client)
+
+		finalized, err := provider.LatestFinalizedBlockNumber(ctx, testutils.Head(20))
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), finalized)
+
+		posted = false
+		finalized, err = provider.LatestFinalizedBlockNumber(ctx, testutils.Head(30))
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), finalized)
+
+		finalized, err = provider.LatestFinalizedBlockNumber(ctx, testutils.Head(5))
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), finalized, "latest block was already finalized")
+	})
+	t.Run("only checks the next block while no new block is finalized", func(t *testing.T) {
+		var calls []int64
+		client := newArbitrumChain(t, func(n int64) uint64 {
+			calls = append(calls, n)
+			if n <= 1000 {
+				return 64
+			}
+			return 0
+		})
+		provider := newProvider(t, client)
+
+		finalized, err := provider.LatestFinalizedBlockNumber(ctx, testutils.Head(1_000_000))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), finalized)
+
+		calls = nil
+		finalized, err = provider.LatestFinalizedBlockNumber(ctx, testutils.Head(1_000_001))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), finalized)
+		assert.Equal(t, []int64{1001}, calls)
+	})
+	t.Run("returns error if NodeInterface call fails", func(t *testing.T) {
+		client := evmclimocks.NewClient(t)
+		client.On("HeadByNumber", mock.Anything, mock.Anything).Return(testutils.Head(5), nil).Once()
+		client.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("execution reverted")).Once()
+		provider := newProvider(t, client)
+
+		_, err := provider.LatestFinalizedBlockNumber(ctx, testutils.Head(10))
+		require.ErrorContains(t, err, "getL1Confirmations call failed for block 1: execution reverted")
+	})
+}
@@ -74,7 +74,7 @@ func TestHeadBroadcaster_Subscribe(t *testing.T) {
 	servicetest.Run(t, mailMon)
 	hb := headtracker.NewHeadBroadcaster(logger)
 	servicetest.Run(t, hb)
-	ht := headtracker.NewHeadTracker(logger, ethClient, evmCfg.EVM(), evmCfg.EVM().HeadTracker(), hb, hs, mailMon)
+	ht := headtracker.NewHeadTracker(logger, ethClient, evmCfg.EVM(), evmCfg.EVM().HeadTracker(), hb, hs, mailMon, nil)
 	servicetest.Run(t, ht)
 
 	latest1, unsubscribe1 := hb.Subscribe(checker1)
@@ -62,6 +62,14 @@ func (c *config) FinalityTagEnabled() bool {
 	return c.finalityTagEnabled
 }
 
+func (c *config) FinalityBlockTag() string {
+	return "finalized"
+}
+
+func (c *config) FinalityHybridEnabled() bool {
+	return false
+}
+
 func (c *config) FinalizedBlockOffset() uint32 {
 	return c.finalizedBlockOffset
 }
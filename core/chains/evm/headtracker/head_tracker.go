@@ -23,6 +23,7 @@ func NewHeadTracker(
 	headBroadcaster httypes.HeadBroadcaster,
 	headSaver httypes.HeadSaver,
 	mailMon *mailbox.Monitor,
+	finalityProvider httypes.FinalityProvider,
 ) httypes.HeadTracker {
 	return headtracker.NewHeadTracker[*evmtypes.Head, ethereum.Subscription](
 		lggr,
@@ -32,6 +33,7 @@ func NewHeadTracker(
 		headBroadcaster,
 		headSaver,
 		mailMon,
+		finalityProvider,
 		func() *evmtypes.Head { return nil },
 	)
 }
@@ -1141,15 +1141,22 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 	h13.ParentHash = h12.Hash
 
 	type opts struct {
-		Heads                []*evmtypes.Head
-		FinalityTagEnabled   bool
-		FinalizedBlockOffset uint32
-		FinalityDepth        uint32
+		Heads                 []*evmtypes.Head
+		FinalityTagEnabled    bool
+		FinalityBlockTag      string
+		FinalityHybridEnabled bool
+		FinalityProvider      httypes.FinalityProvider
+		FinalizedBlockOffset  uint32
+		FinalityDepth         uint32
 	}
 
 	newHeadTrackerUniverse := func(t *testing.T, opts opts) *headTrackerUniverse {
 		evmcfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
 			c.FinalityTagEnabled = ptr(opts.FinalityTagEnabled)
+			if opts.FinalityBlockTag != "" {
+				c.FinalityBlockTag = ptr(opts.FinalityBlockTag)
+			}
+			c.FinalityHybridEnabled = ptr(opts.FinalityHybridEnabled)
 			c.FinalizedBlockOffset = ptr(opts.FinalizedBlockOffset)
 			c.FinalityDepth = ptr(opts.FinalityDepth)
 		})
@@ -1162,6 +1169,10 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 		ethClient := evmtest.NewEthClientMock(t)
 		ethClient.On("ConfiguredChainID", mock.Anything).Return(testutils.FixtureChainID, nil)
 		ht := createHeadTracker(t, ethClient, evmcfg.EVM(), evmcfg.EVM().HeadTracker(), orm)
+		if opts.FinalityProvider != nil {
+			ht.headTracker = headtracker.NewHeadTracker(logger.Test(t), ethClient, evmcfg.EVM(), evmcfg.EVM().HeadTracker(),
+				ht.headBroadcaster, ht.headSaver, ht.mailMon, opts.FinalityProvider)
+		}
 		_, err := ht.headSaver.Load(tests.Context(t), 0)
 		require.NoError(t, err)
 		return ht
@@ -1259,6 +1270,85 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 		assert.Equal(t, actualL.Number, h13.Number)
 		assert.Equal(t, actualLF.Number, h10.Number)
 	})
+	t.Run("returns latest safe block (safe finality tag)", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, opts{FinalityTagEnabled: true, FinalityBlockTag: "safe"})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(h12, nil).Once()
+
+		actualL, actualLF, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, actualL, h13)
+		assert.Equal(t, actualLF, h12)
+	})
+	t.Run("returns error if failed to get latest safe block (safe finality tag)", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, opts{FinalityTagEnabled: true, FinalityBlockTag: "safe"})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(nil, errors.New("safe tag not supported")).Once()
+
+		_, _, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.ErrorContains(t, err, "failed to get latest safe block: safe tag not supported")
+	})
+	t.Run("caps tagged block to finality depth (hybrid)", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, opts{FinalityTagEnabled: true, FinalityHybridEnabled: true, FinalityDepth: 2, Heads: []*evmtypes.Head{h13, h12, h11}})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h12, nil).Once()
+
+		actualL, actualLF, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, actualL.Number, h13.Number)
+		assert.Equal(t, actualLF.Number, h11.Number)
+	})
+	t.Run("returns tagged block if it is deeper than finality depth (hybrid)", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, opts{FinalityTagEnabled: true, FinalityHybridEnabled: true, FinalityDepth: 1, Heads: []*evmtypes.Head{h13, h12, h11}})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h11, nil).Once()
+
+		actualL, actualLF, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, actualL, h13)
+		assert.Equal(t, actualLF, h11)
+	})
+	t.Run("returns block of finality provider", func(t *testing.T) {
+		provider := finalityProviderFunc(func(ctx context.Context, latest *evmtypes.Head) (int64, error) {
+			assert.Equal(t, h13.Number, latest.Number)
+			return 12, nil
+		})
+		htu := newHeadTrackerUniverse(t, opts{FinalityTagEnabled: true, FinalityProvider: provider, Heads: []*evmtypes.Head{h13, h12, h11}})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+
+		actualL, actualLF, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, actualL.Number, h13.Number)
+		assert.Equal(t, actualLF.Number, h12.Number)
+	})
+	t.Run("caps block of finality provider to finality depth (hybrid)", func(t *testing.T) {
+		provider := finalityProviderFunc(func(ctx context.Context, latest *evmtypes.Head) (int64, error) {
+			return 13, nil
+		})
+		htu := newHeadTrackerUniverse(t, opts{FinalityHybridEnabled: true, FinalityDepth: 2, FinalityProvider: provider, Heads: []*evmtypes.Head{h13, h12, h11}})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+
+		actualL, actualLF, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, actualL.Number, h13.Number)
+		assert.Equal(t, actualLF.Number, h11.Number)
+	})
+	t.Run("returns error if finality provider fails", func(t *testing.T) {
+		provider := finalityProviderFunc(func(ctx context.Context, latest *evmtypes.Head) (int64, error) {
+			return 0, errors.New("batch not found")
+		})
+		htu := newHeadTrackerUniverse(t, opts{FinalityProvider: provider})
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+
+		_, _, err := htu.headTracker.LatestAndFinalizedBlock(ctx)
+		require.ErrorContains(t, err, "failed to get latest finalized block from finality provider: batch not found")
+	})
+}
+
+type finalityProviderFunc func(ctx context.Context, latest *evmtypes.Head) (int64, error)
+
+func (f finalityProviderFunc) LatestFinalizedBlockNumber(ctx context.Context, latest *evmtypes.Head) (int64, error) {
+	return f(ctx, latest)
 }
 
 func createHeadTracker(t testing.TB, ethClient *evmclimocks.Client, config commontypes.Config, htConfig commontypes.HeadTrackerConfig, orm headtracker.ORM) *headTrackerUniverse {
@@ -1268,7 +1358,7 @@ func createHeadTracker(t testing.TB, ethClient *evmclimocks.Client, config commo
 	mailMon := mailboxtest.NewMonitor(t)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
-		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon),
+		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon, nil),
 		headBroadcaster: hb,
 		headSaver:       hs,
 		mailMon:         mailMon,
@@ -1284,7 +1374,7 @@ func createHeadTrackerWithChecker(t *testing.T, ethClient *evmclimocks.Client, c
 	hs := headtracker.NewHeadSaver(lggr, orm, config, htConfig)
 	hb.Subscribe(checker)
 	mailMon := mailboxtest.NewMonitor(t)
-	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon)
+	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon, nil)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
 		headTracker:     ht,
@@ -21,9 +21,10 @@ type HeadSaver interface {
 
 // Type Alias for EVM Head Tracker Components
 type (
-	HeadTracker     = headtracker.HeadTracker[*evmtypes.Head, common.Hash]
-	HeadTrackable   = headtracker.HeadTrackable[*evmtypes.Head, common.Hash]
-	HeadListener    = headtracker.HeadListener[*evmtypes.Head, common.Hash]
-	HeadBroadcaster = headtracker.HeadBroadcaster[*evmtypes.Head, common.Hash]
-	Client          = htrktypes.Client[*evmtypes.Head, ethereum.Subscription, *big.Int, common.Hash]
+	HeadTracker      = headtracker.HeadTracker[*evmtypes.Head, common.Hash]
+	HeadTrackable    = headtracker.HeadTrackable[*evmtypes.Head, common.Hash]
+	HeadListener     = headtracker.HeadListener[*evmtypes.Head, common.Hash]
+	HeadBroadcaster  = headtracker.HeadBroadcaster[*evmtypes.Head, common.Hash]
+	Client           = htrktypes.Client[*evmtypes.Head, ethereum.Subscription, *big.Int, common.Hash]
+	FinalityProvider = headtracker.FinalityProvider[*evmtypes.Head, common.Hash]
 )
@@ -2,6 +2,7 @@ package logpoller
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -129,3 +130,23 @@ func (d disabled) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
 func (d disabled) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	return ErrDisabled
 }
+
+func (d disabled) ExportLogs(ctx context.Context, w io.Writer, fromBlock, toBlock int64) error {
+	return ErrDisabled
+}
+
+func (d disabled) ImportLogs(ctx context.Context, r io.Reader) (int, error) {
+	return 0, ErrDisabled
+}
+
+func (d disabled) SubscribeToFilter(name string) (*LogSubscription, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) SubscribeToExpressions(expressions []query.Expression) (*LogSubscription, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) FilterStats(ctx context.Context) ([]FilterStats, error) {
+	return nil, ErrDisabled
+}
@@ -7,6 +7,8 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"math/big"
 	"math/rand"
 	"sort"
@@ -44,10 +46,15 @@ type LogPoller interface {
 	UnregisterFilter(ctx context.Context, name string) error
 	HasFilter(name string) bool
 	GetFilters() map[string]Filter
+	FilterStats(ctx context.Context) ([]FilterStats, error)
 	LatestBlock(ctx context.Context) (LogPollerBlock, error)
 	GetBlocksRange(ctx context.Context, numbers []uint64) ([]LogPollerBlock, error)
 	FindLCA(ctx context.Context) (*LogPollerBlock, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
+	SubscribeToFilter(name string) (*LogSubscription, error)
+	SubscribeToExpressions(expressions []query.Expression) (*LogSubscription, error)
+	ExportLogs(ctx context.Context, w io.Writer, fromBlock, toBlock int64) error
+	ImportLogs(ctx context.Context, r io.Reader) (int, error)
 
 	// General querying
 	Logs(ctx context.Context, start, end int64, eventSig common.Hash, address common.Address) ([]Log, error)
@@ -80,6 +87,7 @@ type LogPollerTest interface {
 	Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery
 	GetReplayFromBlock(ctx context.Context, requested int64) (int64, error)
 	PruneOldBlocks(ctx context.Context) (bool, error)
+	RefreshFilterStats(ctx context.Context) error
 }
 
 type Client interface {
@@ -87,6 +95,7 @@ type Client interface {
 	HeadByHash(ctx context.Context, n common.Hash) (*evmtypes.Head, error)
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
 	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
 	ConfiguredChainID() *big.Int
 }
 
@@ -102,22 +111,34 @@ var (
 	ErrFinalityViolated                   = pkgerrors.New("finality violated")
 )
 
+// filterStatsInterval is how often the per filter storage metrics are refreshed. Computing them scans all the logs of the chain.
+const filterStatsInterval = time.Hour
+
 type logPoller struct {
 	services.StateMachine
 	ec                       Client
 	orm                      ORM
 	headTracker              HeadTracker
 	lggr                     logger.SugaredLogger
-	pollPeriod               time.Duration // poll period set by block production rate
-	useFinalityTag           bool          // indicates whether logPoller should use chain's finality or pick a fixed depth for finality
-	finalityDepth            int64         // finality depth is taken to mean that block (head - finality) is finalized. If `useFinalityTag` is set to true, this value is ignored, because finalityDepth is fetched from chain
-	keepFinalizedBlocksDepth int64         // the number of blocks behind the last finalized block we keep in database
-	backfillBatchSize        int64         // batch size to use when backfilling finalized logs
-	rpcBatchSize             int64         // batch size to use for fallback RPC calls made in GetBlocks
+	pollPeriod               time.Duration       // poll period set by block production rate
+	useFinalityTag           bool                // indicates whether logPoller should use chain's finality or pick a fixed depth for finality
+	finalityBlockTag         blockValidationType // the block tag, finalized or safe, validating the finality of blocks fetched from the RPC if useFinalityTag is true
+	finalityDepth            int64               // finality depth is taken to mean that block (head - finality) is finalized. If `useFinalityTag` is set to true, this value is ignored, because finalityDepth is fetched from chain
+	keepFinalizedBlocksDepth int64               // the number of blocks behind the last finalized block we keep in database
+	backfillBatchSize        int64               // batch size to use when backfilling finalized logs
+	rpcBatchSize             int64               // batch size to use for fallback RPC calls made in GetBlocks
 	logPrunePageSize         int64
 	clientErrors             config.ClientErrors
 	backupPollerNextBlock    int64 // next block to be processed by Backup LogPoller
 	backupPollerBlockDelay   int64 // how far behind regular LogPoller should BackupLogPoller run. 0 = disabled
+	subscribeLogs            bool  // whether logs are also received over a subscription, see subscriptionRun
+
+	subscriptionMu        sync.Mutex // serializes saving subscribed logs with saving polled blocks
+	subscriptionWatermark int64      // latest block saved by the poll loop, subscribed logs up to it are dropped
+	filtersChanged        chan struct{}
+
+	logSubscriptionsMu sync.Mutex
+	logSubscriptions   map[*LogSubscription]struct{}
 
 	filterMu        sync.RWMutex
 	filters         map[string]Filter
@@ -125,6 +146,9 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	filterStatsMu sync.RWMutex
+	filterStats   []FilterStats // computed every filterStatsInterval, see RefreshFilterStats
+
 	replayStart    chan int64
 	replayComplete chan error
 	stopCh         services.StopChan
@@ -140,6 +164,7 @@ type logPoller struct {
 type Opts struct {
 	PollPeriod               time.Duration
 	UseFinalityTag           bool
+	FinalityBlockTag         string // "finalized" if empty
 	FinalityDepth            int64
 	BackfillBatchSize        int64
 	RpcBatchSize             int64
@@ -147,6 +172,7 @@ type Opts struct {
 	BackupPollerBlockDelay   int64
 	LogPrunePageSize         int64
 	ClientErrors             config.ClientErrors
+	SubscribeLogs            bool
 }
 
 // NewLogPoller creates a log poller. Note there is an assumption
@@ -172,11 +198,16 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		backupPollerBlockDelay:   opts.BackupPollerBlockDelay,
 		finalityDepth:            opts.FinalityDepth,
 		useFinalityTag:           opts.UseFinalityTag,
+		finalityBlockTag:         finalityBlockValidation(opts.FinalityBlockTag),
 		backfillBatchSize:        opts.BackfillBatchSize,
 		rpcBatchSize:             opts.RpcBatchSize,
 		keepFinalizedBlocksDepth: opts.KeepFinalizedBlocksDepth,
 		logPrunePageSize:         opts.LogPrunePageSize,
 		clientErrors:             opts.ClientErrors,
+		subscribeLogs:            opts.SubscribeLogs,
+		subscriptionWatermark:    math.MaxInt64, // Unknown until the poll loop saves a block.
+		filtersChanged:           make(chan struct{}, 1),
+		logSubscriptions:         make(map[*LogSubscription]struct{}),
 		filters:                  make(map[string]Filter),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 		finalityViolated:         new(atomic.Bool),
@@ -195,6 +226,14 @@ type Filter struct {
 	LogsPerBlock uint64             // rate limit ( maximum # of logs per block, 0 = unlimited )
 }
 
+// FilterStats is the storage used by the logs of a registered filter.
+type FilterStats struct {
+	Filter
+	Logs         int64 // number of saved logs matching the filter's addresses and event sigs
+	SizeBytes    int64 // estimated size of these logs, excluding their indexes
+	LogsLastHour int64 // number of these logs saved in the last hour
+}
+
 // FilterName is a suggested convenience function for clients to construct unique filter names
 // to populate Name field of struct Filter
 func FilterName(id string, args ...any) string {
@@ -290,6 +329,7 @@ func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
 	}
 	lp.filters[filter.Name] = filter
 	lp.filterDirty = true
+	lp.notifyFiltersChanged()
 	return nil
 }
 
@@ -311,6 +351,7 @@ func (lp *logPoller) UnregisterFilter(ctx context.Context, name string) error {
 	}
 	delete(lp.filters, name)
 	lp.filterDirty = true
+	lp.notifyFiltersChanged()
 	return nil
 }
 
@@ -427,7 +468,7 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) (err error) {
 		return err
 	}
 	if fromBlock <= savedFinalizedBlockNumber {
-		err = lp.backfill(ctx, fromBlock, savedFinalizedBlockNumber)
+		err = lp.backfill(ctx, fromBlock, savedFinalizedBlockNumber, false)
 		if err != nil {
 			return err
 		}
@@ -498,6 +539,10 @@ func (lp *logPoller) Start(context.Context) error {
 		lp.wg.Add(2)
 		go lp.run()
 		go lp.backgroundWorkerRun()
+		if lp.subscribeLogs {
+			lp.wg.Add(1)
+			go lp.subscriptionRun()
+		}
 		return nil
 	})
 }
@@ -510,6 +555,7 @@ func (lp *logPoller) Close() error {
 		}
 		close(lp.stopCh)
 		lp.wg.Wait()
+		lp.closeLogSubscriptions(ErrLogPollerShutdown)
 		return nil
 	})
 }
@@ -556,6 +602,7 @@ func (lp *logPoller) loadFilters(ctx context.Context) error {
 
 	lp.filters = filters
 	lp.filterDirty = true
+	lp.notifyFiltersChanged()
 	return nil
 }
 
@@ -655,6 +702,8 @@ func (lp *logPoller) backgroundWorkerRun() {
 	// Deferring first prune by at least 5 mins reduces risk of putting too much pressure on the database.
 	blockPruneTick := tickStaggeredDelay(5*time.Minute, blockPruneInterval)
 	logPruneTick := tickStaggeredDelay(5*time.Minute, logPruneInterval)
+	// Refreshes the per filter storage metrics and the stats served by FilterStats, see ObservedORM.SelectFilterStats.
+	filterStatsTick := tickStaggeredDelay(5*time.Minute, filterStatsInterval)
 
 	// Start initial prune of unmatched logs after 5-15 successful expired log prunes, so that not all chains start
 	// around the same time. After that, every 20 successful expired log prunes.
@@ -672,6 +721,11 @@ func (lp *logPoller) backgroundWorkerRun() {
 				// Tick faster when cleanup can't keep up with the pace of new blocks
 				blockPruneTick = tickWithDefaultJitter(blockPruneShortInterval)
 			}
+		case <-filterStatsTick:
+			filterStatsTick = tickWithDefaultJitter(filterStatsInterval)
+			if err := lp.RefreshFilterStats(ctx); err != nil {
+				lp.lggr.Errorw("Unable to compute filter stats", "err", err)
+			}
 		case <-logPruneTick:
 			logPruneTick = tickWithDefaultJitter(logPruneInterval)
 			if allRemoved, err := lp.PruneExpiredLogs(ctx); err != nil {
@@ -752,7 +806,7 @@ func (lp *logPoller) BackupPollAndSaveLogs(ctx context.Context) {
 	lastSafeBackfillBlock := latestFinalizedBlockNumber - 1
 	if lastSafeBackfillBlock >= lp.backupPollerNextBlock {
 		lp.lggr.Infow("Backup poller started backfilling logs", "start", lp.backupPollerNextBlock, "end", lastSafeBackfillBlock)
-		if err = lp.backfill(ctx, lp.backupPollerNextBlock, lastSafeBackfillBlock); err != nil {
+		if err = lp.backfill(ctx, lp.backupPollerNextBlock, lastSafeBackfillBlock, false); err != nil {
 			// If there's an error backfilling, we can just return and retry from the last block saved
 			// since we don't save any blocks on backfilling. We may re-insert the same logs but thats ok.
 			lp.lggr.Warnw("Backup poller failed", "err", err)
@@ -826,7 +880,7 @@ func (lp *logPoller) blocksFromLogs(ctx context.Context, logs []types.Log, endBl
 // block range [start, end] and save them to the db.
 // Retries until ctx cancelled. Will return an error if cancelled
 // or if there is an error backfilling.
-func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+func (lp *logPoller) backfill(ctx context.Context, start, end int64, notify bool) error {
 	batchSize := lp.backfillBatchSize
 	for from := start; from <= end; from += batchSize {
 		to := mathutil.Min(from+batchSize-1, end)
@@ -862,11 +916,15 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 		}
 
 		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
-		err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
+		logs := convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID())
+		err = lp.orm.InsertLogsWithBlock(ctx, logs, endblock)
 		if err != nil {
 			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
 			return err
 		}
+		if notify {
+			lp.notifyLogs(logs)
+		}
 	}
 	return nil
 }
@@ -943,6 +1001,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.notifyReorg(blockAfterLCA.Number)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -992,7 +1051,11 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 	lastSafeBackfillBlock := latestFinalizedBlockNumber - 1
 	if lastSafeBackfillBlock >= currentBlockNumber {
 		lp.lggr.Infow("Backfilling logs", "start", currentBlockNumber, "end", lastSafeBackfillBlock)
-		if err = lp.backfill(ctx, currentBlockNumber, lastSafeBackfillBlock); err != nil {
+		if err = lp.reconcileSubscribedLogs(ctx, currentBlockNumber, lastSafeBackfillBlock); err != nil {
+			lp.lggr.Warnw("Unable to reconcile subscribed logs, retrying later", "err", err)
+			return
+		}
+		if err = lp.backfill(ctx, currentBlockNumber, lastSafeBackfillBlock, true); err != nil {
 			// If there's an error backfilling, we can just return and retry from the last block saved
 			// since we don't save any blocks on backfilling. We may re-insert the same logs but thats ok.
 			lp.lggr.Warnw("Unable to backfill finalized logs, retrying later", "err", err)
@@ -1022,7 +1085,7 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 		}
 		lp.lggr.Debugw("Unfinalized log query", "logs", len(logs), "currentBlockNumber", currentBlockNumber, "blockHash", currentBlock.Hash, "timestamp", currentBlock.Timestamp.Unix())
 		block := NewLogPollerBlock(h, currentBlockNumber, currentBlock.Timestamp, latestFinalizedBlockNumber)
-		err = lp.orm.InsertLogsWithBlock(
+		err = lp.insertLogsWithBlock(
 			ctx,
 			convertLogs(logs, []LogPollerBlock{block}, lp.lggr, lp.ec.ConfiguredChainID()),
 			block,
@@ -1128,6 +1191,38 @@ func (lp *logPoller) PruneExpiredLogs(ctx context.Context) (bool, error) {
 	return lp.logPrunePageSize == 0 || rowsRemoved < lp.logPrunePageSize, err
 }
 
+// FilterStats returns the storage used by the logs of each registered filter, to find the filters bloating the logs table.
+// The stats are the ones computed by the last RefreshFilterStats, so filters registered since then are missing, and
+// none are returned until the first refresh, a few minutes after start.
+func (lp *logPoller) FilterStats(ctx context.Context) ([]FilterStats, error) {
+	lp.filterStatsMu.RLock()
+	cached := lp.filterStats
+	lp.filterStatsMu.RUnlock()
+
+	filters := lp.GetFilters()
+	stats := make([]FilterStats, 0, len(cached))
+	for _, s := range cached {
+		if filter, ok := filters[s.Name]; ok {
+			s.Filter = filter
+			stats = append(stats, s)
+		}
+	}
+	return stats, nil
+}
+
+// RefreshFilterStats computes the stats returned by FilterStats. It scans all the logs of the chain, so it is only
+// called every filterStatsInterval by the background worker.
+func (lp *logPoller) RefreshFilterStats(ctx context.Context) error {
+	stats, err := lp.orm.SelectFilterStats(ctx)
+	if err != nil {
+		return err
+	}
+	lp.filterStatsMu.Lock()
+	lp.filterStats = stats
+	lp.filterStatsMu.Unlock()
+	return nil
+}
+
 func (lp *logPoller) PruneUnmatchedLogs(ctx context.Context) (bool, error) {
 	ids, err := lp.orm.SelectUnmatchedLogIDs(ctx, lp.logPrunePageSize)
 	if err != nil {
@@ -1343,10 +1438,19 @@ type blockValidationType string
 var (
 	latestBlock    blockValidationType = blockValidationType(rpc.LatestBlockNumber.String())
 	finalizedBlock blockValidationType = blockValidationType(rpc.FinalizedBlockNumber.String())
+	safeBlock      blockValidationType = blockValidationType(rpc.SafeBlockNumber.String())
 )
 
+// finalityBlockValidation returns the validation request of the given finality block tag
+func finalityBlockValidation(tag string) blockValidationType {
+	if tag == string(safeBlock) {
+		return safeBlock
+	}
+	return finalizedBlock
+}
+
 // fetchBlocks fetches a list of blocks in a single batch. validationReq is the string to use for the
-// additional validation request (either the "finalized" or "latest" string defined in rpc module), which
+// additional validation request (either the "finalized", "safe" or "latest" string defined in rpc module), which
 // will be used to validate the finality of the other blocks.
 func (lp *logPoller) fetchBlocks(ctx context.Context, blocksRequested []string, validationReq blockValidationType) (blocks []*evmtypes.Head, err error) {
 	n := len(blocksRequested)
@@ -1411,7 +1515,7 @@ func (lp *logPoller) fetchBlocks(ctx context.Context, blocksRequested []string,
 func (lp *logPoller) batchFetchBlocks(ctx context.Context, blocksRequested []string, batchSize int64) ([]*evmtypes.Head, error) {
 	var blocks = make([]*evmtypes.Head, 0, len(blocksRequested)+1)
 
-	validationReq := finalizedBlock
+	validationReq := lp.finalityBlockTag
 	if !lp.useFinalityTag {
 		validationReq = latestBlock
 	}
@@ -1459,7 +1563,11 @@ func (lp *logPoller) IndexedLogsWithSigsExcluding(ctx context.Context, address c
 
 // DeleteLogsAndBlocksAfter - removes blocks and logs starting from the specified block
 func (lp *logPoller) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
-	return lp.orm.DeleteLogsAndBlocksAfter(ctx, start)
+	if err := lp.orm.DeleteLogsAndBlocksAfter(ctx, start); err != nil {
+		return err
+	}
+	lp.notifyReorg(start)
+	return nil
 }
 
 func (lp *logPoller) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
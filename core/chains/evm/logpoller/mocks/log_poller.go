@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	io "io"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	logpoller "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
@@ -123,6 +125,113 @@ func (_c *LogPoller_DeleteLogsAndBlocksAfter_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ExportLogs provides a mock function with given fields: ctx, w, fromBlock, toBlock
+func (_m *LogPoller) ExportLogs(ctx context.Context, w io.Writer, fromBlock int64, toBlock int64) error {
+	ret := _m.Called(ctx, w, fromBlock, toBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Writer, int64, int64) error); ok {
+		r0 = rf(ctx, w, fromBlock, toBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LogPoller_ExportLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportLogs'
+type LogPoller_ExportLogs_Call struct {
+	*mock.Call
+}
+
+// ExportLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - w io.Writer
+//   - fromBlock int64
+//   - toBlock int64
+func (_e *LogPoller_Expecter) ExportLogs(ctx interface{}, w interface{}, fromBlock interface{}, toBlock interface{}) *LogPoller_ExportLogs_Call {
+	return &LogPoller_ExportLogs_Call{Call: _e.mock.On("ExportLogs", ctx, w, fromBlock, toBlock)}
+}
+
+func (_c *LogPoller_ExportLogs_Call) Run(run func(ctx context.Context, w io.Writer, fromBlock int64, toBlock int64)) *LogPoller_ExportLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Writer), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ExportLogs_Call) Return(_a0 error) *LogPoller_ExportLogs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LogPoller_ExportLogs_Call) RunAndReturn(run func(context.Context, io.Writer, int64, int64) error) *LogPoller_ExportLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterStats provides a mock function with given fields: ctx
+func (_m *LogPoller) FilterStats(ctx context.Context) ([]logpoller.FilterStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterStats")
+	}
+
+	var r0 []logpoller.FilterStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]logpoller.FilterStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []logpoller.FilterStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.FilterStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_FilterStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterStats'
+type LogPoller_FilterStats_Call struct {
+	*mock.Call
+}
+
+// FilterStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LogPoller_Expecter) FilterStats(ctx interface{}) *LogPoller_FilterStats_Call {
+	return &LogPoller_FilterStats_Call{Call: _e.mock.On("FilterStats", ctx)}
+}
+
+func (_c *LogPoller_FilterStats_Call) Run(run func(ctx context.Context)) *LogPoller_FilterStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LogPoller_FilterStats_Call) Return(_a0 []logpoller.FilterStats, _a1 error) *LogPoller_FilterStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_FilterStats_Call) RunAndReturn(run func(context.Context) ([]logpoller.FilterStats, error)) *LogPoller_FilterStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FilteredLogs provides a mock function with given fields: ctx, filter, limitAndSort, queryName
 func (_m *LogPoller) FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]logpoller.Log, error) {
 	ret := _m.Called(ctx, filter, limitAndSort, queryName)
@@ -486,6 +595,63 @@ func (_c *LogPoller_Healthy_Call) RunAndReturn(run func() error) *LogPoller_Heal
 	return _c
 }
 
+// ImportLogs provides a mock function with given fields: ctx, r
+func (_m *LogPoller) ImportLogs(ctx context.Context, r io.Reader) (int, error) {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportLogs")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) (int, error)); ok {
+		return rf(ctx, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) int); ok {
+		r0 = rf(ctx, r)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader) error); ok {
+		r1 = rf(ctx, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_ImportLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLogs'
+type LogPoller_ImportLogs_Call struct {
+	*mock.Call
+}
+
+// ImportLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r io.Reader
+func (_e *LogPoller_Expecter) ImportLogs(ctx interface{}, r interface{}) *LogPoller_ImportLogs_Call {
+	return &LogPoller_ImportLogs_Call{Call: _e.mock.On("ImportLogs", ctx, r)}
+}
+
+func (_c *LogPoller_ImportLogs_Call) Run(run func(ctx context.Context, r io.Reader)) *LogPoller_ImportLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ImportLogs_Call) Return(_a0 int, _a1 error) *LogPoller_ImportLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_ImportLogs_Call) RunAndReturn(run func(context.Context, io.Reader) (int, error)) *LogPoller_ImportLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IndexedLogs provides a mock function with given fields: ctx, eventSig, address, topicIndex, topicValues, confs
 func (_m *LogPoller) IndexedLogs(ctx context.Context, eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs types.Confirmations) ([]logpoller.Log, error) {
 	ret := _m.Called(ctx, eventSig, address, topicIndex, topicValues, confs)
@@ -1809,6 +1975,122 @@ func (_c *LogPoller_Start_Call) RunAndReturn(run func(context.Context) error) *L
 	return _c
 }
 
+// SubscribeToExpressions provides a mock function with given fields: expressions
+func (_m *LogPoller) SubscribeToExpressions(expressions []query.Expression) (*logpoller.LogSubscription, error) {
+	ret := _m.Called(expressions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeToExpressions")
+	}
+
+	var r0 *logpoller.LogSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]query.Expression) (*logpoller.LogSubscription, error)); ok {
+		return rf(expressions)
+	}
+	if rf, ok := ret.Get(0).(func([]query.Expression) *logpoller.LogSubscription); ok {
+		r0 = rf(expressions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.LogSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]query.Expression) error); ok {
+		r1 = rf(expressions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_SubscribeToExpressions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeToExpressions'
+type LogPoller_SubscribeToExpressions_Call struct {
+	*mock.Call
+}
+
+// SubscribeToExpressions is a helper method to define mock.On call
+//   - expressions []query.Expression
+func (_e *LogPoller_Expecter) SubscribeToExpressions(expressions interface{}) *LogPoller_SubscribeToExpressions_Call {
+	return &LogPoller_SubscribeToExpressions_Call{Call: _e.mock.On("SubscribeToExpressions", expressions)}
+}
+
+func (_c *LogPoller_SubscribeToExpressions_Call) Run(run func(expressions []query.Expression)) *LogPoller_SubscribeToExpressions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]query.Expression))
+	})
+	return _c
+}
+
+func (_c *LogPoller_SubscribeToExpressions_Call) Return(_a0 *logpoller.LogSubscription, _a1 error) *LogPoller_SubscribeToExpressions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_SubscribeToExpressions_Call) RunAndReturn(run func([]query.Expression) (*logpoller.LogSubscription, error)) *LogPoller_SubscribeToExpressions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeToFilter provides a mock function with given fields: name
+func (_m *LogPoller) SubscribeToFilter(name string) (*logpoller.LogSubscription, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeToFilter")
+	}
+
+	var r0 *logpoller.LogSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*logpoller.LogSubscription, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *logpoller.LogSubscription); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.LogSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_SubscribeToFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeToFilter'
+type LogPoller_SubscribeToFilter_Call struct {
+	*mock.Call
+}
+
+// SubscribeToFilter is a helper method to define mock.On call
+//   - name string
+func (_e *LogPoller_Expecter) SubscribeToFilter(name interface{}) *LogPoller_SubscribeToFilter_Call {
+	return &LogPoller_SubscribeToFilter_Call{Call: _e.mock.On("SubscribeToFilter", name)}
+}
+
+func (_c *LogPoller_SubscribeToFilter_Call) Run(run func(name string)) *LogPoller_SubscribeToFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *LogPoller_SubscribeToFilter_Call) Return(_a0 *logpoller.LogSubscription, _a1 error) *LogPoller_SubscribeToFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_SubscribeToFilter_Call) RunAndReturn(run func(string) (*logpoller.LogSubscription, error)) *LogPoller_SubscribeToFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnregisterFilter provides a mock function with given fields: ctx, name
 func (_m *LogPoller) UnregisterFilter(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
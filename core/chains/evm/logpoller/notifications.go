@@ -0,0 +1,299 @@
+package logpoller
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// logSubscriptionBufferSize is the number of events a LogSubscription buffers before it is closed as lagging.
+const logSubscriptionBufferSize = 100
+
+var ErrLogSubscriptionLagging = errors.New("log subscription closed, its events were not received fast enough")
+
+// LogEvent is a change to the logs matched by a LogSubscription.
+type LogEvent struct {
+	// Logs are the new matching logs, delivered as soon as they are saved by the poll loop or the log subscription.
+	// The same log can be delivered more than once, e.g. if it's saved by both.
+	Logs []Log
+	// ReorgedFromBlock is non-zero when the logs from this block on may have been removed because of a reorg. The logs
+	// of the canonical blocks are delivered again as they are saved.
+	ReorgedFromBlock int64
+}
+
+// LogSubscription delivers the changes to the logs it matches, so that consumers don't have to re-query the db for them.
+// Logs saved by a replay or by the backup poller are not delivered. The subscription is closed if its events are not
+// received fast enough, in which case Err returns ErrLogSubscriptionLagging, and the consumer should re-query the db.
+type LogSubscription struct {
+	lp     *logPoller
+	match  func(Log) bool
+	events chan LogEvent
+	err    error
+}
+
+// Events returns the channel of events, which is closed when the subscription is closed.
+func (s *LogSubscription) Events() <-chan LogEvent {
+	return s.events
+}
+
+// Err returns why the subscription was closed, once Events is closed.
+func (s *LogSubscription) Err() error {
+	s.lp.logSubscriptionsMu.Lock()
+	defer s.lp.logSubscriptionsMu.Unlock()
+	return s.err
+}
+
+// Close unsubscribes and closes Events.
+func (s *LogSubscription) Close() {
+	s.lp.logSubscriptionsMu.Lock()
+	defer s.lp.logSubscriptionsMu.Unlock()
+	s.lp.closeLogSubscription(s, nil)
+}
+
+// SubscribeToFilter subscribes to the logs of the registered filter with the given name. The logs are matched against
+// the filter registered under the name when they are saved, so that re-registering it with e.g. new addresses applies
+// to the subscription, and no logs are delivered while it is unregistered.
+func (lp *logPoller) SubscribeToFilter(name string) (*LogSubscription, error) {
+	lp.filterMu.RLock()
+	_, ok := lp.filters[name]
+	lp.filterMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("filter %s is not registered", name)
+	}
+	return lp.addLogSubscription(func(log Log) bool {
+		lp.filterMu.RLock()
+		filter, ok := lp.filters[name]
+		lp.filterMu.RUnlock()
+		return ok && filter.matches(log)
+	}), nil
+}
+
+// SubscribeToExpressions subscribes to the logs matching all the expressions, which are evaluated when the logs are saved.
+// Logs are delivered before they are finalized, so only the unconfirmed confidence level and confirmations are supported.
+func (lp *logPoller) SubscribeToExpressions(expressions []query.Expression) (*LogSubscription, error) {
+	match, err := newExpressionsMatcher(expressions, query.AND)
+	if err != nil {
+		return nil, err
+	}
+	return lp.addLogSubscription(match), nil
+}
+
+func (lp *logPoller) addLogSubscription(match func(Log) bool) *LogSubscription {
+	sub := &LogSubscription{lp: lp, match: match, events: make(chan LogEvent, logSubscriptionBufferSize)}
+	lp.logSubscriptionsMu.Lock()
+	defer lp.logSubscriptionsMu.Unlock()
+	lp.logSubscriptions[sub] = struct{}{}
+	return sub
+}
+
+// closeLogSubscription must be called with logSubscriptionsMu held.
+func (lp *logPoller) closeLogSubscription(sub *LogSubscription, err error) {
+	if _, ok := lp.logSubscriptions[sub]; !ok {
+		return
+	}
+	delete(lp.logSubscriptions, sub)
+	sub.err = err
+	close(sub.events)
+}
+
+func (lp *logPoller) closeLogSubscriptions(err error) {
+	lp.logSubscriptionsMu.Lock()
+	defer lp.logSubscriptionsMu.Unlock()
+	for sub := range lp.logSubscriptions {
+		lp.closeLogSubscription(sub, err)
+	}
+}
+
+// notifyLogs delivers the saved logs to the subscriptions matching them.
+func (lp *logPoller) notifyLogs(logs []Log) {
+	if len(logs) == 0 {
+		return
+	}
+	lp.logSubscriptionsMu.Lock()
+	defer lp.logSubscriptionsMu.Unlock()
+	for sub := range lp.logSubscriptions {
+		var matched []Log
+		for _, log := range logs {
+			if sub.match(log) {
+				matched = append(matched, log)
+			}
+		}
+		if len(matched) > 0 {
+			lp.sendLogEvent(sub, LogEvent{Logs: matched})
+		}
+	}
+}
+
+// notifyReorg notifies all the subscriptions that the logs from the given block on may have been removed.
+func (lp *logPoller) notifyReorg(fromBlock int64) {
+	lp.logSubscriptionsMu.Lock()
+	defer lp.logSubscriptionsMu.Unlock()
+	for sub := range lp.logSubscriptions {
+		lp.sendLogEvent(sub, LogEvent{ReorgedFromBlock: fromBlock})
+	}
+}
+
+// sendLogEvent must be called with logSubscriptionsMu held. It never blocks, so a slow consumer can't stall the poll loop.
+func (lp *logPoller) sendLogEvent(sub *LogSubscription, event LogEvent) {
+	select {
+	case sub.events <- event:
+	default:
+		lp.lggr.Warnw("Closing lagging log subscription")
+		lp.closeLogSubscription(sub, ErrLogSubscriptionLagging)
+	}
+}
+
+// matches returns whether the log is one of the filter's logs.
+func (filter *Filter) matches(log Log) bool {
+	topics := log.GetTopics()
+	if !containsHash(filter.EventSigs, log.EventSig) || !containsAddress(filter.Addresses, log.Address) {
+		return false
+	}
+	for i, values := range []evmtypes.HashArray{filter.Topic2, filter.Topic3, filter.Topic4} {
+		if len(values) > 0 && (len(topics) <= i+1 || !containsHash(values, topics[i+1])) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHash(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// newExpressionsMatcher evaluates the expressions, combined with op, on a log in memory, like the db queries of FilteredLogs.
+func newExpressionsMatcher(expressions []query.Expression, op query.BoolOperator) (func(Log) bool, error) {
+	matchers := make([]func(Log) bool, len(expressions))
+	for i, expression := range expressions {
+		var err error
+		if expression.IsPrimitive() {
+			matchers[i], err = newPrimitiveMatcher(expression.Primitive)
+		} else {
+			matchers[i], err = newExpressionsMatcher(expression.BoolExpression.Expressions, expression.BoolExpression.BoolOperator)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return func(log Log) bool {
+		for _, match := range matchers {
+			if match(log) == (op == query.OR) {
+				return op == query.OR
+			}
+		}
+		return op == query.AND
+	}, nil
+}
+
+func newPrimitiveMatcher(primitive primitives.Primitive) (func(Log) bool, error) {
+	switch p := primitive.(type) {
+	case *primitives.Block:
+		block, err := strconv.ParseInt(p.Block, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block %q: %w", p.Block, err)
+		}
+		return func(log Log) bool {
+			return compareWith(p.Operator, cmp.Compare(log.BlockNumber, block))
+		}, nil
+	case *primitives.Timestamp:
+		timestamp := time.Unix(int64(p.Timestamp), 0)
+		return func(log Log) bool {
+			return compareWith(p.Operator, log.BlockTimestamp.Compare(timestamp))
+		}, nil
+	case *primitives.TxHash:
+		b, err := hexutil.Decode(p.TxHash)
+		if errors.Is(err, hexutil.ErrMissingPrefix) {
+			b, err = hexutil.Decode("0x" + p.TxHash)
+		}
+		if err != nil {
+			return nil, err
+		}
+		txHash := common.BytesToHash(b)
+		return func(log Log) bool { return log.TxHash == txHash }, nil
+	case *primitives.Confidence:
+		if p.ConfidenceLevel != primitives.Unconfirmed {
+			return nil, fmt.Errorf("confidence level %s is not supported by log subscriptions", p.ConfidenceLevel)
+		}
+		return func(Log) bool { return true }, nil
+	case *confirmationsFilter:
+		if p.Confirmations != evmtypes.Unconfirmed {
+			return nil, fmt.Errorf("confirmations %d are not supported by log subscriptions", p.Confirmations)
+		}
+		return func(Log) bool { return true }, nil
+	case *addressFilter:
+		return func(log Log) bool { return log.Address == p.address }, nil
+	case *eventSigFilter:
+		return func(log Log) bool { return log.EventSig == p.eventSig }, nil
+	case *eventByWordFilter:
+		return func(log Log) bool {
+			start := mathutil.Min(32*p.WordIndex, len(log.Data))
+			word := log.Data[start:mathutil.Min(start+32, len(log.Data))]
+			return compareHashes(p.HashedValueComparers, word)
+		}, nil
+	case *eventByTopicFilter:
+		if !(p.Topic == 1 || p.Topic == 2 || p.Topic == 3) {
+			return nil, fmt.Errorf("invalid index for topic: %d", p.Topic)
+		}
+		return func(log Log) bool {
+			topics := log.GetTopics()
+			return len(topics) > int(p.Topic) && compareHashes(p.ValueComparers, topics[p.Topic].Bytes())
+		}, nil
+	default:
+		return nil, fmt.Errorf("primitive %T is not supported by log subscriptions", primitive)
+	}
+}
+
+func compareHashes(comparers []HashedValueComparator, value []byte) bool {
+	for _, comparer := range comparers {
+		if !compareWith(comparer.Operator, bytes.Compare(value, comparer.Value.Bytes())) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareWith returns whether the result of comparing a to b satisfies a op b.
+func compareWith(op primitives.ComparisonOperator, cmp int) bool {
+	switch op {
+	case primitives.Eq:
+		return cmp == 0
+	case primitives.Neq:
+		return cmp != 0
+	case primitives.Gt:
+		return cmp > 0
+	case primitives.Lt:
+		return cmp < 0
+	case primitives.Gte:
+		return cmp >= 0
+	case primitives.Lte:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
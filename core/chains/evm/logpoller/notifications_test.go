@@ -0,0 +1,101 @@
+package logpoller_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestLogPoller_SubscribeToLogs(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	th := SetupTH(t, logpoller.Opts{
+		UseFinalityTag:           false,
+		FinalityDepth:            2,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	})
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      "notifications",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1, th.EmitterAddress2},
+	}))
+
+	_, err := th.LogPoller.SubscribeToFilter("missing")
+	require.ErrorContains(t, err, "filter missing is not registered")
+	_, err = th.LogPoller.SubscribeToExpressions([]query.Expression{query.Confidence(primitives.Finalized)})
+	require.ErrorContains(t, err, "is not supported by log subscriptions")
+
+	filterSub, err := th.LogPoller.SubscribeToFilter("notifications")
+	require.NoError(t, err)
+	defer filterSub.Close()
+	exprSub, err := th.LogPoller.SubscribeToExpressions([]query.Expression{
+		logpoller.NewAddressFilter(th.EmitterAddress2),
+		logpoller.NewEventSigFilter(EmitterABI.Events["Log1"].ID),
+	})
+	require.NoError(t, err)
+	defer exprSub.Close()
+
+	receive := func(t *testing.T, sub *logpoller.LogSubscription) logpoller.LogEvent {
+		select {
+		case event, ok := <-sub.Events():
+			require.True(t, ok, "subscription closed: %v", sub.Err())
+			return event
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for a log event")
+			return logpoller.LogEvent{}
+		}
+	}
+
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	_, err = th.Emitter2.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	th.PollAndSaveLogs(ctx, 1)
+
+	event := receive(t, filterSub)
+	require.Len(t, event.Logs, 2)
+	assert.Zero(t, event.ReorgedFromBlock)
+
+	event = receive(t, exprSub)
+	require.Len(t, event.Logs, 1)
+	assert.Equal(t, th.EmitterAddress2, event.Logs[0].Address)
+
+	require.NoError(t, th.LogPoller.DeleteLogsAndBlocksAfter(ctx, 2))
+	assert.Equal(t, int64(2), receive(t, filterSub).ReorgedFromBlock)
+	assert.Equal(t, int64(2), receive(t, exprSub).ReorgedFromBlock)
+
+	exprSub.Close()
+	_, ok := <-exprSub.Events()
+	assert.False(t, ok)
+	assert.NoError(t, exprSub.Err())
+
+	// the filter subscription matches the filter as currently registered
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      "notifications",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
+		Addresses: []common.Address{th.EmitterAddress1, th.EmitterAddress2},
+	}))
+	_, err = th.Emitter1.EmitLog2(th.Owner, []*big.Int{big.NewInt(3)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	th.PollAndSaveLogs(ctx, 2)
+	for found := false; !found; {
+		for _, log := range receive(t, filterSub).Logs {
+			found = found || log.EventSig == EmitterABI.Events["Log2"].ID
+		}
+	}
+}
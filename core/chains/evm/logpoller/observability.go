@@ -64,6 +64,18 @@ var (
 		Name: "log_poller_blocks_inserted",
 		Help: "Counter to track number of blocks inserted by Log Poller",
 	}, []string{"evmChainID"})
+	lpFilterLogs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_filter_logs",
+		Help: "Number of logs stored by Log Poller matching a filter",
+	}, []string{"evmChainID", "filterName"})
+	lpFilterLogsSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_filter_logs_size_bytes",
+		Help: "Estimated size of the logs stored by Log Poller matching a filter, excluding indexes",
+	}, []string{"evmChainID", "filterName"})
+	lpFilterLogsLastHour = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_filter_logs_last_hour",
+		Help: "Number of logs matching a filter inserted by Log Poller in the last hour",
+	}, []string{"evmChainID", "filterName"})
 )
 
 // ObservedORM is a decorator layer for ORM used by LogPoller, responsible for pushing Prometheus metrics reporting duration and size of result set for the queries.
@@ -74,6 +86,9 @@ type ObservedORM struct {
 	datasetSize    *prometheus.GaugeVec
 	logsInserted   *prometheus.CounterVec
 	blocksInserted *prometheus.CounterVec
+	filterLogs     *prometheus.GaugeVec
+	filterLogsSize *prometheus.GaugeVec
+	filterLogsHour *prometheus.GaugeVec
 	chainId        string
 }
 
@@ -86,6 +101,9 @@ func NewObservedORM(chainID *big.Int, ds sqlutil.DataSource, lggr logger.Logger)
 		datasetSize:    lpQueryDataSets,
 		logsInserted:   lpLogsInserted,
 		blocksInserted: lpBlockInserted,
+		filterLogs:     lpFilterLogs,
+		filterLogsSize: lpFilterLogsSize,
+		filterLogsHour: lpFilterLogsLastHour,
 		chainId:        chainID.String(),
 	}
 }
@@ -136,6 +154,12 @@ func (o *ObservedORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64)
 	})
 }
 
+func (o *ObservedORM) DeleteLogsNotInBlocks(ctx context.Context, start, end int64, blockHashes []common.Hash) (int64, error) {
+	return withObservedExecAndRowsAffected(o, "DeleteLogsNotInBlocks", del, func() (int64, error) {
+		return o.ORM.DeleteLogsNotInBlocks(ctx, start, end, blockHashes)
+	})
+}
+
 func (o *ObservedORM) DeleteLogsByRowID(ctx context.Context, rowIDs []uint64) (int64, error) {
 	return withObservedExecAndRowsAffected(o, "DeleteLogsByRowID", del, func() (int64, error) {
 		return o.ORM.DeleteLogsByRowID(ctx, rowIDs)
@@ -154,6 +178,25 @@ func (o *ObservedORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64
 	})
 }
 
+// SelectFilterStats also reports the stats of each filter, replacing the ones of the filters which were unregistered.
+func (o *ObservedORM) SelectFilterStats(ctx context.Context) ([]FilterStats, error) {
+	stats, err := withObservedQueryAndResults(o, "SelectFilterStats", func() ([]FilterStats, error) {
+		return o.ORM.SelectFilterStats(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, gauge := range []*prometheus.GaugeVec{o.filterLogs, o.filterLogsSize, o.filterLogsHour} {
+		gauge.DeletePartialMatch(prometheus.Labels{"evmChainID": o.chainId})
+	}
+	for _, s := range stats {
+		o.filterLogs.WithLabelValues(o.chainId, s.Name).Set(float64(s.Logs))
+		o.filterLogsSize.WithLabelValues(o.chainId, s.Name).Set(float64(s.SizeBytes))
+		o.filterLogsHour.WithLabelValues(o.chainId, s.Name).Set(float64(s.LogsLastHour))
+	}
+	return stats, nil
+}
+
 func (o *ObservedORM) SelectBlockByNumber(ctx context.Context, n int64) (*LogPollerBlock, error) {
 	return withObservedQuery(o, "SelectBlockByNumber", func() (*LogPollerBlock, error) {
 		return o.ORM.SelectBlockByNumber(ctx, n)
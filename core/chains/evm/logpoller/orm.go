@@ -38,8 +38,10 @@ type ORM interface {
 	InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
+	DeleteLogsNotInBlocks(ctx context.Context, start, end int64, blockHashes []common.Hash) (int64, error)
 	SelectUnmatchedLogIDs(ctx context.Context, limit int64) (ids []uint64, err error)
 	DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error)
+	SelectFilterStats(ctx context.Context) ([]FilterStats, error)
 
 	GetBlocksRange(ctx context.Context, start int64, end int64) ([]LogPollerBlock, error)
 	SelectBlockByNumber(ctx context.Context, blockNumber int64) (*LogPollerBlock, error)
@@ -377,6 +379,21 @@ func (o *DSORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
 	})
 }
 
+// DeleteLogsNotInBlocks deletes the logs in the [start, end] block range whose block hash is not one of blockHashes,
+// which removes the logs of reorged blocks.
+func (o *DSORM) DeleteLogsNotInBlocks(ctx context.Context, start, end int64, blockHashes []common.Hash) (int64, error) {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM evm.logs
+		WHERE evm_chain_id = $1
+		AND block_number >= $2
+		AND block_number <= $3
+		AND NOT (block_hash = ANY($4))`,
+		ubig.New(o.chainID), start, end, append([][]byte{}, concatBytes(blockHashes)...))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 type Exp struct {
 	Address      common.Address
 	EventSig     common.Hash
@@ -434,6 +451,26 @@ func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, erro
 	return result.RowsAffected()
 }
 
+// SelectFilterStats returns the storage used by the logs of each filter. Logs are matched to filters by address and
+// event sig, like when they are pruned, so a log matching several filters is counted for each of them.
+func (o *DSORM) SelectFilterStats(ctx context.Context) ([]FilterStats, error) {
+	query := `
+		SELECT f.name,
+			COUNT(l.id) AS logs,
+			COALESCE(SUM(pg_column_size(l.*)), 0) AS size_bytes,
+			COUNT(l.id) FILTER (WHERE l.created_at > STATEMENT_TIMESTAMP() - interval '1 hour') AS logs_last_hour
+		FROM (
+			SELECT DISTINCT name, evm_chain_id, address, event
+			FROM evm.log_poller_filters
+			WHERE evm_chain_id = $1
+		) f LEFT JOIN evm.logs l ON l.evm_chain_id = f.evm_chain_id AND l.address = f.address AND l.event_sig = f.event
+		GROUP BY f.name
+		ORDER BY f.name`
+	var stats []FilterStats
+	err := o.ds.SelectContext(ctx, &stats, query, ubig.New(o.chainID))
+	return stats, err
+}
+
 // InsertLogs is idempotent to support replays.
 func (o *DSORM) InsertLogs(ctx context.Context, logs []Log) error {
 	if err := o.validateLogs(logs); err != nil {
@@ -1032,7 +1069,8 @@ func (o *DSORM) SelectIndexedLogsWithSigsExcluding(ctx context.Context, sigA, si
 }
 
 func (o *DSORM) FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, _ string) ([]Log, error) {
-	qs, args, err := (&pgDSLParser{}).buildQuery(o.chainID, filter, limitAndSort)
+	parser := &pgDSLParser{}
+	qs, args, err := parser.buildQuery(o.chainID, filter, limitAndSort)
 	if err != nil {
 		return nil, err
 	}
@@ -1052,6 +1090,23 @@ func (o *DSORM) FilteredLogs(ctx context.Context, filter []query.Expression, lim
 		return nil, err
 	}
 
+	if parser.cursorCheck != "" {
+		// Checked after selecting the logs, so that a reorg removing the log of the cursor before the select is noticed.
+		query, sqlArgs, err = o.ds.BindNamed(parser.cursorCheck, values)
+		if err != nil {
+			return nil, err
+		}
+
+		var exists bool
+		if err = o.ds.GetContext(ctx, &exists, query, sqlArgs...); err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, ErrCursorReorged
+		}
+	}
+
 	return logs, nil
 }
 
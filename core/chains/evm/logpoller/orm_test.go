@@ -1193,6 +1193,49 @@ func TestORM_DeleteBlocksBefore(t *testing.T) {
 	require.Equal(t, err, sql.ErrNoRows)
 }
 
+func TestORM_SelectFilterStats(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	th := SetupTH(t, lpOpts)
+	event1 := EmitterABI.Events["Log1"].ID
+	event2 := EmitterABI.Events["Log2"].ID
+
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{Name: "both", EventSigs: []common.Hash{event1, event2}, Addresses: []common.Address{th.EmitterAddress1}, MaxLogsKept: 10}))
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{Name: "log2", EventSigs: []common.Hash{event2}, Addresses: []common.Address{th.EmitterAddress1}}))
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{Name: "empty", EventSigs: []common.Hash{event1}, Addresses: []common.Address{th.EmitterAddress2}}))
+	require.NoError(t, th.ORM.InsertLogs(ctx, []logpoller.Log{
+		GenLog(th.ChainID, 1, 1, "0x1", event1.Bytes(), th.EmitterAddress1),
+		GenLog(th.ChainID, 2, 1, "0x1", event1.Bytes(), th.EmitterAddress1),
+		GenLog(th.ChainID, 3, 1, "0x1", event2.Bytes(), th.EmitterAddress1),
+		GenLog(th.ChainID2, 1, 1, "0x1", event1.Bytes(), th.EmitterAddress2),
+	}))
+
+	stats, err := th.LogPoller.FilterStats(ctx)
+	require.NoError(t, err)
+	require.Empty(t, stats, "stats are only computed by RefreshFilterStats")
+
+	require.NoError(t, th.LogPoller.RefreshFilterStats(ctx))
+	stats, err = th.LogPoller.FilterStats(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats, 3)
+
+	assert.Equal(t, "both", stats[0].Name)
+	assert.Equal(t, int64(3), stats[0].Logs)
+	assert.Equal(t, int64(3), stats[0].LogsLastHour)
+	assert.Positive(t, stats[0].SizeBytes)
+	assert.Equal(t, uint64(10), stats[0].MaxLogsKept)
+	assert.Equal(t, []common.Address{th.EmitterAddress1}, []common.Address(stats[0].Addresses))
+
+	assert.Equal(t, "empty", stats[1].Name)
+	assert.Zero(t, stats[1].Logs)
+	assert.Zero(t, stats[1].SizeBytes)
+
+	// The log of event2 is counted for both filters matching it.
+	assert.Equal(t, "log2", stats[2].Name)
+	assert.Equal(t, int64(1), stats[2].Logs)
+	assert.Less(t, stats[2].SizeBytes, stats[0].SizeBytes)
+}
+
 func TestLogPoller_Logs(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -1273,6 +1316,64 @@ func TestLogPoller_Logs(t *testing.T) {
 	assert.Equal(t, event1.Bytes(), lgs[0].Topics[0])
 }
 
+func TestORM_FilteredLogs_CursorAcrossEvents(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	th := SetupTH(t, lpOpts)
+	event1 := EmitterABI.Events["Log1"].ID
+	event2 := EmitterABI.Events["Log2"].ID
+	address := common.HexToAddress("0x2ab9a2Dc53736b361b72d900CdF9F78F9406fbbb")
+
+	require.NoError(t, th.ORM.InsertLogs(ctx, []logpoller.Log{
+		GenLogWithData(th.ChainID, address, event1, 1, 1, logpoller.EvmWord(1).Bytes()),
+		GenLogWithData(th.ChainID, address, event2, 2, 1, logpoller.EvmWord(1).Bytes()),
+		GenLogWithData(th.ChainID, address, event1, 1, 2, logpoller.EvmWord(5).Bytes()),
+		GenLogWithData(th.ChainID, address, event2, 2, 2, logpoller.EvmWord(5).Bytes()),
+		GenLogWithData(th.ChainID, address, event1, 1, 3, logpoller.EvmWord(7).Bytes()),
+		GenLogWithData(th.ChainID, address, event2, 2, 3, logpoller.EvmWord(7).Bytes()),
+	}))
+
+	// event1 logs with a first data word of at least 5, or any event2 log
+	filter := []query.Expression{
+		logpoller.NewAddressFilter(address),
+		query.Or(
+			query.And(
+				logpoller.NewEventSigFilter(event1),
+				logpoller.NewEventByWordFilter(0, []logpoller.HashedValueComparator{
+					{Value: logpoller.EvmWord(5), Operator: primitives.Gte},
+				}),
+			),
+			logpoller.NewEventSigFilter(event2),
+		),
+	}
+
+	lgs, err := th.ORM.FilteredLogs(ctx, filter, query.NewLimitAndSort(query.CountLimit(3), query.NewSortBySequence(query.Asc)), "")
+	require.NoError(t, err)
+	require.Len(t, lgs, 3)
+	assert.Equal(t, []int64{1, 2, 2}, []int64{lgs[0].BlockNumber, lgs[1].BlockNumber, lgs[2].BlockNumber})
+	assert.Equal(t, event2, lgs[0].EventSig)
+
+	cursor := logpoller.FormatContractReaderCursor(lgs[2])
+	lgs, err = th.ORM.FilteredLogs(ctx, filter, query.NewLimitAndSort(query.CursorLimit(cursor, query.CursorFollowing, 3)), "")
+	require.NoError(t, err)
+	require.Len(t, lgs, 2)
+	assert.Equal(t, int64(3), lgs[0].BlockNumber)
+	assert.Equal(t, int64(3), lgs[1].BlockNumber)
+
+	// the logs of block 3 are replaced by a reorg, so their cursor can't be followed anymore
+	cursor = logpoller.FormatContractReaderCursor(lgs[1])
+	require.NoError(t, th.ORM.DeleteLogsAndBlocksAfter(ctx, 3))
+	require.NoError(t, th.ORM.InsertLogs(ctx, []logpoller.Log{
+		GenLogWithData(th.ChainID, address, event2, 2, 3, logpoller.EvmWord(8).Bytes()),
+	}))
+
+	_, err = th.ORM.FilteredLogs(ctx, filter, query.NewLimitAndSort(query.CursorLimit(cursor, query.CursorFollowing, 3)), "")
+	require.ErrorIs(t, err, logpoller.ErrCursorReorged)
+
+	_, err = th.ORM.FilteredLogs(ctx, append(filter, query.Confidence(primitives.Finalized)), query.NewLimitAndSort(query.CursorLimit(cursor, query.CursorFollowing, 3)), "")
+	require.NoError(t, err)
+}
+
 func BenchmarkLogs(b *testing.B) {
 	th := SetupTH(b, lpOpts)
 	o := th.ORM
@@ -27,6 +27,7 @@ const (
 
 var (
 	ErrUnexpectedCursorFormat = errors.New("unexpected cursor format")
+	ErrCursorReorged          = errors.New("the log of the cursor was removed by a reorg, query again from an earlier cursor")
 	logsFields                = [...]string{"evm_chain_id", "log_index", "block_hash", "block_number",
 		"address", "event_sig", "topics", "tx_hash", "data", "created_at", "block_timestamp"}
 	blocksFields = [...]string{"evm_chain_id", "block_hash", "block_number", "block_timestamp",
@@ -38,6 +39,10 @@ var (
 type pgDSLParser struct {
 	args *queryArgs
 
+	// cursorCheck is the query checking that the log of the cursor is still saved, set when the query can return logs
+	// which are not finalized. Without it, the logs replacing the reorged logs before the cursor would be skipped.
+	cursorCheck string
+
 	// transient properties expected to be set and reset with every expression
 	expression string
 	err        error
@@ -156,42 +161,50 @@ func (v *pgDSLParser) nestedConfQuery(finalized bool, confs uint64) string {
 }
 
 func (v *pgDSLParser) VisitEventByWordFilter(p *eventByWordFilter) {
-	if len(p.HashedValueComparers) > 0 {
-		wordIdx := v.args.withIndexedField("word_index", p.WordIndex)
-
-		comps := make([]string, len(p.HashedValueComparers))
-		for idx, comp := range p.HashedValueComparers {
-			comps[idx], v.err = makeComp(comp, v.args, "word_value", wordIdx, "substring(data from 32*:%s+1 for 32) %s :%s")
-			if v.err != nil {
-				return
-			}
-		}
+	if len(p.HashedValueComparers) == 0 {
+		v.err = errors.New("data word filter requires at least one value comparator")
 
-		v.expression = strings.Join(comps, " AND ")
+		return
 	}
-}
 
-func (v *pgDSLParser) VisitEventTopicsByValueFilter(p *eventByTopicFilter) {
-	if len(p.ValueComparers) > 0 {
-		if !(p.Topic == 1 || p.Topic == 2 || p.Topic == 3) {
-			v.err = fmt.Errorf("invalid index for topic: %d", p.Topic)
+	wordIdx := v.args.withIndexedField("word_index", p.WordIndex)
 
+	comps := make([]string, len(p.HashedValueComparers))
+	for idx, comp := range p.HashedValueComparers {
+		comps[idx], v.err = makeComp(comp, v.args, "word_value", wordIdx, "substring(data from 32*:%s+1 for 32) %s :%s")
+		if v.err != nil {
 			return
 		}
+	}
 
-		// Add 1 since postgresql arrays are 1-indexed.
-		topicIdx := v.args.withIndexedField("topic_index", p.Topic+1)
+	v.expression = strings.Join(comps, " AND ")
+}
 
-		comps := make([]string, len(p.ValueComparers))
-		for idx, comp := range p.ValueComparers {
-			comps[idx], v.err = makeComp(comp, v.args, "topic_value", topicIdx, "topics[:%s] %s :%s")
-			if v.err != nil {
-				return
-			}
-		}
+func (v *pgDSLParser) VisitEventTopicsByValueFilter(p *eventByTopicFilter) {
+	if len(p.ValueComparers) == 0 {
+		v.err = errors.New("topic filter requires at least one value comparator")
+
+		return
+	}
+
+	if !(p.Topic == 1 || p.Topic == 2 || p.Topic == 3) {
+		v.err = fmt.Errorf("invalid index for topic: %d", p.Topic)
 
-		v.expression = strings.Join(comps, " AND ")
+		return
 	}
+
+	// Add 1 since postgresql arrays are 1-indexed.
+	topicIdx := v.args.withIndexedField("topic_index", p.Topic+1)
+
+	comps := make([]string, len(p.ValueComparers))
+	for idx, comp := range p.ValueComparers {
+		comps[idx], v.err = makeComp(comp, v.args, "topic_value", topicIdx, "topics[:%s] %s :%s")
+		if v.err != nil {
+			return
+		}
+	}
+
+	v.expression = strings.Join(comps, " AND ")
 }
 
 func (v *pgDSLParser) VisitConfirmationsFilter(p *confirmationsFilter) {
@@ -221,6 +234,7 @@ func makeComp(comp HashedValueComparator, args *queryArgs, field, subfield, patt
 func (v *pgDSLParser) buildQuery(chainID *big.Int, expressions []query.Expression, limiter query.LimitAndSort) (string, *queryArgs, error) {
 	// reset transient properties
 	v.args = newQueryArgs(chainID)
+	v.cursorCheck = ""
 	v.expression = ""
 	v.err = nil
 
@@ -254,16 +268,14 @@ func (v *pgDSLParser) buildQuery(chainID *big.Int, expressions []query.Expressio
 func (v *pgDSLParser) whereClause(expressions []query.Expression, limiter query.LimitAndSort) (string, error) {
 	segment := "WHERE evm_chain_id = :evm_chain_id"
 
+	var hasFinalized bool
 	if len(expressions) > 0 {
-		exp, hasFinalized, err := v.combineExpressions(expressions, query.AND)
+		exp, fin, err := v.combineExpressions(expressions, query.AND)
 		if err != nil {
 			return "", err
 		}
 
-		if limiter.HasCursorLimit() && !hasFinalized {
-			return "", errors.New("cursor-base queries limited to only finalized blocks")
-		}
-
+		hasFinalized = fin
 		segment = fmt.Sprintf("%s AND %s", segment, exp)
 	}
 
@@ -278,7 +290,7 @@ func (v *pgDSLParser) whereClause(expressions []query.Expression, limiter query.
 			return "", errors.New("invalid cursor direction")
 		}
 
-		block, logIdx, _, err := valuesFromCursor(limiter.Limit.Cursor)
+		block, logIdx, txHash, err := valuesFromCursor(limiter.Limit.Cursor)
 		if err != nil {
 			return "", err
 		}
@@ -287,6 +299,14 @@ func (v *pgDSLParser) whereClause(expressions []query.Expression, limiter query.
 
 		v.args.withField("cursor_block_number", block).
 			withField("cursor_log_index", logIdx)
+
+		// Finalized logs can't be reorged, so the cursor can only be invalidated if the query returns unfinalized logs.
+		if !hasFinalized {
+			v.cursorCheck = "SELECT EXISTS (SELECT 1 FROM evm.logs WHERE evm_chain_id = :evm_chain_id " +
+				"AND block_number = :cursor_block_number AND log_index = :cursor_log_index AND tx_hash = :cursor_tx_hash)"
+
+			v.args.withField("cursor_tx_hash", txHash)
+		}
 	}
 
 	return segment, nil
@@ -368,14 +388,16 @@ func (v *pgDSLParser) combineExpressions(expressions []query.Expression, op quer
 	var isFinalized bool
 
 	for idx, exp := range expressions {
+		var fin bool
+
 		if exp.IsPrimitive() {
 			exp.Primitive.Accept(v)
 
 			switch prim := exp.Primitive.(type) {
 			case *primitives.Confidence:
-				isFinalized = prim.ConfidenceLevel == primitives.Finalized
+				fin = prim.ConfidenceLevel == primitives.Finalized
 			case *confirmationsFilter:
-				isFinalized = prim.Confirmations == evmtypes.Finalized
+				fin = prim.Confirmations == evmtypes.Finalized
 			}
 
 			clause, err := v.getLastExpression()
@@ -385,17 +407,25 @@ func (v *pgDSLParser) combineExpressions(expressions []query.Expression, op quer
 
 			clauses[idx] = clause
 		} else {
-			clause, fin, err := v.combineExpressions(exp.BoolExpression.Expressions, exp.BoolExpression.BoolOperator)
+			clause, nestedFin, err := v.combineExpressions(exp.BoolExpression.Expressions, exp.BoolExpression.BoolOperator)
 			if err != nil {
 				return "", isFinalized, err
 			}
 
-			if fin {
-				isFinalized = fin
-			}
-
+			fin = nestedFin
 			clauses[idx] = clause
 		}
+
+		// Only finalized logs match a conjunction if they match any of its expressions, but a disjunction only if they
+		// match all of them.
+		switch {
+		case idx == 0:
+			isFinalized = fin
+		case op == query.AND:
+			isFinalized = isFinalized || fin
+		default:
+			isFinalized = isFinalized && fin
+		}
 	}
 
 	output := strings.Join(clauses, fmt.Sprintf(" %s ", op.String()))
@@ -356,4 +356,165 @@ func TestDSLParser(t *testing.T) {
 
 		assertArgs(t, args, 7)
 	})
+
+	// compound query -> (sigA & topic range & finalized) || (sigB & word range & finalized)
+	t.Run("query across event signatures with cursor", func(t *testing.T) {
+		t.Parallel()
+
+		topicFilter := NewEventByTopicFilter(3, []HashedValueComparator{
+			{Value: common.HexToHash("a"), Operator: primitives.Gte},
+			{Value: common.HexToHash("b"), Operator: primitives.Lte},
+		})
+		wordFilter := NewEventByWordFilter(1, []HashedValueComparator{
+			{Value: common.HexToHash("c"), Operator: primitives.Gt},
+		})
+
+		parser := &pgDSLParser{}
+		chainID := big.NewInt(1)
+
+		expressions := []query.Expression{
+			query.Or(
+				query.And(NewEventSigFilter(common.HexToHash("0x21")), topicFilter, query.Confidence(primitives.Finalized)),
+				query.And(NewEventSigFilter(common.HexToHash("0x22")), wordFilter, query.Confidence(primitives.Finalized)),
+			),
+		}
+		limiter := query.NewLimitAndSort(query.CursorLimit("10-5-0x42", query.CursorFollowing, 20))
+
+		result, args, err := parser.buildQuery(chainID, expressions, limiter)
+		finalized := "block_number <= (SELECT finalized_block_number FROM evm.log_poller_blocks WHERE evm_chain_id = :evm_chain_id ORDER BY block_number DESC LIMIT 1)"
+		expected := logsQuery(
+			" WHERE evm_chain_id = :evm_chain_id " +
+				"AND ((event_sig = :event_sig_0 " +
+				"AND topics[:topic_index_0] >= :topic_value_0 AND topics[:topic_index_0] <= :topic_value_1 " +
+				"AND " + finalized + ") " +
+				"OR (event_sig = :event_sig_1 " +
+				"AND substring(data from 32*:word_index_0+1 for 32) > :word_value_0 " +
+				"AND " + finalized + ")) " +
+				"AND (block_number > :cursor_block_number OR (block_number = :cursor_block_number AND log_index > :cursor_log_index)) " +
+				"ORDER BY block_number ASC, log_index ASC, tx_hash ASC " +
+				"LIMIT 20")
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		assert.Empty(t, parser.cursorCheck)
+
+		assertArgs(t, args, 10)
+	})
+
+	t.Run("query with cursor for unfinalized logs", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			name        string
+			expressions []query.Expression
+			finalized   bool
+		}{
+			{name: "no expressions"},
+			{name: "unconfirmed", expressions: []query.Expression{query.Confidence(primitives.Unconfirmed)}},
+			{name: "finalized and unconfirmed", expressions: []query.Expression{
+				query.Confidence(primitives.Unconfirmed),
+				query.Confidence(primitives.Finalized),
+			}, finalized: true},
+			{name: "finalized or unconfirmed", expressions: []query.Expression{query.Or(
+				query.And(NewEventSigFilter(common.HexToHash("0x21")), query.Confidence(primitives.Finalized)),
+				NewEventSigFilter(common.HexToHash("0x22")),
+			)}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				txHash := common.HexToHash("0x42")
+				cursor := FormatContractReaderCursor(Log{BlockNumber: 10, LogIndex: 5, TxHash: txHash})
+
+				parser := &pgDSLParser{}
+				limiter := query.NewLimitAndSort(query.CursorLimit(cursor, query.CursorFollowing, 20))
+
+				_, args, err := parser.buildQuery(big.NewInt(1), tt.expressions, limiter)
+				require.NoError(t, err)
+
+				values, err := args.toArgs()
+				require.NoError(t, err)
+
+				if tt.finalized {
+					assert.Empty(t, parser.cursorCheck)
+					assert.NotContains(t, values, "cursor_tx_hash")
+
+					return
+				}
+
+				assert.Equal(t, "SELECT EXISTS (SELECT 1 FROM evm.logs WHERE evm_chain_id = :evm_chain_id "+
+					"AND block_number = :cursor_block_number AND log_index = :cursor_log_index AND tx_hash = :cursor_tx_hash)", parser.cursorCheck)
+				assert.Equal(t, txHash.Bytes(), values["cursor_tx_hash"])
+			})
+		}
+	})
+
+	t.Run("query for many topic values", func(t *testing.T) {
+		t.Parallel()
+
+		expressions := make([]query.Expression, 300)
+		for i := range expressions {
+			expressions[i] = NewEventByTopicFilter(1, []HashedValueComparator{
+				{Value: common.BigToHash(big.NewInt(int64(i))), Operator: primitives.Eq},
+			})
+		}
+
+		parser := &pgDSLParser{}
+		result, args, err := parser.buildQuery(big.NewInt(1), []query.Expression{query.Or(expressions...)}, query.LimitAndSort{})
+
+		require.NoError(t, err)
+		assert.Contains(t, result, "topics[:topic_index_299] = :topic_value_299")
+
+		// chain id, a topic index for every filter and a value for every comparator
+		assertArgs(t, args, 601)
+	})
+
+	t.Run("query for filters without comparators", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := (&pgDSLParser{}).buildQuery(big.NewInt(1), []query.Expression{NewEventByWordFilter(1, nil)}, query.LimitAndSort{})
+		require.ErrorContains(t, err, "data word filter requires at least one value comparator")
+
+		_, _, err = (&pgDSLParser{}).buildQuery(big.NewInt(1), []query.Expression{NewEventByTopicFilter(1, nil)}, query.LimitAndSort{})
+		require.ErrorContains(t, err, "topic filter requires at least one value comparator")
+	})
+}
+
+func TestExpressionsMatcher(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0x1")
+	eventSig := common.HexToHash("0x2")
+	topic := common.HexToHash("0x3")
+	log := Log{
+		BlockNumber: 10,
+		Address:     address,
+		EventSig:    eventSig,
+		Topics:      [][]byte{eventSig.Bytes(), topic.Bytes()},
+		Data:        common.HexToHash("0x4").Bytes(),
+	}
+
+	tests := []struct {
+		name        string
+		expressions []query.Expression
+		matches     bool
+	}{
+		{"address and event sig", []query.Expression{NewAddressFilter(address), NewEventSigFilter(eventSig)}, true},
+		{"other address", []query.Expression{NewAddressFilter(common.HexToAddress("0x5"))}, false},
+		{"block range", []query.Expression{query.Block("10", primitives.Gte), query.Block("11", primitives.Lt)}, true},
+		{"after block", []query.Expression{query.Block("10", primitives.Gt)}, false},
+		{"topic", []query.Expression{NewEventByTopicFilter(1, []HashedValueComparator{{Value: topic, Operator: primitives.Eq}})}, true},
+		{"missing topic", []query.Expression{NewEventByTopicFilter(2, []HashedValueComparator{{Value: topic, Operator: primitives.Eq}})}, false},
+		{"word", []query.Expression{NewEventByWordFilter(0, []HashedValueComparator{{Value: common.HexToHash("0x3"), Operator: primitives.Gt}})}, true},
+		{"or", []query.Expression{query.Or(NewAddressFilter(common.HexToAddress("0x5")), query.Block("10", primitives.Eq))}, true},
+		{"unconfirmed", []query.Expression{query.Confidence(primitives.Unconfirmed)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := newExpressionsMatcher(tt.expressions, query.AND)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, match(log))
+		})
+	}
+
+	_, err := newExpressionsMatcher([]query.Expression{NewConfirmationsFilter(types.Finalized)}, query.AND)
+	require.ErrorContains(t, err, "not supported by log subscriptions")
 }
@@ -29,7 +29,7 @@ func concatBytes[T bytesProducer](byteSlice []T) [][]byte {
 // Besides the convenience methods, it also keeps track of arguments validation and sanitization.
 type queryArgs struct {
 	args      map[string]any
-	idxLookup map[string]uint8
+	idxLookup map[string]int
 	err       []error
 }
 
@@ -38,7 +38,7 @@ func newQueryArgs(chainId *big.Int) *queryArgs {
 		args: map[string]any{
 			"evm_chain_id": ubig.New(chainId),
 		},
-		idxLookup: make(map[string]uint8),
+		idxLookup: make(map[string]int),
 		err:       []error{},
 	}
 }
@@ -66,7 +66,7 @@ func (q *queryArgs) withIndexableField(fieldName string, value any, addIndex boo
 		idx := q.nextIdx(fieldName)
 		idxName := fmt.Sprintf("%s_%d", fieldName, idx)
 
-		q.idxLookup[fieldName] = uint8(idx)
+		q.idxLookup[fieldName] = idx
 		fieldName = idxName
 	}
 
@@ -92,7 +92,7 @@ func (q *queryArgs) nextIdx(baseFieldName string) int {
 		return 0
 	}
 
-	return int(idx) + 1
+	return idx + 1
 }
 
 func (q *queryArgs) withEventSig(eventSig common.Hash) *queryArgs {
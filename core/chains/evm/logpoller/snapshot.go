@@ -0,0 +1,236 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// snapshotVersion is the version of the snapshot format written by ExportLogs.
+const snapshotVersion = 1
+
+// A snapshot is a JSON Lines file: a snapshotHeader followed by a snapshotBlock for every block with logs in the range,
+// in ascending order. The last block of the range is always included, so the importing node knows where to resume polling.
+type snapshotHeader struct {
+	Version    int       `json:"version"`
+	EvmChainID *ubig.Big `json:"evmChainId"`
+	FromBlock  int64     `json:"fromBlock"`
+	ToBlock    int64     `json:"toBlock"`
+}
+
+type snapshotBlock struct {
+	Number    int64         `json:"number"`
+	Hash      common.Hash   `json:"hash"`
+	Timestamp time.Time     `json:"timestamp"`
+	Logs      []snapshotLog `json:"logs,omitempty"`
+}
+
+type snapshotLog struct {
+	LogIndex int64          `json:"logIndex"`
+	Address  common.Address `json:"address"`
+	Topics   []common.Hash  `json:"topics"`
+	TxHash   common.Hash    `json:"txHash"`
+	Data     hexutil.Bytes  `json:"data"`
+}
+
+// ExportLogs writes a snapshot of the finalized logs in [fromBlock, toBlock] to w, which can be imported by another node
+// with ImportLogs.
+func (lp *logPoller) ExportLogs(ctx context.Context, w io.Writer, fromBlock, toBlock int64) error {
+	if fromBlock < 1 || fromBlock > toBlock {
+		return fmt.Errorf("invalid block range [%d, %d]", fromBlock, toBlock)
+	}
+	finalized, err := lp.savedFinalizedBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if toBlock > finalized {
+		return fmt.Errorf("only finalized logs can be exported, but block %d is after the latest finalized block %d", toBlock, finalized)
+	}
+	end, err := lp.GetBlocksRange(ctx, []uint64{uint64(toBlock)})
+	if err != nil {
+		return fmt.Errorf("failed to get block %d: %w", toBlock, err)
+	}
+
+	enc := json.NewEncoder(w)
+	if err = enc.Encode(snapshotHeader{Version: snapshotVersion, EvmChainID: ubig.New(lp.ec.ConfiguredChainID()), FromBlock: fromBlock, ToBlock: toBlock}); err != nil {
+		return err
+	}
+	var exported int
+	batchSize := mathutil.Max(lp.backfillBatchSize, 1)
+	for from := fromBlock; from <= toBlock; from += batchSize {
+		to := mathutil.Min(from+batchSize-1, toBlock)
+		logs, err := lp.orm.SelectLogsByBlockRange(ctx, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to select logs in [%d, %d]: %w", from, to, err)
+		}
+		blocks := snapshotBlocksFromLogs(logs)
+		if to == toBlock && (len(blocks) == 0 || blocks[len(blocks)-1].Number != toBlock) {
+			blocks = append(blocks, snapshotBlock{Number: toBlock, Hash: end[0].BlockHash, Timestamp: end[0].BlockTimestamp})
+		}
+		for _, b := range blocks {
+			if err = enc.Encode(b); err != nil {
+				return err
+			}
+		}
+		exported += len(logs)
+	}
+	lp.lggr.Infow("Exported logs", "fromBlock", fromBlock, "toBlock", toBlock, "logs", exported)
+	return nil
+}
+
+// snapshotBlocksFromLogs groups the logs, which are ordered by block number and log index, by block.
+func snapshotBlocksFromLogs(logs []Log) []snapshotBlock {
+	var blocks []snapshotBlock
+	for _, l := range logs {
+		if len(blocks) == 0 || blocks[len(blocks)-1].Number != l.BlockNumber {
+			blocks = append(blocks, snapshotBlock{Number: l.BlockNumber, Hash: l.BlockHash, Timestamp: l.BlockTimestamp})
+		}
+		b := &blocks[len(blocks)-1]
+		b.Logs = append(b.Logs, snapshotLog{LogIndex: l.LogIndex, Address: l.Address, Topics: l.GetTopics(), TxHash: l.TxHash, Data: l.Data})
+	}
+	return blocks
+}
+
+// ImportLogs imports the logs of a snapshot written by ExportLogs which match the registered filters, and returns the
+// number of imported logs. The blocks of the snapshot are validated against the finalized blocks of the chain before
+// their logs are saved. If no blocks were saved yet, polling resumes after the last block of the snapshot.
+func (lp *logPoller) ImportLogs(ctx context.Context, r io.Reader) (int, error) {
+	dec := json.NewDecoder(r)
+	var header snapshotHeader
+	if err := dec.Decode(&header); err != nil {
+		return 0, fmt.Errorf("failed to decode snapshot header: %w", err)
+	}
+	if header.Version != snapshotVersion {
+		return 0, fmt.Errorf("unsupported snapshot version %d", header.Version)
+	}
+	chainID := lp.ec.ConfiguredChainID()
+	if header.EvmChainID == nil || header.EvmChainID.Cmp(ubig.New(chainID)) != 0 {
+		return 0, fmt.Errorf("snapshot is for chain %s, but the log poller is for chain %s", header.EvmChainID, chainID)
+	}
+
+	// Filters are loaded from the db, as the log poller may not be running when importing.
+	filters, err := lp.orm.LoadFilters(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load filters: %w", err)
+	}
+	addresses := make(map[common.Address]struct{})
+	eventSigs := make(map[common.Hash]struct{})
+	for _, filter := range filters {
+		for _, addr := range filter.Addresses {
+			addresses[addr] = struct{}{}
+		}
+		for _, eventSig := range filter.EventSigs {
+			eventSigs[eventSig] = struct{}{}
+		}
+	}
+
+	var imported int
+	var last *snapshotBlock
+	var batch []snapshotBlock
+	importBatch := func() error {
+		logs, err2 := lp.validateSnapshotBlocks(ctx, batch, header, addresses, eventSigs)
+		if err2 != nil {
+			return err2
+		}
+		if len(logs) > 0 {
+			if err2 = lp.orm.InsertLogs(ctx, logs); err2 != nil {
+				return fmt.Errorf("failed to insert logs: %w", err2)
+			}
+			lp.notifyLogs(logs)
+		}
+		imported += len(logs)
+		last = &batch[len(batch)-1]
+		batch = nil
+		return nil
+	}
+	for {
+		var block snapshotBlock
+		if err = dec.Decode(&block); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return imported, fmt.Errorf("failed to decode snapshot block: %w", err)
+		}
+		if (last != nil && block.Number <= last.Number) || (len(batch) > 0 && block.Number <= batch[len(batch)-1].Number) {
+			return imported, fmt.Errorf("snapshot blocks are not in ascending order at block %d", block.Number)
+		}
+		batch = append(batch, block)
+		if int64(len(batch)) >= mathutil.Max(lp.rpcBatchSize, 1) {
+			if err = importBatch(); err != nil {
+				return imported, err
+			}
+		}
+	}
+	if len(batch) > 0 {
+		if err = importBatch(); err != nil {
+			return imported, err
+		}
+	}
+	if last == nil || last.Number != header.ToBlock {
+		return imported, fmt.Errorf("snapshot is truncated, it does not end with block %d", header.ToBlock)
+	}
+
+	if _, err = lp.orm.SelectLatestBlock(ctx); errors.Is(err, sql.ErrNoRows) {
+		if err = lp.orm.InsertBlock(ctx, last.Hash, last.Number, last.Timestamp, last.Number); err != nil {
+			return imported, fmt.Errorf("failed to insert block %d: %w", last.Number, err)
+		}
+	} else if err != nil {
+		return imported, err
+	}
+	lp.lggr.Infow("Imported logs", "fromBlock", header.FromBlock, "toBlock", header.ToBlock, "logs", imported)
+	return imported, nil
+}
+
+// validateSnapshotBlocks checks the blocks against the finalized blocks of the chain, and returns their logs matching the filters.
+func (lp *logPoller) validateSnapshotBlocks(ctx context.Context, blocks []snapshotBlock, header snapshotHeader, addresses map[common.Address]struct{}, eventSigs map[common.Hash]struct{}) ([]Log, error) {
+	numbers := make([]uint64, len(blocks))
+	for i, b := range blocks {
+		if b.Number < header.FromBlock || b.Number > header.ToBlock {
+			return nil, fmt.Errorf("snapshot block %d is outside of its range [%d, %d]", b.Number, header.FromBlock, header.ToBlock)
+		}
+		numbers[i] = uint64(b.Number)
+	}
+	canonical, err := lp.GetBlocksRange(ctx, numbers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finalized blocks: %w", err)
+	}
+	var logs []Log
+	for i, b := range blocks {
+		if b.Hash != canonical[i].BlockHash {
+			return nil, fmt.Errorf("snapshot block %d has hash %s, but the chain has %s", b.Number, b.Hash, canonical[i].BlockHash)
+		}
+		for _, l := range b.Logs {
+			if len(l.Topics) == 0 {
+				return nil, fmt.Errorf("log %d of snapshot block %d has no topics", l.LogIndex, b.Number)
+			}
+			_, addressOk := addresses[l.Address]
+			_, eventSigOk := eventSigs[l.Topics[0]]
+			if !addressOk || !eventSigOk {
+				continue
+			}
+			logs = append(logs, Log{
+				EvmChainId:     header.EvmChainID,
+				LogIndex:       l.LogIndex,
+				BlockHash:      b.Hash,
+				BlockNumber:    b.Number,
+				BlockTimestamp: b.Timestamp,
+				EventSig:       l.Topics[0],
+				Topics:         convertTopics(l.Topics),
+				Address:        l.Address,
+				TxHash:         l.TxHash,
+				Data:           l.Data,
+			})
+		}
+	}
+	return logs, nil
+}
@@ -0,0 +1,126 @@
+package logpoller_test
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestLogPoller_ExportImportLogs(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	lpOpts := logpoller.Opts{
+		UseFinalityTag:           false,
+		FinalityDepth:            2,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	}
+	th := SetupTH(t, lpOpts)
+
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      "export",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1, th.EmitterAddress2},
+	}))
+	for i := 0; i < 8; i++ {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		_, err = th.Emitter2.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+	th.Client.Commit()
+	th.PollAndSaveLogs(ctx, 1)
+
+	latest, err := th.LogPoller.LatestBlock(ctx)
+	require.NoError(t, err)
+	finalized := latest.FinalizedBlockNumber
+	require.Greater(t, finalized, int64(4))
+
+	t.Run("only finalized blocks can be exported", func(t *testing.T) {
+		err := th.LogPoller.ExportLogs(ctx, &bytes.Buffer{}, 1, latest.BlockNumber)
+		require.ErrorContains(t, err, "only finalized logs can be exported")
+	})
+
+	var snapshot bytes.Buffer
+	require.NoError(t, th.LogPoller.ExportLogs(ctx, &snapshot, 2, finalized))
+	exported, err := th.ORM.SelectLogsByBlockRange(ctx, 2, finalized)
+	require.NoError(t, err)
+	require.NotEmpty(t, exported)
+
+	newImporter := func(t *testing.T) logpoller.LogPoller {
+		orm := logpoller.NewORM(th.ChainID, pgtest.NewSqlxDB(t), th.Lggr)
+		ec := client.NewSimulatedBackendClient(t, th.Client, th.ChainID)
+		ht := headtracker.NewSimulatedHeadTracker(ec, lpOpts.UseFinalityTag, lpOpts.FinalityDepth)
+		lp := logpoller.NewLogPoller(orm, ec, th.Lggr, ht, lpOpts)
+		require.NoError(t, lp.RegisterFilter(ctx, logpoller.Filter{
+			Name:      "import",
+			EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+			Addresses: []common.Address{th.EmitterAddress1},
+		}))
+		return lp
+	}
+
+	t.Run("imports the logs matching the filters", func(t *testing.T) {
+		lp := newImporter(t)
+		imported, err := lp.ImportLogs(ctx, bytes.NewReader(snapshot.Bytes()))
+		require.NoError(t, err)
+
+		var expected []logpoller.Log
+		for _, l := range exported {
+			if l.Address == th.EmitterAddress1 {
+				expected = append(expected, l)
+			}
+		}
+		assert.Equal(t, len(expected), imported)
+		logs, err := lp.LogsWithSigs(ctx, 0, finalized, []common.Hash{EmitterABI.Events["Log1"].ID}, th.EmitterAddress1)
+		require.NoError(t, err)
+		require.Len(t, logs, len(expected))
+		for i := range logs {
+			assert.Equal(t, expected[i].BlockHash, logs[i].BlockHash)
+			assert.Equal(t, expected[i].LogIndex, logs[i].LogIndex)
+			assert.Equal(t, expected[i].Data, logs[i].Data)
+		}
+
+		// Polling resumes after the last block of the snapshot.
+		block, err := lp.LatestBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, finalized, block.BlockNumber)
+	})
+
+	t.Run("rejects blocks not on the chain", func(t *testing.T) {
+		blockHash := exported[0].BlockHash.Hex()
+		tampered := strings.Replace(snapshot.String(), blockHash, common.HexToHash("0x1234").Hex(), 1)
+		_, err := newImporter(t).ImportLogs(ctx, strings.NewReader(tampered))
+		require.ErrorContains(t, err, "but the chain has "+blockHash)
+	})
+
+	t.Run("rejects truncated snapshots", func(t *testing.T) {
+		lines := strings.SplitAfter(strings.TrimSpace(snapshot.String()), "\n")
+		truncated := strings.Join(lines[:len(lines)-1], "")
+		_, err := newImporter(t).ImportLogs(ctx, strings.NewReader(truncated))
+		require.ErrorContains(t, err, "snapshot is truncated")
+	})
+
+	t.Run("rejects snapshots of another chain", func(t *testing.T) {
+		orm := logpoller.NewORM(th.ChainID2, pgtest.NewSqlxDB(t), th.Lggr)
+		ec := client.NewSimulatedBackendClient(t, th.Client, th.ChainID2)
+		ht := headtracker.NewSimulatedHeadTracker(ec, lpOpts.UseFinalityTag, lpOpts.FinalityDepth)
+		lp := logpoller.NewLogPoller(orm, ec, th.Lggr, ht, lpOpts)
+		_, err := lp.ImportLogs(ctx, bytes.NewReader(snapshot.Bytes()))
+		require.ErrorContains(t, err, "snapshot is for chain "+th.ChainID.String())
+	})
+}
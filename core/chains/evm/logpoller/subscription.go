@@ -0,0 +1,210 @@
+package logpoller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// subscriptionBufferSize is the number of subscribed logs buffered while the previous ones are being saved.
+const subscriptionBufferSize = 100
+
+// subscriptionRun receives the logs of the registered filters over a subscription, and saves them as soon as they are
+// produced instead of on the next poll. The poll loop still processes every block: subscribed logs are only saved
+// after the latest block saved by the poll loop, and the poll loop replaces the subscribed logs of reorged blocks with
+// the logs of the canonical blocks when it reaches them.
+func (lp *logPoller) subscriptionRun() {
+	defer lp.wg.Done()
+	ctx, cancel := lp.stopCh.NewCtx()
+	defer cancel()
+
+	for {
+		err := lp.subscribeAndSaveLogs(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			// The poll loop keeps saving the logs in the meantime.
+			lp.lggr.Warnw("Log subscription failed, resubscribing after the poll period", "err", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(lp.pollPeriod):
+			}
+		}
+	}
+}
+
+// subscribeAndSaveLogs subscribes to the logs of the registered filters, and saves them until the subscription fails or
+// the filters change.
+func (lp *logPoller) subscribeAndSaveLogs(ctx context.Context) error {
+	q := lp.Filter(nil, nil, nil)
+	if len(q.Addresses) == 0 {
+		// Without any filters the subscription would receive every log of the chain.
+		select {
+		case <-ctx.Done():
+		case <-lp.filtersChanged:
+		}
+		return nil
+	}
+
+	ch := make(chan types.Log, subscriptionBufferSize)
+	sub, err := lp.ec.SubscribeFilterLogs(ctx, q, ch)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to logs: %w", err)
+	}
+	defer sub.Unsubscribe()
+	lp.lggr.Debugw("Subscribed to logs", "addresses", q.Addresses, "eventSigs", q.Topics[0])
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-lp.filtersChanged:
+			return nil
+		case err = <-sub.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return err
+		case log := <-ch:
+			logs := []types.Log{log}
+			for drained := false; !drained; {
+				select {
+				case log = <-ch:
+					logs = append(logs, log)
+				default:
+					drained = true
+				}
+			}
+			if err = lp.saveSubscribedLogs(ctx, logs); err != nil {
+				lp.lggr.Warnw("Unable to save subscribed logs, they will be saved by the poll loop", "err", err, "logs", len(logs))
+			}
+		}
+	}
+}
+
+// saveSubscribedLogs saves the logs received over the subscription which are after the latest block saved by the poll loop.
+func (lp *logPoller) saveSubscribedLogs(ctx context.Context, logs []types.Log) error {
+	var blocks []LogPollerBlock
+	var blockLogs []types.Log
+	for _, log := range logs {
+		// Removed logs are already gone from the chain, the poll loop deletes them when it reaches their reorged block.
+		if log.Removed || len(log.Topics) == 0 {
+			continue
+		}
+		if len(blocks) == 0 || blocks[len(blocks)-1].BlockHash != log.BlockHash {
+			// Subscribed logs don't have the timestamp of their block.
+			head, err := lp.ec.HeadByHash(ctx, log.BlockHash)
+			if err != nil {
+				return fmt.Errorf("failed to get block %s: %w", log.BlockHash, err)
+			}
+			blocks = append(blocks, NewLogPollerBlock(log.BlockHash, int64(log.BlockNumber), head.Timestamp, 0))
+		} else {
+			blocks = append(blocks, blocks[len(blocks)-1])
+		}
+		blockLogs = append(blockLogs, log)
+	}
+	if len(blockLogs) == 0 {
+		return nil
+	}
+
+	lp.subscriptionMu.Lock()
+	defer lp.subscriptionMu.Unlock()
+	var saved []Log
+	for _, log := range convertLogs(blockLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()) {
+		if log.BlockNumber > lp.subscriptionWatermark {
+			saved = append(saved, log)
+		}
+	}
+	if len(saved) == 0 {
+		return nil
+	}
+	if err := lp.orm.InsertLogs(ctx, saved); err != nil {
+		return err
+	}
+	lp.notifyLogs(saved)
+	lp.lggr.Debugw("Saved subscribed logs", "logs", len(saved), "block", saved[len(saved)-1].BlockNumber)
+	return nil
+}
+
+// insertLogsWithBlock saves the logs of a polled block. With the log subscription, the subscribed logs of reorged blocks
+// at the same height are deleted first, as they would prevent the logs of the canonical block from being saved.
+func (lp *logPoller) insertLogsWithBlock(ctx context.Context, logs []Log, block LogPollerBlock) error {
+	if !lp.subscribeLogs {
+		if err := lp.orm.InsertLogsWithBlock(ctx, logs, block); err != nil {
+			return err
+		}
+		lp.notifyLogs(logs)
+		return nil
+	}
+
+	lp.subscriptionMu.Lock()
+	defer lp.subscriptionMu.Unlock()
+	deleted, err := lp.orm.DeleteLogsNotInBlocks(ctx, block.BlockNumber, block.BlockNumber, []common.Hash{block.BlockHash})
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		lp.notifyReorg(block.BlockNumber)
+	}
+	if err = lp.orm.InsertLogsWithBlock(ctx, logs, block); err != nil {
+		return err
+	}
+	lp.notifyLogs(logs)
+	lp.subscriptionWatermark = block.BlockNumber
+	return nil
+}
+
+// reconcileSubscribedLogs deletes the subscribed logs of reorged blocks in the finalized [start, end] range, before the
+// poll loop backfills it.
+func (lp *logPoller) reconcileSubscribedLogs(ctx context.Context, start, end int64) error {
+	if !lp.subscribeLogs {
+		return nil
+	}
+
+	lp.subscriptionMu.Lock()
+	defer lp.subscriptionMu.Unlock()
+	logs, err := lp.orm.SelectLogsByBlockRange(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	var numbers []uint64
+	for _, log := range logs {
+		if len(numbers) == 0 || numbers[len(numbers)-1] != uint64(log.BlockNumber) {
+			numbers = append(numbers, uint64(log.BlockNumber))
+		}
+	}
+	if len(numbers) > 0 {
+		blocks, err := lp.GetBlocksRange(ctx, numbers)
+		if err != nil {
+			return err
+		}
+		hashes := make([]common.Hash, len(blocks))
+		for i, block := range blocks {
+			hashes[i] = block.BlockHash
+		}
+		deleted, err := lp.orm.DeleteLogsNotInBlocks(ctx, start, end, hashes)
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			lp.lggr.Infow("Deleted subscribed logs of reorged blocks", "start", start, "end", end, "logs", deleted)
+			lp.notifyReorg(start)
+		}
+	}
+	lp.subscriptionWatermark = end
+	return nil
+}
+
+// notifyFiltersChanged makes the log subscription resubscribe with the current filters. Must be called with filterMu held.
+func (lp *logPoller) notifyFiltersChanged() {
+	select {
+	case lp.filtersChanged <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,127 @@
+package logpoller_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestLogPoller_SubscribeLogs(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	th := SetupTH(t, logpoller.Opts{
+		UseFinalityTag:           false,
+		FinalityDepth:            2,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+		SubscribeLogs:            true,
+	})
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      "subscription",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}))
+	require.NoError(t, th.LogPoller.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, th.LogPoller.Close()) })
+
+	// The poll period is an hour, so the first poll on start is the only one.
+	testutils.AssertEventually(t, func() bool {
+		_, err := th.LogPoller.LatestBlock(ctx)
+		return err == nil
+	})
+
+	// Logs after the latest polled block can only have been saved from the subscription.
+	var i int64
+	testutils.AssertEventually(t, func() bool {
+		i++
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(i)})
+		require.NoError(t, err)
+		th.Client.Commit()
+		time.Sleep(100 * time.Millisecond)
+
+		latest, err := th.LogPoller.LatestBlock(ctx)
+		require.NoError(t, err)
+		logs, err := th.LogPoller.Logs(ctx, latest.BlockNumber+1, latest.BlockNumber+i, EmitterABI.Events["Log1"].ID, th.EmitterAddress1)
+		require.NoError(t, err)
+		return len(logs) > 0
+	})
+}
+
+func TestLogPoller_SubscribedLogsOfReorgedBlocks(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	th := SetupTH(t, logpoller.Opts{
+		UseFinalityTag:           false,
+		FinalityDepth:            2,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+		SubscribeLogs:            true,
+	})
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      "subscription",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}))
+	next := th.PollAndSaveLogs(ctx, 1)
+
+	// saveReorgedLog saves a log of a reorged block at the height of the canonical log, as if it was received over the
+	// subscription before the reorg. It conflicts with the canonical log, which has the same log index.
+	saveReorgedLog := func(t *testing.T, canonical common.Hash) {
+		b, err := th.Client.BlockByHash(ctx, canonical)
+		require.NoError(t, err)
+		require.NoError(t, th.ORM.InsertLogs(ctx, []logpoller.Log{{
+			EvmChainId:     ubig.New(th.ChainID),
+			LogIndex:       0,
+			BlockHash:      common.HexToHash("0x1234"),
+			BlockNumber:    b.Number().Int64(),
+			BlockTimestamp: time.Now(),
+			EventSig:       EmitterABI.Events["Log1"].ID,
+			Topics:         [][]byte{EmitterABI.Events["Log1"].ID.Bytes()},
+			Address:        th.EmitterAddress1,
+			TxHash:         common.HexToHash("0x5678"),
+		}}))
+	}
+	assertCanonicalLog := func(t *testing.T, block common.Hash) {
+		b, err := th.Client.BlockByHash(ctx, block)
+		require.NoError(t, err)
+		logs, err := th.ORM.SelectLogsByBlockRange(ctx, b.Number().Int64(), b.Number().Int64())
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, block, logs[0].BlockHash)
+	}
+
+	t.Run("polled block", func(t *testing.T) {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+		require.NoError(t, err)
+		canonical := th.Client.Commit()
+		saveReorgedLog(t, canonical)
+
+		next = th.PollAndSaveLogs(ctx, next)
+		assertCanonicalLog(t, canonical)
+	})
+
+	t.Run("backfilled blocks", func(t *testing.T) {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+		require.NoError(t, err)
+		canonical := th.Client.Commit()
+		saveReorgedLog(t, canonical)
+		for i := 0; i < 5; i++ {
+			th.Client.Commit()
+		}
+
+		th.PollAndSaveLogs(ctx, next)
+		assertCanonicalLog(t, canonical)
+	})
+}
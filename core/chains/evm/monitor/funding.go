@@ -0,0 +1,287 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+const (
+	// FundingKindTopUp is the TxMeta.FundingKind of the transfers from the treasury key to a key below its minimum balance
+	FundingKindTopUp = "TopUp"
+	// FundingKindSweep is the TxMeta.FundingKind of the transfers of the excess balance of a key back to the treasury key
+	FundingKindSweep = "Sweep"
+)
+
+// fundingTxStates are the states of the funding transfers which are not settled yet, so the balances of their keys
+// don't reflect them.
+var fundingTxStates = []txmgrtypes.TxState{
+	txmgrcommon.TxUnstarted,
+	txmgrcommon.TxInProgress,
+	txmgrcommon.TxUnconfirmed,
+	txmgrcommon.TxConfirmedMissingReceipt,
+}
+
+type (
+	// FundingManager tops up the keys of a chain from its treasury key on every new head if their balance is below
+	// their minimum, and optionally sweeps their balance above the sweep threshold back to the treasury key.
+	FundingManager interface {
+		httypes.HeadTrackable
+		services.Service
+	}
+
+	fundingManager struct {
+		services.Service
+		eng *services.Engine
+
+		cfg         evmconfig.Funding
+		gasCfg      evmconfig.GasEstimator
+		gasLimit    uint64
+		txm         txmgr.TxManager
+		ethClient   evmclient.Client
+		chainID     *big.Int
+		chainIDStr  string
+		ethKeyStore keystore.Eth
+		auditLggr   logger.Logger
+		sleeperTask *utils.SleeperTask
+	}
+)
+
+var _ FundingManager = (*fundingManager)(nil)
+
+// NewFundingManager returns a new fundingManager, sending its transfers with the transfer gas limit of gasCfg
+func NewFundingManager(cfg evmconfig.Funding, gasCfg evmconfig.GasEstimator, txm txmgr.TxManager, ethClient evmclient.Client, ethKeyStore keystore.Eth, lggr logger.Logger) *fundingManager {
+	chainID := ethClient.ConfiguredChainID()
+	fm := &fundingManager{
+		cfg:         cfg,
+		gasCfg:      gasCfg,
+		gasLimit:    gasCfg.LimitTransfer(),
+		txm:         txm,
+		ethClient:   ethClient,
+		chainID:     chainID,
+		chainIDStr:  chainID.String(),
+		ethKeyStore: ethKeyStore,
+	}
+	fm.Service, fm.eng = services.Config{
+		Name:  "FundingManager",
+		Close: fm.close,
+	}.NewServiceEngine(lggr)
+	fm.auditLggr = logger.Named(fm.eng, "FundingAudit")
+	fm.sleeperTask = utils.NewSleeperTask(&fundingWorker{fm: fm})
+	return fm
+}
+
+// Close shuts down the FundingManager, should not be used after this
+func (fm *fundingManager) close() error {
+	return fm.sleeperTask.Stop()
+}
+
+// OnNewLongestChain checks the balance of each key and funds or sweeps it if needed
+func (fm *fundingManager) OnNewLongestChain(_ context.Context, _ *evmtypes.Head) {
+	ok := fm.sleeperTask.WakeUpIfStarted()
+	if !ok {
+		fm.eng.Debugw("FundingManager: ignoring OnNewLongestChain call, funding manager is not started", "state", fm.sleeperTask.State())
+	}
+}
+
+var promFundingTransfers = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "funding_transfers",
+		Help: "The number of funding transfers created by the funding manager, by kind",
+	},
+	[]string{"evmChainID", "kind"},
+)
+
+// pendingKeys returns the keys sending or receiving a funding transfer which is not settled yet.
+func (fm *fundingManager) pendingKeys(ctx context.Context) (map[gethCommon.Address]bool, error) {
+	txes, err := fm.txm.FindTxesWithMetaFieldByStates(ctx, "FundingKind", fundingTxStates, fm.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending funding transfers: %w", err)
+	}
+
+	pending := make(map[gethCommon.Address]bool, 2*len(txes))
+	for _, tx := range txes {
+		pending[tx.FromAddress] = true
+		pending[tx.ToAddress] = true
+	}
+	return pending, nil
+}
+
+func (fm *fundingManager) balance(ctx context.Context, address gethCommon.Address) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(ctx, ethFetchTimeout)
+	defer cancel()
+
+	bal, err := fm.ethClient.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance for key %s: %w", address.Hex(), err)
+	} else if bal == nil {
+		return nil, fmt.Errorf("error getting balance for key %s: invariant violation, bal may not be nil", address.Hex())
+	}
+	return bal, nil
+}
+
+// sweepFee returns the fee kept by a key for the gas of its sweep. It is priced at twice the current gas price, capped
+// by the key's max gas price, so that the key keeps its target balance even if the sweep is bumped. On chains with an
+// L1 data fee, the fee of the sweep is slightly higher.
+func (fm *fundingManager) sweepFee(ctx context.Context, address gethCommon.Address) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(ctx, ethFetchTimeout)
+	defer cancel()
+
+	price, err := fm.ethClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting gas price: %w", err)
+	}
+	price = new(big.Int).Mul(price, big.NewInt(2))
+	if maxPrice := fm.gasCfg.PriceMaxKey(address).ToInt(); price.Cmp(maxPrice) > 0 {
+		price = maxPrice
+	}
+	return new(big.Int).Mul(price, new(big.Int).SetUint64(fm.gasLimit)), nil
+}
+
+func (fm *fundingManager) transfer(ctx context.Context, kind string, from, to gethCommon.Address, amount, balance *big.Int) {
+	lggr := logger.With(fm.auditLggr,
+		"kind", kind,
+		"from", from.Hex(),
+		"to", to.Hex(),
+		"amount", assets.NewWei(amount).String(),
+		"balance", assets.NewWei(balance).String())
+
+	// Sweeps are never urgent, unlike the other txes of the swept key.
+	priority := txmgrtypes.TxPriorityHigh
+	if kind == FundingKindSweep {
+		priority = txmgrtypes.TxPriorityLow
+	}
+
+	etx, err := fm.txm.CreateTransaction(ctx, txmgr.TxRequest{
+		FromAddress:    from,
+		ToAddress:      to,
+		EncodedPayload: []byte{},
+		Value:          *amount,
+		FeeLimit:       fm.gasLimit,
+		Meta:           &txmgr.TxMeta{FundingKind: &kind},
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       priority,
+	})
+	if err != nil {
+		lggr.Errorw("FundingManager: failed to create funding transfer", "err", err)
+		return
+	}
+
+	promFundingTransfers.WithLabelValues(fm.chainIDStr, kind).Inc()
+	lggr.Infow(fmt.Sprintf("FundingManager: created %s transfer of %s from %s to %s", kind, assets.NewWei(amount), from.Hex(), to.Hex()),
+		"txID", etx.ID)
+}
+
+type fundingWorker struct {
+	fm *fundingManager
+}
+
+func (*fundingWorker) Name() string {
+	return "FundingManagerWorker"
+}
+
+func (w *fundingWorker) Work() {
+	// Used with SleeperTask
+	ctx, cancel := w.fm.eng.NewCtx()
+	defer cancel()
+	w.WorkCtx(ctx)
+}
+
+func (w *fundingWorker) WorkCtx(ctx context.Context) {
+	fm := w.fm
+	treasury := fm.cfg.TreasuryKey()
+
+	enabledAddresses, err := fm.ethKeyStore.EnabledAddressesForChain(ctx, fm.chainID)
+	if err != nil {
+		fm.eng.Errorw("FundingManager: error getting keys", "err", err)
+		return
+	}
+	if !slices.Contains(enabledAddresses, treasury) {
+		fm.eng.Errorw(fmt.Sprintf("FundingManager: treasury key %s is not enabled for chain %s", treasury.Hex(), fm.chainIDStr),
+			"treasury", treasury)
+		return
+	}
+
+	pending, err := fm.pendingKeys(ctx)
+	if err != nil {
+		fm.eng.Errorw("FundingManager: error getting pending transfers", "err", err)
+		return
+	}
+	if pending[treasury] {
+		// the balance of the treasury key doesn't reflect its pending transfers yet
+		fm.eng.Debugw("FundingManager: waiting for pending funding transfers of the treasury key", "treasury", treasury)
+		return
+	}
+
+	treasuryBalance, err := fm.balance(ctx, treasury)
+	if err != nil {
+		fm.eng.Errorw("FundingManager: "+err.Error(), "address", treasury)
+		return
+	}
+
+	for _, address := range enabledAddresses {
+		if address == treasury || pending[address] {
+			continue
+		}
+
+		balance, err := fm.balance(ctx, address)
+		if err != nil {
+			fm.eng.Errorw("FundingManager: "+err.Error(), "address", address)
+			continue
+		}
+
+		minBalance, targetBalance := fm.cfg.MinBalance(address).ToInt(), fm.cfg.TargetBalance(address).ToInt()
+		if targetBalance.Cmp(minBalance) <= 0 {
+			fm.eng.Errorw(fmt.Sprintf("FundingManager: target balance of key %s must be greater than its min balance", address.Hex()),
+				"address", address, "minBalance", minBalance, "targetBalance", targetBalance)
+			continue
+		}
+
+		switch {
+		case balance.Cmp(minBalance) < 0:
+			amount := new(big.Int).Sub(targetBalance, balance)
+			if treasuryBalance.Cmp(amount) < 0 {
+				fm.auditLggr.Errorw(fmt.Sprintf("FundingManager: treasury key %s balance too low to top up key %s", treasury.Hex(), address.Hex()),
+					"treasury", treasury, "treasuryBalance", assets.NewWei(treasuryBalance).String(),
+					"address", address, "amount", assets.NewWei(amount).String())
+				continue
+			}
+			fm.transfer(ctx, FundingKindTopUp, treasury, address, amount, balance)
+			treasuryBalance = new(big.Int).Sub(treasuryBalance, amount)
+		case fm.cfg.SweepEnabled() && balance.Cmp(fm.cfg.SweepThreshold().ToInt()) > 0:
+			// the swept key pays the gas of the sweep, so it keeps the fee on top of its target balance
+			fee, err := fm.sweepFee(ctx, address)
+			if err != nil {
+				fm.eng.Errorw("FundingManager: "+err.Error(), "address", address)
+				continue
+			}
+			amount := new(big.Int).Sub(balance, targetBalance)
+			amount.Sub(amount, fee)
+			if amount.Sign() <= 0 {
+				fm.eng.Debugw(fmt.Sprintf("FundingManager: excess balance of key %s does not cover the fee of its sweep", address.Hex()),
+					"address", address, "balance", assets.NewWei(balance).String(), "fee", assets.NewWei(fee).String())
+				continue
+			}
+			fm.transfer(ctx, FundingKindSweep, address, treasury, amount, balance)
+		}
+	}
+}
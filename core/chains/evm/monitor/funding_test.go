@@ -0,0 +1,109 @@
+package monitor_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+func TestFundingManager(t *testing.T) {
+	t.Parallel()
+
+	treasury := testutils.NewAddress()
+	low := testutils.NewAddress()
+	lowSpecific := testutils.NewAddress()
+	high := testutils.NewAddress()
+	barelyHigh := testutils.NewAddress()
+	pending := testutils.NewAddress()
+
+	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.Funding.Enabled = ptr(true)
+		c.Funding.TreasuryKey = ptr(types.MustEIP55Address(treasury.Hex()))
+		c.Funding.MinBalance = assets.Ether(1)
+		c.Funding.TargetBalance = assets.Ether(5)
+		c.Funding.SweepEnabled = ptr(true)
+		c.Funding.SweepThreshold = assets.Ether(10)
+		c.KeySpecific = toml.KeySpecificConfig{{
+			Key:     ptr(types.MustEIP55Address(lowSpecific.Hex())),
+			Funding: toml.KeySpecificFunding{MinBalance: assets.Ether(3), TargetBalance: assets.Ether(4)},
+		}, {
+			Key:     ptr(types.MustEIP55Address(barelyHigh.Hex())),
+			Funding: toml.KeySpecificFunding{TargetBalance: assets.Ether(10).Sub(assets.GWei(1))},
+		}}
+	})
+
+	ethKeyStore := ksmocks.NewEth(t)
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+		Return([]common.Address{treasury, low, lowSpecific, high, barelyHigh, pending}, nil)
+
+	ethClient := newEthClientMock(t)
+	ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Return(assets.Ether(100).ToInt(), nil)
+	ethClient.On("BalanceAt", mock.Anything, low, nilBigInt).Return(assets.Ether(0).ToInt(), nil)
+	ethClient.On("BalanceAt", mock.Anything, lowSpecific, nilBigInt).Return(assets.Ether(2).ToInt(), nil)
+	ethClient.On("BalanceAt", mock.Anything, high, nilBigInt).Return(assets.Ether(12).ToInt(), nil)
+	// the excess balance of barelyHigh does not cover the fee of its sweep
+	ethClient.On("BalanceAt", mock.Anything, barelyHigh, nilBigInt).Return(assets.Ether(10).Add(assets.GWei(1)).ToInt(), nil)
+	ethClient.On("SuggestGasPrice", mock.Anything).Return(assets.GWei(1).ToInt(), nil)
+
+	txm := txmmocks.NewMockEvmTxManager(t)
+	txm.On("FindTxesWithMetaFieldByStates", mock.Anything, "FundingKind", mock.Anything, big.NewInt(0)).
+		Return([]*txmgr.Tx{{FromAddress: pending, ToAddress: common.Address{}}}, nil)
+
+	created := make(chan txmgr.TxRequest, 3)
+	txm.On("CreateTransaction", mock.Anything, mock.Anything).Return(txmgr.Tx{}, nil).Run(func(args mock.Arguments) {
+		created <- args.Get(1).(txmgr.TxRequest)
+	}).Times(3)
+
+	fm := monitor.NewFundingManager(cfg.EVM().Funding(), cfg.EVM().GasEstimator(), txm, ethClient, ethKeyStore, logger.Test(t))
+	servicetest.Run(t, fm)
+	fm.OnNewLongestChain(tests.Context(t), &types.Head{})
+
+	requests := map[common.Address]txmgr.TxRequest{}
+	for range 3 {
+		r := <-created
+		requests[r.FromAddress] = r
+		if r.FromAddress == treasury {
+			requests[r.ToAddress] = r
+		}
+	}
+
+	topUp := requests[low]
+	assert.Equal(t, treasury, topUp.FromAddress)
+	assert.Equal(t, assets.Ether(5).String(), assets.NewWei(&topUp.Value).String())
+	assert.Equal(t, monitor.FundingKindTopUp, *topUp.Meta.FundingKind)
+	assert.Equal(t, uint64(21000), topUp.FeeLimit)
+
+	topUp = requests[lowSpecific]
+	assert.Equal(t, treasury, topUp.FromAddress)
+	assert.Equal(t, assets.Ether(2).String(), assets.NewWei(&topUp.Value).String())
+
+	sweep := requests[high]
+	assert.Equal(t, treasury, sweep.ToAddress)
+	// the swept key keeps the fee of the sweep at twice the gas price
+	assert.Equal(t, assets.Ether(7).Sub(assets.GWei(2*21000)).String(), assets.NewWei(&sweep.Value).String())
+	assert.Equal(t, monitor.FundingKindSweep, *sweep.Meta.FundingKind)
+	assert.Equal(t, txmgrtypes.TxPriorityLow, sweep.Priority)
+
+	require.NotContains(t, requests, pending)
+	require.NotContains(t, requests, barelyHigh)
+}
+
+func ptr[T any](t T) *T { return &t }
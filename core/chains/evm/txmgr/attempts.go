@@ -3,11 +3,13 @@ package txmgr
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -46,7 +48,9 @@ func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeCon
 // used for when a brand new transaction is being created in the txm
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	txType := 0x0
-	if c.feeConfig.EIP1559DynamicFees() {
+	if len(etx.BlobSidecar) > 0 {
+		txType = 0x3
+	} else if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
@@ -60,6 +64,13 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
+	if txType == 0x3 {
+		fee.BlobFeeCap, err = c.EvmFeeEstimator.GetBlobFee(ctx, keySpecificMaxGasPriceWei)
+		if err != nil {
+			// unlike GetFee, GetBlobFee fails for estimators and chains without blob support, which retrying does not fix
+			return attempt, fee, feeLimit, false, fmt.Errorf("%w: failed to get blob fee: %w", txmgrtypes.ErrPermanentAttempt, err)
+		}
+	}
 
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, fee, feeLimit, txType, lggr)
 	return attempt, fee, feeLimit, retryable, err
@@ -112,6 +123,11 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 // NewCustomTxAttempt is the lowest level func where the fee parameters + tx type must be passed in
 // used in the txm for force rebroadcast where fees and tx type are pre-determined without an estimator
 func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error) {
+	if (len(etx.BlobSidecar) > 0) != (txType == 0x3) {
+		err = pkgerrors.Errorf("Attempt of tx %v is a type %d transaction, but the tx has %d bytes of blob sidecar. Only blob txes can be type 3 transactions", etx.ID, txType, len(etx.BlobSidecar))
+		logger.Sugared(lggr).AssumptionViolation(err.Error())
+		return attempt, false, err // not retryable
+	}
 	switch txType {
 	case 0x0: // legacy
 		if fee.Legacy == nil {
@@ -132,6 +148,17 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			TipCap: fee.DynamicTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case 0x3: // blob, EIP4844
+		if !fee.ValidDynamic() || fee.BlobFeeCap == nil {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but estimator did not return dynamic and blob fee bump", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		attempt, err = c.newBlobAttempt(ctx, etx, gas.DynamicFee{
+			FeeCap: fee.DynamicFeeCap,
+			TipCap: fee.DynamicTipCap,
+		}, fee.BlobFeeCap, gasLimit)
+		return attempt, !errors.Is(err, txmgrtypes.ErrPermanentAttempt), err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -198,6 +225,59 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+// newBlobAttempt builds an attempt of a blob tx. The attempt is signed without the blob sidecar, which is attached from the tx
+// whenever the attempt is broadcast.
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.DynamicFee, blobFeeCap *assets.Wei, gasLimit uint64) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, fee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if err = validateBlobFee(c.feeConfig, blobFeeCap, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating blob fee")
+	}
+	sidecar, err := decodeBlobSidecar(etx.BlobSidecar)
+	if err != nil {
+		return attempt, fmt.Errorf("%w: %w", txmgrtypes.ErrPermanentAttempt, err)
+	}
+
+	b := types.BlobTx{
+		ChainID:    uint256.MustFromBig(&c.chainID),
+		Nonce:      uint64(*etx.Sequence),
+		GasTipCap:  uint256.MustFromBig(fee.TipCap.ToInt()),
+		GasFeeCap:  uint256.MustFromBig(fee.FeeCap.ToInt()),
+		Gas:        gasLimit,
+		To:         etx.ToAddress,
+		Value:      uint256.MustFromBig(&etx.Value),
+		Data:       etx.EncodedPayload,
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap.ToInt()),
+		BlobHashes: sidecar.BlobHashes(),
+	}
+	tx := types.NewTx(&b)
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFeeCap: fee.FeeCap,
+		DynamicTipCap: fee.TipCap,
+		BlobFeeCap:    blobFeeCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = 3
+	return attempt, nil
+}
+
+// validateBlobFee is a sanity check of the maxFeePerBlobGas of blob txes
+func validateBlobFee(kse keySpecificEstimator, blobFeeCap *assets.Wei, etx Tx) error {
+	if blobFeeCap == nil {
+		return pkgerrors.Wrapf(txmgrtypes.ErrPermanentAttempt, "blob fee cap missing for blob tx %v", etx.ID)
+	}
+	max := kse.PriceMaxKey(etx.FromAddress)
+	if blobFeeCap.Cmp(max) > 0 {
+		return pkgerrors.Errorf("cannot create tx attempt: specified blob fee cap of %s would exceed max configured gas price of %s for key %s", blobFeeCap.String(), max.String(), etx.FromAddress.String())
+	}
+	return nil
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
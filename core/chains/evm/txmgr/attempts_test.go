@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -193,6 +196,99 @@ func TestTxm_NewDynamicFeeTx(t *testing.T) {
 	})
 }
 
+func TestTxm_NewBlobAttempt(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(1)
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, chainID).Return(func(_ context.Context, _ gethcommon.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+		return types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
+	})
+	sidecar, err := txmgr.NewBlobSidecar([][]byte{{1, 2, 3}})
+	require.NoError(t, err)
+	var n evmtypes.Nonce
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	feeCfg := newFeeConfig()
+	feeCfg.priceMax = assets.GWei(200)
+
+	t.Run("creates type 3 attempt for blob txes", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{DynamicTipCap: assets.GWei(1), DynamicFeeCap: assets.GWei(10)}, uint64(100_000), nil)
+		est.On("GetBlobFee", mock.Anything, assets.GWei(200)).Return(assets.GWei(5), nil)
+		cks := txmgr.NewEvmTxAttemptBuilder(*chainID, feeCfg, kst, est)
+
+		a, _, _, _, err := cks.NewTxAttempt(ctx, txmgr.Tx{Sequence: &n, FromAddress: addr, BlobSidecar: sidecar}, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, assets.GWei(5).String(), a.TxFee.BlobFeeCap.String())
+		assert.Equal(t, assets.GWei(10).String(), a.TxFee.DynamicFeeCap.String())
+
+		signedTx, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(types.BlobTxType), signedTx.Type())
+		assert.Len(t, signedTx.BlobHashes(), 1)
+		assert.Equal(t, assets.GWei(5).ToInt(), signedTx.BlobGasFeeCap())
+		assert.Nil(t, signedTx.BlobTxSidecar(), "attempts are stored without sidecar")
+		assert.Equal(t, a.Hash, signedTx.Hash())
+	})
+
+	t.Run("fails for blob fee cap exceeding max configured price", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*chainID, feeCfg, kst, nil)
+		_, _, err := cks.NewCustomTxAttempt(ctx, txmgr.Tx{Sequence: &n, FromAddress: addr, BlobSidecar: sidecar}, gas.EvmFee{
+			DynamicTipCap: assets.GWei(1),
+			DynamicFeeCap: assets.GWei(10),
+			BlobFeeCap:    assets.GWei(201),
+		}, 100_000, 0x3, lggr)
+		require.ErrorContains(t, err, "specified blob fee cap of 201 gwei would exceed max configured gas price of 200 gwei")
+	})
+
+	t.Run("fails permanently if the blob fee can not be estimated", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{DynamicTipCap: assets.GWei(1), DynamicFeeCap: assets.GWei(10)}, uint64(100_000), nil)
+		est.On("GetBlobFee", mock.Anything, assets.GWei(200)).Return(nil, pkgerrors.New("blob transactions are not supported"))
+		cks := txmgr.NewEvmTxAttemptBuilder(*chainID, feeCfg, kst, est)
+
+		_, _, _, retryable, err := cks.NewTxAttempt(ctx, txmgr.Tx{Sequence: &n, FromAddress: addr, BlobSidecar: sidecar}, lggr)
+		require.ErrorIs(t, err, txmgrtypes.ErrPermanentAttempt)
+		require.ErrorContains(t, err, "blob transactions are not supported")
+		assert.False(t, retryable)
+	})
+
+	t.Run("fails permanently for invalid blob sidecar", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*chainID, feeCfg, kst, nil)
+		_, retryable, err := cks.NewCustomTxAttempt(ctx, txmgr.Tx{Sequence: &n, FromAddress: addr, BlobSidecar: []byte{1, 2, 3}}, gas.EvmFee{
+			DynamicTipCap: assets.GWei(1),
+			DynamicFeeCap: assets.GWei(10),
+			BlobFeeCap:    assets.GWei(5),
+		}, 100_000, 0x3, lggr)
+		require.ErrorIs(t, err, txmgrtypes.ErrPermanentAttempt)
+		require.ErrorContains(t, err, "failed to decode blob sidecar")
+		assert.False(t, retryable)
+	})
+
+	t.Run("fails for mismatched tx type", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*chainID, feeCfg, kst, nil)
+		_, retryable, err := cks.NewCustomTxAttempt(ctx, txmgr.Tx{Sequence: &n, FromAddress: addr, BlobSidecar: sidecar}, gas.EvmFee{
+			DynamicTipCap: assets.GWei(1),
+			DynamicFeeCap: assets.GWei(10),
+		}, 100_000, 0x2, lggr)
+		require.ErrorContains(t, err, "Only blob txes can be type 3 transactions")
+		assert.False(t, retryable)
+
+		_, retryable, err = cks.NewCustomTxAttempt(ctx, txmgr.Tx{Sequence: &n, FromAddress: addr}, gas.EvmFee{
+			DynamicTipCap: assets.GWei(1),
+			DynamicFeeCap: assets.GWei(10),
+			BlobFeeCap:    assets.GWei(5),
+		}, 100_000, 0x3, lggr)
+		require.ErrorContains(t, err, "Only blob txes can be type 3 transactions")
+		assert.False(t, retryable)
+	})
+}
+
 func TestTxm_NewLegacyAttempt(t *testing.T) {
 	addr := NewEvmAddress()
 	kst := ksmocks.NewEth(t)
@@ -0,0 +1,106 @@
+package txmgr
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// MaxBlobsPerTx is the maximum number of blobs of a blob tx, which is limited by the blob gas of a block
+const MaxBlobsPerTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+
+// NewBlobSidecar computes the KZG commitments and proofs of the blobs, and returns the encoded sidecar to be set as the
+// BlobSidecar of a TxRequest. Blobs shorter than a full blob are padded with zeros.
+func NewBlobSidecar(blobs [][]byte) ([]byte, error) {
+	if len(blobs) == 0 || len(blobs) > MaxBlobsPerTx {
+		return nil, fmt.Errorf("a blob tx must have between 1 and %d blobs, got %d", MaxBlobsPerTx, len(blobs))
+	}
+	sidecar := &types.BlobTxSidecar{}
+	for i, b := range blobs {
+		var blob kzg4844.Blob
+		if len(b) > len(blob) {
+			return nil, fmt.Errorf("blob %d is %d bytes, which exceeds the blob size of %d bytes", i, len(b), len(blob))
+		}
+		copy(blob[:], b)
+		commitment, err := kzg4844.BlobToCommitment(blob)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute the commitment of blob %d", i)
+		}
+		proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute the proof of blob %d", i)
+		}
+		sidecar.Blobs = append(sidecar.Blobs, blob)
+		sidecar.Commitments = append(sidecar.Commitments, commitment)
+		sidecar.Proofs = append(sidecar.Proofs, proof)
+	}
+	return rlp.EncodeToBytes(sidecar)
+}
+
+func decodeBlobSidecar(b []byte) (*types.BlobTxSidecar, error) {
+	sidecar := new(types.BlobTxSidecar)
+	if err := rlp.DecodeBytes(b, sidecar); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to decode blob sidecar")
+	}
+	if len(sidecar.Blobs) == 0 || len(sidecar.Blobs) != len(sidecar.Commitments) || len(sidecar.Blobs) != len(sidecar.Proofs) {
+		return nil, fmt.Errorf("invalid blob sidecar with %d blobs, %d commitments and %d proofs", len(sidecar.Blobs), len(sidecar.Commitments), len(sidecar.Proofs))
+	}
+	return sidecar, nil
+}
+
+// blobTxWithSidecar is the network encoding of a blob tx, which is used to broadcast it with its blobs
+type blobTxWithSidecar struct {
+	BlobTx      rlp.RawValue
+	Blobs       []kzg4844.Blob
+	Commitments []kzg4844.Commitment
+	Proofs      []kzg4844.Proof
+}
+
+// withBlobSidecar attaches the encoded sidecar to a signed blob tx. Attempts of blob txes are stored without their sidecar,
+// which is only kept once per tx, so it has to be attached again for every broadcast.
+func withBlobSidecar(tx *types.Transaction, encodedSidecar []byte) (*types.Transaction, error) {
+	if tx.Type() != types.BlobTxType {
+		return nil, fmt.Errorf("cannot attach a blob sidecar to a tx of type %d", tx.Type())
+	}
+	sidecar, err := decodeBlobSidecar(encodedSidecar)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Equal(sidecar.BlobHashes(), tx.BlobHashes()) {
+		return nil, fmt.Errorf("blob sidecar does not match the blob hashes of tx %s", tx.Hash())
+	}
+	canonical, err := tx.WithoutBlobTxSidecar().MarshalBinary()
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to encode blob tx")
+	}
+	enc, err := rlp.EncodeToBytes(&blobTxWithSidecar{
+		BlobTx:      canonical[1:],
+		Blobs:       sidecar.Blobs,
+		Commitments: sidecar.Commitments,
+		Proofs:      sidecar.Proofs,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to encode blob tx with sidecar")
+	}
+	txWithSidecar := new(types.Transaction)
+	if err = txWithSidecar.UnmarshalBinary(append([]byte{types.BlobTxType}, enc...)); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to decode blob tx with sidecar")
+	}
+	return txWithSidecar, nil
+}
+
+// txForBroadcast returns the signed tx of an attempt, with the blob sidecar of its tx attached for blob txes
+func txForBroadcast(signedTx *types.Transaction, etx Tx) (*types.Transaction, error) {
+	if signedTx.Type() != types.BlobTxType {
+		return signedTx, nil
+	}
+	if len(etx.BlobSidecar) == 0 {
+		return nil, fmt.Errorf("blob sidecar of tx %d is missing, it may already be finalized", etx.ID)
+	}
+	return withBlobSidecar(signedTx, etx.BlobSidecar)
+}
@@ -0,0 +1,111 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+func TestNewBlobSidecar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("computes commitments and proofs", func(t *testing.T) {
+		encoded, err := txmgr.NewBlobSidecar([][]byte{{1, 2, 3}, {}})
+		require.NoError(t, err)
+
+		var sidecar types.BlobTxSidecar
+		require.NoError(t, rlp.DecodeBytes(encoded, &sidecar))
+		require.Len(t, sidecar.Blobs, 2)
+		require.Len(t, sidecar.Commitments, 2)
+		require.Len(t, sidecar.Proofs, 2)
+		assert.Equal(t, []byte{1, 2, 3, 0}, sidecar.Blobs[0][:4])
+		for i := range sidecar.Blobs {
+			assert.NoError(t, kzg4844.VerifyBlobProof(sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]))
+		}
+	})
+
+	t.Run("fails for invalid number of blobs", func(t *testing.T) {
+		_, err := txmgr.NewBlobSidecar(nil)
+		require.ErrorContains(t, err, "a blob tx must have between 1 and 6 blobs, got 0")
+		_, err = txmgr.NewBlobSidecar(make([][]byte, txmgr.MaxBlobsPerTx+1))
+		require.ErrorContains(t, err, "a blob tx must have between 1 and 6 blobs, got 7")
+	})
+
+	t.Run("fails for oversized blob", func(t *testing.T) {
+		_, err := txmgr.NewBlobSidecar([][]byte{make([]byte, len(kzg4844.Blob{})+1)})
+		require.ErrorContains(t, err, "exceeds the blob size")
+	})
+}
+
+func TestEvmTxmClient_SendTransactionReturnCode_Blob(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	encodedSidecar, err := txmgr.NewBlobSidecar([][]byte{{1, 2, 3}})
+	require.NoError(t, err)
+	var sidecar types.BlobTxSidecar
+	require.NoError(t, rlp.DecodeBytes(encodedSidecar, &sidecar))
+
+	chainID := big.NewInt(1)
+	signedTx, err := types.SignTx(types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(10),
+		Gas:        100_000,
+		Value:      uint256.NewInt(0),
+		BlobFeeCap: uint256.NewInt(5),
+		BlobHashes: sidecar.BlobHashes(),
+	}), types.LatestSignerForChainID(chainID), key)
+	require.NoError(t, err)
+	rawTx, err := rlp.EncodeToBytes(signedTx)
+	require.NoError(t, err)
+	attempt := txmgr.TxAttempt{SignedRawTx: rawTx, Hash: signedTx.Hash(), TxType: 3}
+	lggr := logger.Sugared(logger.Test(t))
+
+	t.Run("attaches the blob sidecar of the tx", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Hash() == signedTx.Hash() && tx.BlobTxSidecar() != nil && len(tx.BlobTxSidecar().Blobs) == 1
+		}), from).Return(commonclient.Successful, nil).Once()
+		client := txmgr.NewEvmTxmClient(ethClient, nil)
+
+		code, err := client.SendTransactionReturnCode(tests.Context(t), txmgr.Tx{FromAddress: from, BlobSidecar: encodedSidecar}, attempt, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+	})
+
+	t.Run("fails for missing blob sidecar", func(t *testing.T) {
+		client := txmgr.NewEvmTxmClient(testutils.NewEthClientMockWithDefaultChain(t), nil)
+
+		code, err := client.SendTransactionReturnCode(tests.Context(t), txmgr.Tx{ID: 1, FromAddress: from}, attempt, lggr)
+		require.ErrorContains(t, err, "blob sidecar of tx 1 is missing")
+		assert.Equal(t, commonclient.Fatal, code)
+	})
+
+	t.Run("fails for mismatched blob sidecar", func(t *testing.T) {
+		otherSidecar, err := txmgr.NewBlobSidecar([][]byte{{4, 5, 6}})
+		require.NoError(t, err)
+		client := txmgr.NewEvmTxmClient(testutils.NewEthClientMockWithDefaultChain(t), nil)
+
+		code, err := client.SendTransactionReturnCode(tests.Context(t), txmgr.Tx{FromAddress: from, BlobSidecar: otherSidecar}, attempt, lggr)
+		require.ErrorContains(t, err, "blob sidecar does not match the blob hashes")
+		assert.Equal(t, commonclient.Fatal, code)
+	})
+}
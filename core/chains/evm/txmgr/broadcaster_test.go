@@ -629,6 +629,82 @@ func TestEthBroadcaster_TransmitChecking(t *testing.T) {
 	})
 }
 
+func TestEthBroadcaster_SimulateBeforeBroadcast(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.SimulateBeforeBroadcast = ptr(true)
+	})
+	ctx := tests.Context(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	checkerFactory := &txmgr.CheckerFactory{Client: ethClient}
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, checkerFactory, false, nonceTracker)
+
+	t.Run("when simulation reverts, fatally errors transaction with revert reason", func(t *testing.T) {
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending").Return(&client.JsonError{
+			Code:    3,
+			Message: "execution reverted",
+			// Error("not allowed")
+			Data: "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f7420616c6c6f776564000000000000000000000000000000000000000000",
+		}).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		{
+			retryable, err := eb.ProcessUnstartedTxs(tests.Context(t), fromAddress)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, ethTx.State)
+		assert.Equal(t, "transaction reverted during simulation: not allowed", ethTx.Error.String)
+		assert.Empty(t, ethTx.TxAttempts)
+	})
+
+	t.Run("when simulation succeeds, sends tx as normal", func(t *testing.T) {
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending").Return(nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		{
+			retryable, err := eb.ProcessUnstartedTxs(tests.Context(t), fromAddress)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, ethTx.State)
+	})
+
+	t.Run("when disabled for the tx, sends tx without simulation", func(t *testing.T) {
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 1
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithChecker(txmgr.TransmitCheckerSpec{Simulate: ptr(false)}))
+		{
+			retryable, err := eb.ProcessUnstartedTxs(tests.Context(t), fromAddress)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		ethTx, err := txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, ethTx.State)
+	})
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testing.T) {
 	// non-transactional DB needed because we deliberately test for FK violation
 	cfg, db := heavyweight.FullTestDBV2(t, nil)
@@ -1988,3 +2064,105 @@ func (t *testChecker) Check(
 ) error {
 	return t.err
 }
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_TxSchedulers(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ctx := tests.Context(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	expectSend := func(nonce uint64, value int64) {
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == nonce && tx.Value().Cmp(big.NewInt(value)) == 0
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+	}
+
+	t.Run("broadcasts txes with higher priority first", func(t *testing.T) {
+		lowTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithPriority(txmgrtypes.TxPriorityLow), txRequestWithValue(*big.NewInt(1)))
+		highTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithPriority(txmgrtypes.TxPriorityHigh), txRequestWithValue(*big.NewInt(2)))
+		expectSend(0, 2)
+		expectSend(1, 1)
+
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		highTx, err = txStore.FindTxWithAttempts(ctx, highTx.ID)
+		require.NoError(t, err)
+		require.NotNil(t, highTx.Sequence)
+		assert.Equal(t, evmtypes.Nonce(0), *highTx.Sequence)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, highTx.Priority)
+		lowTx, err = txStore.FindTxWithAttempts(ctx, lowTx.ID)
+		require.NoError(t, err)
+		require.NotNil(t, lowTx.Sequence)
+		assert.Equal(t, evmtypes.Nonce(1), *lowTx.Sequence)
+		assert.Equal(t, txmgrtypes.TxPriorityLow, lowTx.Priority)
+	})
+
+	t.Run("holds back txes of rate limited subjects", func(t *testing.T) {
+		limited := txmgrcommon.NewSchedulingStrategy(uuid.New(), 0, 0, time.Hour)
+		eb.RegisterTxScheduler(limited)
+
+		firstTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithStrategy(limited), txRequestWithValue(*big.NewInt(3)))
+		secondTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithStrategy(limited), txRequestWithValue(*big.NewInt(4)))
+		expectSend(2, 3)
+
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		firstTx, err = txStore.FindTxWithAttempts(ctx, firstTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, firstTx.State)
+		secondTx, err = txStore.FindTxWithAttempts(ctx, secondTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, secondTx.State)
+	})
+
+	t.Run("does not hold back subjects whose tx failed to be sent", func(t *testing.T) {
+		limited := txmgrcommon.NewSchedulingStrategy(uuid.New(), 0, 0, time.Hour)
+		eb.RegisterTxScheduler(limited)
+
+		failedTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithStrategy(limited), txRequestWithValue(*big.NewInt(5)))
+		sentTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithStrategy(limited), txRequestWithValue(*big.NewInt(6)))
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 3 && tx.Value().Cmp(big.NewInt(5)) == 0
+		}), fromAddress).Return(commonclient.Fatal, errors.New("exceeds block gas limit")).Once()
+		expectSend(3, 6)
+
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		failedTx, err = txStore.FindTxWithAttempts(ctx, failedTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, failedTx.State)
+		sentTx, err = txStore.FindTxWithAttempts(ctx, sentTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, sentTx.State)
+	})
+
+	t.Run("evicts idle schedules", func(t *testing.T) {
+		eb.XXXTestSetScheduleIdleTimeout(0)
+		eb.RegisterTxScheduler(txmgrcommon.NewSchedulingStrategy(uuid.New(), 0, 0, 0))
+
+		_, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		// the rate limited subjects of the previous tests are kept until their interval has passed
+		assert.Equal(t, 2, eb.XXXTestScheduledSubjects())
+	})
+}
@@ -0,0 +1,261 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+var _ txmgrtypes.TxBudget[common.Address] = (*GasBudget)(nil)
+
+const (
+	// gasBudgetPollInterval controls how often the fees spent over the budget windows are recomputed
+	gasBudgetPollInterval = 15 * time.Second
+
+	hourlyWindow = "hourly"
+	dailyWindow  = "daily"
+)
+
+var (
+	promGasBudgetSpent = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_gas_budget_spent",
+		Help: "Fees spent (in Wei) by a key or a job over the rolling budget window",
+	}, []string{"evmChainID", "subject", "id", "window"})
+	promGasBudgetExhausted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_gas_budget_exhausted",
+		Help: "Number of txes rejected or deferred because their key or job exhausted its budget",
+	}, []string{"evmChainID", "subject", "mode"})
+)
+
+type gasBudgetTxStore interface {
+	FindGasSpendsSince(ctx context.Context, since time.Time, chainID *big.Int) ([]GasSpend, error)
+}
+
+type budgetSpent struct {
+	hourly *big.Int
+	daily  *big.Int
+}
+
+// GasBudget caps the fees spent by each key and job over rolling hourly and daily windows. The fees of the txes mined
+// over the daily window are recomputed from their receipts every gasBudgetPollInterval, so a key or job may overspend
+// by the txes mined in between.
+// Only the txes with a TxMeta.JobID, i.e. the ones of the ethtx pipeline task, count toward the budget of their job,
+// while the txes of the other jobs only count toward the budget of their key.
+// Depending on the configured mode, the Txm rejects the new txes of exhausted keys and jobs, or the Broadcaster
+// leaves them unstarted until the budget replenishes.
+type GasBudget struct {
+	services.StateMachine
+	lggr    logger.SugaredLogger
+	chainID *big.Int
+	cfg     config.TxBudget
+	txStore gasBudgetTxStore
+
+	mu        sync.RWMutex
+	keySpent  map[common.Address]budgetSpent
+	jobSpent  map[int32]budgetSpent
+	exhausted map[string]struct{}
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+func NewGasBudget(lggr logger.Logger, chainID *big.Int, cfg config.TxBudget, txStore gasBudgetTxStore) *GasBudget {
+	return &GasBudget{
+		lggr:      logger.Sugared(logger.Named(lggr, "GasBudget")),
+		chainID:   chainID,
+		cfg:       cfg,
+		txStore:   txStore,
+		keySpent:  make(map[common.Address]budgetSpent),
+		jobSpent:  make(map[int32]budgetSpent),
+		exhausted: make(map[string]struct{}),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (b *GasBudget) Start(ctx context.Context) error {
+	return b.StartOnce("GasBudget", func() error {
+		b.lggr.Debugw("Starting GasBudget", "mode", b.cfg.Mode(), "keyHourly", b.cfg.KeyHourly(), "keyDaily", b.cfg.KeyDaily(),
+			"jobHourly", b.cfg.JobHourly(), "jobDaily", b.cfg.JobDaily())
+		if err := b.Refresh(ctx, time.Now()); err != nil {
+			b.lggr.Errorw("Failed to load the spent fees, the budget is not enforced until they are loaded", "err", err)
+		}
+		b.wg.Add(1)
+		go b.runLoop()
+		return nil
+	})
+}
+
+func (b *GasBudget) Close() error {
+	return b.StopOnce("GasBudget", func() error {
+		close(b.stopCh)
+		b.wg.Wait()
+		return nil
+	})
+}
+
+func (b *GasBudget) Name() string {
+	return b.lggr.Name()
+}
+
+func (b *GasBudget) HealthReport() map[string]error {
+	return map[string]error{b.Name(): b.Healthy()}
+}
+
+func (b *GasBudget) runLoop() {
+	defer b.wg.Done()
+	ctx, cancel := b.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(gasBudgetPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.Refresh(ctx, time.Now()); err != nil {
+				b.lggr.Errorw("Failed to refresh the spent fees", "err", err)
+				b.SvcErrBuffer.Append(err)
+			}
+		}
+	}
+}
+
+// DeferExhausted returns true in Defer mode
+func (b *GasBudget) DeferExhausted() bool {
+	return b.cfg.Mode() == "Defer"
+}
+
+// CheckBudget returns an error wrapping ErrTxBudgetExhausted if the key, or the job if not nil, spent its hourly or
+// daily budget.
+func (b *GasBudget) CheckBudget(fromAddress common.Address, jobID *int32) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if err := checkBudgetSpent("key "+fromAddress.String(), b.keySpent[fromAddress], b.cfg.KeyHourly(), b.cfg.KeyDaily()); err != nil {
+		promGasBudgetExhausted.WithLabelValues(b.chainID.String(), "key", b.cfg.Mode()).Inc()
+		return err
+	}
+	if jobID == nil {
+		return nil
+	}
+	if err := checkBudgetSpent(fmt.Sprintf("job %d", *jobID), b.jobSpent[*jobID], b.cfg.JobHourly(), b.cfg.JobDaily()); err != nil {
+		promGasBudgetExhausted.WithLabelValues(b.chainID.String(), "job", b.cfg.Mode()).Inc()
+		return err
+	}
+	return nil
+}
+
+func checkBudgetSpent(subject string, spent budgetSpent, hourly, daily *assets.Wei) error {
+	if budgetExceeded(spent.hourly, hourly) {
+		return fmt.Errorf("%w: %s spent %s of its %s budget of %s", txmgrtypes.ErrTxBudgetExhausted, subject, assets.NewWei(spent.hourly), hourlyWindow, hourly)
+	}
+	if budgetExceeded(spent.daily, daily) {
+		return fmt.Errorf("%w: %s spent %s of its %s budget of %s", txmgrtypes.ErrTxBudgetExhausted, subject, assets.NewWei(spent.daily), dailyWindow, daily)
+	}
+	return nil
+}
+
+// budgetExceeded returns true if the spent fees reached the limit, zero limits are disabled
+func budgetExceeded(spent *big.Int, limit *assets.Wei) bool {
+	return spent != nil && limit != nil && limit.ToInt().Sign() > 0 && spent.Cmp(limit.ToInt()) >= 0
+}
+
+// Refresh recomputes the fees spent over the daily window. Recomputing the whole window, rather than only accounting
+// the newly saved receipts, keeps the spent fees right when receipts are saved out of order or deleted by a re-org.
+func (b *GasBudget) Refresh(ctx context.Context, now time.Time) error {
+	dayAgo, hourAgo := now.Add(-24*time.Hour), now.Add(-time.Hour)
+
+	spends, err := b.txStore.FindGasSpendsSince(ctx, dayAgo, b.chainID)
+	if err != nil {
+		return fmt.Errorf("failed to load spent fees: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	keySpent := make(map[common.Address]budgetSpent)
+	jobSpent := make(map[int32]budgetSpent)
+	for _, s := range spends {
+		inHour := s.CreatedAt.After(hourAgo)
+		keySpent[s.FromAddress] = addBudgetSpent(keySpent[s.FromAddress], s.Fee, inHour)
+		if s.JobID != nil {
+			jobSpent[*s.JobID] = addBudgetSpent(jobSpent[*s.JobID], s.Fee, inHour)
+		}
+	}
+
+	for addr := range b.keySpent {
+		if _, ok := keySpent[addr]; !ok {
+			b.reportSpent("key", addr.String(), budgetSpent{}, b.cfg.KeyHourly(), b.cfg.KeyDaily())
+		}
+	}
+	for jobID := range b.jobSpent {
+		if _, ok := jobSpent[jobID]; !ok {
+			b.reportSpent("job", strconv.Itoa(int(jobID)), budgetSpent{}, b.cfg.JobHourly(), b.cfg.JobDaily())
+		}
+	}
+	for addr, spent := range keySpent {
+		b.reportSpent("key", addr.String(), spent, b.cfg.KeyHourly(), b.cfg.KeyDaily())
+	}
+	for jobID, spent := range jobSpent {
+		b.reportSpent("job", strconv.Itoa(int(jobID)), spent, b.cfg.JobHourly(), b.cfg.JobDaily())
+	}
+	b.keySpent, b.jobSpent = keySpent, jobSpent
+	return nil
+}
+
+func addBudgetSpent(spent budgetSpent, fee *big.Int, inHour bool) budgetSpent {
+	if spent.daily == nil {
+		spent = budgetSpent{hourly: new(big.Int), daily: new(big.Int)}
+	}
+	spent.daily.Add(spent.daily, fee)
+	if inHour {
+		spent.hourly.Add(spent.hourly, fee)
+	}
+	return spent
+}
+
+// reportSpent sets the spent metrics, and alerts once when a key or job exhausts its budget
+func (b *GasBudget) reportSpent(subject, id string, spent budgetSpent, hourly, daily *assets.Wei) {
+	if spent.daily == nil {
+		spent = budgetSpent{hourly: new(big.Int), daily: new(big.Int)}
+	}
+	hourlySpent, _ := new(big.Float).SetInt(spent.hourly).Float64()
+	dailySpent, _ := new(big.Float).SetInt(spent.daily).Float64()
+	promGasBudgetSpent.WithLabelValues(b.chainID.String(), subject, id, hourlyWindow).Set(hourlySpent)
+	promGasBudgetSpent.WithLabelValues(b.chainID.String(), subject, id, dailyWindow).Set(dailySpent)
+
+	name := subject + " " + id
+	err := checkBudgetSpent(name, spent, hourly, daily)
+	_, wasExhausted := b.exhausted[name]
+	switch {
+	case err != nil && !wasExhausted:
+		b.exhausted[name] = struct{}{}
+		b.lggr.Criticalw(fmt.Sprintf("Gas budget exhausted, new transactions of the %s are %s", subject, budgetAction(b.cfg.Mode())),
+			"subject", subject, "id", id, "err", err)
+	case err == nil && wasExhausted:
+		delete(b.exhausted, name)
+		b.lggr.Infow("Gas budget replenished", "subject", subject, "id", id)
+	}
+}
+
+func budgetAction(mode string) string {
+	if mode == "Defer" {
+		return "deferred"
+	}
+	return "rejected"
+}
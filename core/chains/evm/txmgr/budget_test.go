@@ -0,0 +1,112 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+)
+
+type testTxBudgetConfig struct {
+	mode                string
+	keyHourly, keyDaily *assets.Wei
+	jobHourly, jobDaily *assets.Wei
+}
+
+func (c *testTxBudgetConfig) Enabled() bool          { return true }
+func (c *testTxBudgetConfig) Mode() string           { return c.mode }
+func (c *testTxBudgetConfig) KeyHourly() *assets.Wei { return c.keyHourly }
+func (c *testTxBudgetConfig) KeyDaily() *assets.Wei  { return c.keyDaily }
+func (c *testTxBudgetConfig) JobHourly() *assets.Wei { return c.jobHourly }
+func (c *testTxBudgetConfig) JobDaily() *assets.Wei  { return c.jobDaily }
+
+func TestGasBudget_CheckBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	now := time.Now()
+	fromAddress := testutils.NewAddress()
+	otherAddress := testutils.NewAddress()
+	jobID, otherJobID := int32(7), int32(8)
+
+	cfg := &testTxBudgetConfig{
+		mode:      "Reject",
+		keyHourly: assets.NewWeiI(100),
+		keyDaily:  assets.NewWeiI(300),
+		jobHourly: assets.NewWeiI(50),
+		jobDaily:  assets.NewWeiI(0),
+	}
+	txStore := mocks.NewEvmTxStore(t)
+	budget := txmgr.NewGasBudget(logger.Test(t), testutils.FixtureChainID, cfg, txStore)
+
+	spends := []txmgr.GasSpend{
+		{TxID: 1, CreatedAt: now.Add(-23 * time.Hour), FromAddress: fromAddress, Fee: big.NewInt(250)},
+		{TxID: 2, CreatedAt: now.Add(-10 * time.Minute), FromAddress: otherAddress, JobID: &jobID, Fee: big.NewInt(60)},
+		{TxID: 3, CreatedAt: now.Add(-5 * time.Minute), FromAddress: otherAddress, JobID: &otherJobID, Fee: big.NewInt(30)},
+	}
+	txStore.On("FindGasSpendsSince", mock.Anything, now.Add(-24*time.Hour), testutils.FixtureChainID).Return(spends, nil).Once()
+	require.NoError(t, budget.Refresh(ctx, now))
+
+	t.Run("rejects txes of a key exceeding its daily budget", func(t *testing.T) {
+		require.NoError(t, budget.CheckBudget(fromAddress, nil))
+
+		spends = append(spends, txmgr.GasSpend{TxID: 4, CreatedAt: now.Add(-time.Minute), FromAddress: fromAddress, Fee: big.NewInt(50)})
+		txStore.On("FindGasSpendsSince", mock.Anything, now.Add(-24*time.Hour), testutils.FixtureChainID).Return(spends, nil).Once()
+		require.NoError(t, budget.Refresh(ctx, now))
+
+		err := budget.CheckBudget(fromAddress, nil)
+		require.ErrorIs(t, err, txmgrtypes.ErrTxBudgetExhausted)
+		assert.Contains(t, err.Error(), "daily budget")
+	})
+
+	t.Run("rejects txes of a job exceeding its hourly budget", func(t *testing.T) {
+		err := budget.CheckBudget(otherAddress, &jobID)
+		require.ErrorIs(t, err, txmgrtypes.ErrTxBudgetExhausted)
+		assert.Contains(t, err.Error(), "job 7")
+		assert.Contains(t, err.Error(), "hourly budget")
+
+		require.NoError(t, budget.CheckBudget(otherAddress, &otherJobID))
+		require.NoError(t, budget.CheckBudget(otherAddress, nil))
+	})
+
+	t.Run("stops counting the spends dropped by a re-org", func(t *testing.T) {
+		txStore.On("FindGasSpendsSince", mock.Anything, now.Add(-24*time.Hour), testutils.FixtureChainID).Return(spends[:1], nil).Once()
+		require.NoError(t, budget.Refresh(ctx, now))
+		require.NoError(t, budget.CheckBudget(fromAddress, nil))
+		require.NoError(t, budget.CheckBudget(otherAddress, &jobID))
+
+		txStore.On("FindGasSpendsSince", mock.Anything, now.Add(-24*time.Hour), testutils.FixtureChainID).Return(spends, nil).Once()
+		require.NoError(t, budget.Refresh(ctx, now))
+		require.ErrorIs(t, budget.CheckBudget(fromAddress, nil), txmgrtypes.ErrTxBudgetExhausted)
+	})
+
+	t.Run("replenishes the budget as spends leave the window", func(t *testing.T) {
+		// the oldest spend is no longer saved after the start of the daily window
+		txStore.On("FindGasSpendsSince", mock.Anything, now.Add(-22*time.Hour), testutils.FixtureChainID).Return(spends[1:], nil).Once()
+
+		require.NoError(t, budget.Refresh(ctx, now.Add(2*time.Hour)))
+		require.NoError(t, budget.CheckBudget(otherAddress, &jobID))
+		require.NoError(t, budget.CheckBudget(fromAddress, nil), "the oldest spend left the daily window")
+	})
+
+	assert.False(t, budget.DeferExhausted())
+}
+
+func TestGasBudget_DeferExhausted(t *testing.T) {
+	t.Parallel()
+
+	budget := txmgr.NewGasBudget(logger.Test(t), testutils.FixtureChainID, &testTxBudgetConfig{mode: "Defer"}, mocks.NewEvmTxStore(t))
+	assert.True(t, budget.DeferExhausted())
+}
@@ -65,8 +65,11 @@ func NewTxm(
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
-	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
-	return txm, nil
+	evmTxm := NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
+	if txConfig.Budget().Enabled() {
+		evmTxm.SetTxBudget(NewGasBudget(lggr, chainID, txConfig.Budget(), txStore))
+	}
+	return evmTxm, nil
 }
 
 // NewEvmTxm creates a new concrete EvmTxm
@@ -101,6 +101,11 @@ func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, at
 		lggr.Criticalw("Fatal error signing transaction", "err", err, "etx", etx)
 		return commonclient.Fatal, err
 	}
+	signedTx, err = txForBroadcast(signedTx, etx)
+	if err != nil {
+		lggr.Criticalw("Fatal error attaching blob sidecar to transaction", "err", err, "etx", etx)
+		return commonclient.Fatal, err
+	}
 	return c.client.SendTransactionReturnCode(ctx, signedTx, etx.FromAddress)
 }
 
@@ -181,7 +186,11 @@ func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumbe
 		Data:       a.Tx.EncodedPayload,
 		AccessList: nil,
 	}, blockNumber)
-	return client.ExtractRPCError(errCall)
+	jErr, err := client.ExtractRPCError(errCall)
+	if err != nil {
+		return nil, err
+	}
+	return &revertError{JsonError: jErr, reason: defaultRevertReasonDecoder().Decode(*jErr)}, nil
 }
 
 func (c *evmTxmClient) HeadByHash(ctx context.Context, hash common.Hash) (*evmtypes.Head, error) {
@@ -45,6 +45,10 @@ func batchSendTransactions(
 		if decodeErr != nil {
 			return reqs, now, successfulBroadcast, fmt.Errorf("failed to decode signed raw tx into Transaction object: %w", decodeErr)
 		}
+		signedTx, decodeErr = txForBroadcast(signedTx, attempt.Tx)
+		if decodeErr != nil {
+			return reqs, now, successfulBroadcast, fmt.Errorf("failed to attach blob sidecar: %w", decodeErr)
+		}
 		// Get the canonical encoding of the Transaction object needed for the eth_sendRawTransaction request
 		// The signed raw tx cannot be used directly because it uses a different encoding
 		txBytes, marshalErr := signedTx.MarshalBinary()
@@ -602,6 +602,43 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 		// Check receipts
 		require.Len(t, attempt5_1.Receipts, 1)
 	})
+
+	etx6 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, nonce, fromAddress)
+	attempt6_1 := etx6.TxAttempts[0]
+	nonce++
+
+	t.Run("stores the revert reason of a reverted transaction", func(t *testing.T) {
+		txmReceipt := evmtypes.Receipt{
+			TxHash:           attempt6_1.Hash,
+			BlockHash:        testutils.NewHash(),
+			BlockNumber:      big.NewInt(42),
+			TransactionIndex: uint(1),
+			Status:           uint64(0),
+		}
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 &&
+				cltest.BatchElemMatchesParams(b[0], attempt6_1.Hash, "eth_getTransactionReceipt")
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			*(elems[0].Result.(*evmtypes.Receipt)) = txmReceipt
+		}).Once()
+		data, err := utils.ABIEncode(`[{"type":"string"}]`, "subscription not found")
+		require.NoError(t, err)
+		sig := utils.Keccak256Fixed([]byte(`Error(string)`))
+		ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(nil, &client.JsonError{
+			Code:    3,
+			Message: "execution reverted",
+			Data:    hexutil.Encode(utils.ConcatBytes(sig[:4], data)),
+		}).Once()
+
+		require.NoError(t, ec.CheckForReceipts(ctx, blockNum, latestFinalizedBlockNum))
+
+		etx6, err = txStore.FindTxWithAttempts(ctx, etx6.ID)
+		require.NoError(t, err)
+		require.Len(t, etx6.TxAttempts[0].Receipts, 1)
+		require.True(t, etx6.RevertReason.Valid)
+		assert.Equal(t, "subscription not found", etx6.RevertReason.String)
+	})
 }
 
 func TestEthConfirmer_CheckForReceipts_batching(t *testing.T) {
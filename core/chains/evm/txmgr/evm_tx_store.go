@@ -48,6 +48,7 @@ type EvmTxStore interface {
 	// methods used solely in EVM components
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []Receipt, err error)
 	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error
+	FindGasSpendsSince(ctx context.Context, since time.Time, chainID *big.Int) (spends []GasSpend, err error)
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers, readers, or evm specific components
@@ -199,6 +200,9 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	Priority          int32
+	RevertReason      nullv4.String
+	BlobSidecar       []byte
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -221,6 +225,9 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Priority = tx.Priority
+	db.RevertReason = tx.RevertReason
+	db.BlobSidecar = tx.BlobSidecar
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -256,6 +263,9 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Priority = db.Priority
+	tx.RevertReason = db.RevertReason
+	tx.BlobSidecar = db.BlobSidecar
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -289,6 +299,7 @@ type DbEthTxAttempt struct {
 	GasTipCap               *assets.Wei
 	GasFeeCap               *assets.Wei
 	IsPurgeAttempt          bool
+	BlobFeeCap              *assets.Wei
 }
 
 func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
@@ -304,6 +315,7 @@ func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
 	db.GasTipCap = attempt.TxFee.DynamicTipCap
 	db.GasFeeCap = attempt.TxFee.DynamicFeeCap
 	db.IsPurgeAttempt = attempt.IsPurgeAttempt
+	db.BlobFeeCap = attempt.TxFee.BlobFeeCap
 
 	// handle state naming difference between generic + EVM
 	if attempt.State == txmgrtypes.TxAttemptInsufficientFunds {
@@ -334,6 +346,7 @@ func (db DbEthTxAttempt) ToTxAttempt(attempt *TxAttempt) {
 		Legacy:        db.GasPrice,
 		DynamicTipCap: db.GasTipCap,
 		DynamicFeeCap: db.GasFeeCap,
+		BlobFeeCap:    db.BlobFeeCap,
 	}
 	attempt.IsPurgeAttempt = db.IsPurgeAttempt
 }
@@ -359,8 +372,8 @@ func NewTxStore(
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
+INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt, blob_fee_cap)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt, :blob_fee_cap)
 RETURNING *;
 `
 
@@ -531,8 +544,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, priority, blob_sidecar) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :priority, :blob_sidecar
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -776,9 +789,14 @@ WHERE evm.tx_attempts.state <> 'in_progress' AND evm.txes.broadcast_at <= $1 AND
 ORDER BY evm.txes.nonce ASC, evm.tx_attempts.gas_price DESC, evm.tx_attempts.gas_tip_cap DESC
 LIMIT $4
 `, olderThan, chainID.String(), address, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "FindEthTxAttemptsRequiringResend failed to load evm.tx_attempts")
+	}
 
 	attempts = dbEthTxAttemptsToEthTxAttempts(dbAttempts)
-	return attempts, pkgerrors.Wrap(err, "FindEthTxAttemptsRequiringResend failed to load evm.tx_attempts")
+	// the txes are needed to resend blob txes with their sidecars
+	err = o.preloadTxesAtomic(ctx, attempts)
+	return attempts, pkgerrors.Wrap(err, "FindEthTxAttemptsRequiringResend failed to load evm.txes")
 }
 
 func (o *evmTxStore) UpdateBroadcastAts(ctx context.Context, now time.Time, etxIDs []int64) error {
@@ -827,9 +845,11 @@ func (o *evmTxStore) FindTxAttemptsConfirmedMissingReceipt(ctx context.Context,
 		ORDER BY evm.tx_attempts.eth_tx_id ASC, evm.tx_attempts.gas_price DESC, evm.tx_attempts.gas_tip_cap DESC`,
 		chainID.String())
 	if err != nil {
-		err = pkgerrors.Wrap(err, "FindEtxAttemptsConfirmedMissingReceipt failed to query")
+		return nil, pkgerrors.Wrap(err, "FindEtxAttemptsConfirmedMissingReceipt failed to query")
 	}
 	attempts = dbEthTxAttemptsToEthTxAttempts(dbAttempts)
+	// the txes are needed to rebroadcast blob txes with their sidecars
+	err = o.preloadTxesAtomic(ctx, attempts)
 	return
 }
 
@@ -845,6 +865,18 @@ func (o *evmTxStore) UpdateTxsUnconfirmed(ctx context.Context, ids []int64) erro
 	return nil
 }
 
+// UpdateTxRevertReason stores the decoded reason of an on-chain revert of the tx
+func (o *evmTxStore) UpdateTxRevertReason(ctx context.Context, id int64, revertReason string) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	_, err := o.q.ExecContext(ctx, `UPDATE evm.txes SET revert_reason = $1 WHERE id = $2`, revertReason, id)
+	if err != nil {
+		return pkgerrors.Wrap(err, "UpdateTxRevertReason failed to execute")
+	}
+	return nil
+}
+
 func (o *evmTxStore) FindTxAttemptsRequiringReceiptFetch(ctx context.Context, chainID *big.Int) (attempts []TxAttempt, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1097,6 +1129,52 @@ func (o *evmTxStore) FindLatestSequence(ctx context.Context, fromAddress common.
 	return
 }
 
+// FindSequenceGaps returns the nonces between from and the latest nonce of the address, which are not used by any transaction
+func (o *evmTxStore) FindSequenceGaps(ctx context.Context, fromAddress common.Address, chainID *big.Int, from evmtypes.Nonce) (nonces []evmtypes.Nonce, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	sql := `SELECT gaps.nonce FROM generate_series($3::bigint, (
+	SELECT MAX(nonce) FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2
+) AS gaps(nonce)
+WHERE NOT EXISTS (
+	SELECT 1 FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce = gaps.nonce
+)
+ORDER BY gaps.nonce ASC`
+	err = o.q.SelectContext(ctx, &nonces, sql, fromAddress, chainID.String(), from.Int64())
+	return
+}
+
+// RewindSequences moves the transactions of the address with a nonce of at least from, which were never broadcast,
+// back to unstarted, and returns their IDs. These are the in-progress transactions, and unconfirmed ones without a
+// broadcast attempt. Broadcast transactions keep their nonce, as they might still be mined.
+func (o *evmTxStore) RewindSequences(ctx context.Context, fromAddress common.Address, chainID *big.Int, from evmtypes.Nonce) (ids []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		if err = orm.q.SelectContext(ctx, &ids, `SELECT id FROM evm.txes
+WHERE from_address = $1 AND evm_chain_id = $2 AND nonce >= $3 AND (state = 'in_progress' OR (state = 'unconfirmed' AND NOT EXISTS (
+	SELECT 1 FROM evm.tx_attempts WHERE evm.tx_attempts.eth_tx_id = evm.txes.id AND evm.tx_attempts.state = 'broadcast'
+)))
+ORDER BY nonce ASC FOR UPDATE`, fromAddress, chainID.String(), from.Int64()); err != nil {
+			return fmt.Errorf("RewindSequences failed to load txes: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if _, err = orm.q.ExecContext(ctx, `DELETE FROM evm.tx_attempts WHERE eth_tx_id = ANY($1)`, pq.Array(ids)); err != nil {
+			return fmt.Errorf("RewindSequences failed to delete attempts: %w", err)
+		}
+		if _, err = orm.q.ExecContext(ctx, `UPDATE evm.txes SET state = 'unstarted', nonce = NULL, broadcast_at = NULL, initial_broadcast_at = NULL
+WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+			return fmt.Errorf("RewindSequences failed to update txes: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
 // FindTxWithIdempotencyKey returns any broadcast ethtx with the given idempotencyKey and chainID
 func (o *evmTxStore) FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string, chainID *big.Int) (etx *Tx, err error) {
 	var cancel context.CancelFunc
@@ -1590,13 +1668,13 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAt
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
+// Finds the saved transaction with the highest priority that has yet to be broadcast from the given address
 func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1606,6 +1684,21 @@ func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context
 	return etx, nil
 }
 
+// Finds the saved transactions with the highest priority that have yet to be broadcast from the given address
+func (o *evmTxStore) FindUnstartedTransactionsFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit uint32) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEtxs []DbEthTx
+	err = o.q.SelectContext(ctx, &dbEtxs, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC LIMIT $3`, fromAddress, chainID.String(), limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to FindUnstartedTransactionsFromAddress")
+	}
+	etxs = make([]*Tx, len(dbEtxs))
+	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+	return etxs, nil
+}
+
 func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1626,7 +1719,7 @@ func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 		}
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
+		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL, blob_sidecar=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 		dbEtx.ToTx(etx)
 		return err
 	})
@@ -1850,12 +1943,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, priority, blob_sidecar)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14,$15
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txRequest.Priority, txRequest.BlobSidecar)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -2133,7 +2226,7 @@ func (o *evmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Contex
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	sql := `
-UPDATE evm.txes SET state = 'finalized' WHERE evm.txes.evm_chain_id = $1 AND evm.txes.id IN (SELECT evm.txes.id FROM evm.txes
+UPDATE evm.txes SET state = 'finalized', blob_sidecar = NULL WHERE evm.txes.evm_chain_id = $1 AND evm.txes.id IN (SELECT evm.txes.id FROM evm.txes
 	INNER JOIN evm.tx_attempts ON evm.tx_attempts.eth_tx_id = evm.txes.id
 	INNER JOIN evm.receipts ON evm.receipts.tx_hash = evm.tx_attempts.hash
 	WHERE evm.receipts.id = ANY($2))
@@ -2141,3 +2234,71 @@ UPDATE evm.txes SET state = 'finalized' WHERE evm.txes.evm_chain_id = $1 AND evm
 	_, err := o.q.ExecContext(ctx, sql, chainId.String(), pq.Array(receiptIDs))
 	return err
 }
+
+// GasSpend is the fee paid for a mined tx, attributed to its key and job
+type GasSpend struct {
+	TxID        int64
+	CreatedAt   time.Time
+	FromAddress common.Address
+	JobID       *int32
+	Fee         *big.Int
+}
+
+type dbGasSpend struct {
+	ID          int64
+	CreatedAt   time.Time
+	Receipt     evmtypes.Receipt
+	FromAddress common.Address
+	JobID       sql.NullInt32
+	GasPrice    *assets.Wei
+	GasFeeCap   *assets.Wei
+}
+
+// FindGasSpendsSince returns the fees paid for txes whose receipts were saved after the given time, ordered by tx ID.
+// A tx mined again after a re-org is only counted once, at its latest receipt, and the receipts deleted by a re-org
+// are no longer counted. The fee is priced at the effective gas price of the receipt, or at the price of the mined
+// attempt for receipts saved without one, plus the L1 data fee of the receipt on rollups reporting it.
+func (o *evmTxStore) FindGasSpendsSince(ctx context.Context, since time.Time, chainID *big.Int) (spends []GasSpend, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+
+	var rows []dbGasSpend
+	query := `SELECT DISTINCT ON (evm.txes.id) evm.txes.id, evm.receipts.created_at, evm.receipts.receipt, evm.txes.from_address,
+		(evm.txes.meta->>'JobID')::int AS job_id, evm.tx_attempts.gas_price, evm.tx_attempts.gas_fee_cap FROM evm.receipts
+		INNER JOIN evm.tx_attempts ON evm.tx_attempts.hash = evm.receipts.tx_hash
+		INNER JOIN evm.txes ON evm.txes.id = evm.tx_attempts.eth_tx_id
+		WHERE evm.receipts.created_at > $1 AND evm.txes.evm_chain_id = $2
+		ORDER BY evm.txes.id, evm.receipts.block_number DESC, evm.receipts.id DESC`
+	if err = o.q.SelectContext(ctx, &rows, query, since, chainID.String()); err != nil {
+		return nil, fmt.Errorf("FindGasSpendsSince failed to load evm.receipts: %w", err)
+	}
+
+	spends = make([]GasSpend, len(rows))
+	for i, r := range rows {
+		price := r.Receipt.EffectiveGasPrice
+		if price == nil && r.GasPrice != nil {
+			price = r.GasPrice.ToInt()
+		} else if price == nil && r.GasFeeCap != nil {
+			price = r.GasFeeCap.ToInt()
+		}
+		fee := new(big.Int)
+		if price != nil {
+			fee.Mul(price, new(big.Int).SetUint64(r.Receipt.GasUsed))
+		}
+		if r.Receipt.L1Fee != nil {
+			fee.Add(fee, r.Receipt.L1Fee)
+		}
+		spends[i] = GasSpend{
+			TxID:        r.ID,
+			CreatedAt:   r.CreatedAt,
+			FromAddress: r.FromAddress,
+			Fee:         fee,
+		}
+		if r.JobID.Valid {
+			jobID := r.JobID.Int32
+			spends[i].JobID = &jobID
+		}
+	}
+	return spends, nil
+}
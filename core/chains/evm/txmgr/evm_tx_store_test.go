@@ -479,6 +479,25 @@ func TestORM_UpdateTxsUnconfirmed(t *testing.T) {
 	assert.Equal(t, etx0.State, txmgrcommon.TxUnconfirmed)
 }
 
+func TestORM_UpdateTxRevertReason(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	etx := mustInsertConfirmedEthTxWithReceipt(t, txStore, fromAddress, 0, 1)
+	assert.False(t, etx.RevertReason.Valid)
+	require.NoError(t, txStore.UpdateTxRevertReason(ctx, etx.ID, "InvalidConsumer(7, 0x0000000000000000000000000000000000000aBc)"))
+
+	etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	require.True(t, etx.RevertReason.Valid)
+	assert.Equal(t, "InvalidConsumer(7, 0x0000000000000000000000000000000000000aBc)", etx.RevertReason.String)
+}
+
 func TestORM_FindTxAttemptsRequiringReceiptFetch(t *testing.T) {
 	t.Parallel()
 
@@ -1255,6 +1274,129 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 	})
 }
 
+func TestORM_FindUnstartedTransactionsFromAddress(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	t.Run("cannot find unstarted txs", func(t *testing.T) {
+		mustInsertInProgressEthTxWithAttempt(t, txStore, 13, fromAddress)
+
+		etxs, err := txStore.FindUnstartedTransactionsFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), 10)
+		require.NoError(t, err)
+		assert.Empty(t, etxs)
+	})
+
+	t.Run("finds unstarted txs up to limit", func(t *testing.T) {
+		etx1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		etx2 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+
+		etxs, err := txStore.FindUnstartedTransactionsFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), 2)
+		require.NoError(t, err)
+		require.Len(t, etxs, 2)
+		assert.Equal(t, etx1.ID, etxs[0].ID)
+		assert.Equal(t, etx2.ID, etxs[1].ID)
+	})
+
+	t.Run("finds unstarted txs with higher priority first", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		lowTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityLow))
+		defaultTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		highTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityHigh))
+
+		etxs, err := txStore.FindUnstartedTransactionsFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), 10)
+		require.NoError(t, err)
+		require.Len(t, etxs, 3)
+		assert.Equal(t, highTx.ID, etxs[0].ID)
+		assert.Equal(t, defaultTx.ID, etxs[1].ID)
+		assert.Equal(t, lowTx.ID, etxs[2].ID)
+
+		etx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, highTx.ID, etx.ID)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, etx.Priority)
+	})
+}
+
+func TestORM_FindSequenceGaps(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	t.Run("finds no gaps without txes", func(t *testing.T) {
+		gaps, err := txStore.FindSequenceGaps(ctx, fromAddress, testutils.FixtureChainID, 0)
+		require.NoError(t, err)
+		assert.Empty(t, gaps)
+	})
+
+	mustInsertConfirmedEthTxWithReceipt(t, txStore, fromAddress, 0, 1)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 5, fromAddress)
+
+	t.Run("finds unused nonces up to the latest one", func(t *testing.T) {
+		gaps, err := txStore.FindSequenceGaps(ctx, fromAddress, testutils.FixtureChainID, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []evmtypes.Nonce{1, 3, 4}, gaps)
+
+		gaps, err = txStore.FindSequenceGaps(ctx, fromAddress, testutils.FixtureChainID, 4)
+		require.NoError(t, err)
+		assert.Equal(t, []evmtypes.Nonce{4}, gaps)
+	})
+}
+
+func TestORM_RewindSequences(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	confirmed := mustInsertConfirmedEthTxWithReceipt(t, txStore, fromAddress, 0, 1)
+	below := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+	broadcast := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+	inProgress := mustInsertInProgressEthTxWithAttempt(t, txStore, 4, fromAddress)
+
+	ids, err := txStore.RewindSequences(ctx, fromAddress, testutils.FixtureChainID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{inProgress.ID}, ids)
+
+	for _, id := range ids {
+		etx, err := txStore.FindTxWithAttempts(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+		assert.Nil(t, etx.Sequence)
+		assert.Nil(t, etx.BroadcastAt)
+		assert.Empty(t, etx.TxAttempts)
+	}
+	for _, id := range []int64{confirmed.ID, below.ID} {
+		etx, err := txStore.FindTxWithAttempts(ctx, id)
+		require.NoError(t, err)
+		assert.NotEqual(t, txmgrcommon.TxUnstarted, etx.State)
+		assert.NotNil(t, etx.Sequence)
+	}
+
+	// an unconfirmed transaction with a broadcast attempt might still be mined, so it keeps its nonce
+	etx, err := txStore.FindTxWithAttempts(ctx, broadcast.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	require.NotNil(t, etx.Sequence)
+	assert.Equal(t, evmtypes.Nonce(3), *etx.Sequence)
+	require.Len(t, etx.TxAttempts, 1)
+	assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[0].State)
+}
+
 func TestORM_UpdateTxFatalError(t *testing.T) {
 	t.Parallel()
 
@@ -1277,6 +1419,18 @@ func TestORM_UpdateTxFatalError(t *testing.T) {
 		assert.Len(t, etx.TxAttempts, 0)
 		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
 	})
+
+	t.Run("clears blob sidecar", func(t *testing.T) {
+		etx := mustInsertInProgressEthTxWithAttempt(t, txStore, 14, fromAddress)
+		_, err := db.ExecContext(ctx, `UPDATE evm.txes SET blob_sidecar = $1 WHERE id = $2`, []byte{1, 2, 3}, etx.ID)
+		require.NoError(t, err)
+		etx.Error = null.StringFrom("blob tx failed")
+
+		require.NoError(t, txStore.UpdateTxFatalError(ctx, &etx))
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Nil(t, etx.BlobSidecar)
+	})
 }
 
 func TestORM_UpdateTxAttemptInProgressToBroadcast(t *testing.T) {
@@ -1715,6 +1869,27 @@ func TestORM_CreateTransaction(t *testing.T) {
 
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 
+	t.Run("inserts eth_tx with blob sidecar", func(t *testing.T) {
+		sidecar, err := txmgr.NewBlobSidecar([][]byte{{1, 2, 3}})
+		require.NoError(t, err)
+		etx, err := txStore.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			FeeLimit:       gasLimit,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			BlobSidecar:    sidecar,
+		}, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, sidecar, etx.BlobSidecar)
+
+		var dbEthTx txmgr.DbEthTx
+		require.NoError(t, db.Get(&dbEthTx, `SELECT * FROM evm.txes WHERE id = $1`, etx.ID))
+		assert.Equal(t, sidecar, dbEthTx.BlobSidecar)
+		_, err = db.Exec(`DELETE FROM evm.txes WHERE id = $1`, etx.ID)
+		require.NoError(t, err)
+	})
+
 	t.Run("with queue under capacity inserts eth_tx", func(t *testing.T) {
 		subject := uuid.New()
 		strategy := newMockTxStrategy(t)
@@ -1913,3 +2088,89 @@ func TestORM_UpdateTxesFinalized(t *testing.T) {
 		require.Equal(t, txmgrcommon.TxFinalized, etx.State)
 	})
 }
+
+func TestORM_FindGasSpendsSince(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	kst := cltest.NewKeyStore(t, db)
+	broadcast := time.Now()
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth())
+
+	mustInsertMinedTx := func(nonce evmtypes.Nonce, meta *sqlutil.JSON, gasUsed uint64, effectiveGasPrice *big.Int) (int64, common.Hash) {
+		tx := &txmgr.Tx{
+			Sequence:           &nonce,
+			FromAddress:        fromAddress,
+			EncodedPayload:     []byte{1, 2, 3},
+			State:              txmgrcommon.TxConfirmed,
+			BroadcastAt:        &broadcast,
+			InitialBroadcastAt: &broadcast,
+			Meta:               meta,
+		}
+		require.NoError(t, txStore.InsertTx(ctx, tx))
+		attempt := newBroadcastLegacyEthTxAttempt(t, tx.ID, 10)
+		require.NoError(t, txStore.InsertTxAttempt(ctx, &attempt))
+		receipt := newTxReceipt(attempt.Hash, 100, 0)
+		receipt.GasUsed = gasUsed
+		receipt.EffectiveGasPrice = effectiveGasPrice
+		_, err := txStore.InsertReceipt(ctx, &receipt)
+		require.NoError(t, err)
+		return tx.ID, attempt.Hash
+	}
+
+	jobMeta := sqlutil.JSON(`{"JobID":7}`)
+	firstID, _ := mustInsertMinedTx(0, &jobMeta, 21_000, nil)
+	secondID, secondHash := mustInsertMinedTx(1, nil, 100, big.NewInt(3))
+
+	t.Run("prices receipts at their effective gas price, or at the price of their attempt", func(t *testing.T) {
+		spends, err := txStore.FindGasSpendsSince(ctx, broadcast.Add(-time.Hour), testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, spends, 2)
+
+		assert.Equal(t, firstID, spends[0].TxID)
+		assert.Equal(t, fromAddress, spends[0].FromAddress)
+		require.NotNil(t, spends[0].JobID)
+		assert.Equal(t, int32(7), *spends[0].JobID)
+		assert.Equal(t, big.NewInt(210_000), spends[0].Fee)
+
+		assert.Equal(t, secondID, spends[1].TxID)
+		assert.Nil(t, spends[1].JobID)
+		assert.Equal(t, big.NewInt(300), spends[1].Fee)
+	})
+
+	t.Run("counts a tx mined again after a re-org once, at its latest receipt", func(t *testing.T) {
+		receipt := newTxReceipt(secondHash, 101, 0)
+		receipt.GasUsed = 100
+		receipt.EffectiveGasPrice = big.NewInt(4)
+		_, err := txStore.InsertReceipt(ctx, &receipt)
+		require.NoError(t, err)
+
+		spends, err := txStore.FindGasSpendsSince(ctx, broadcast.Add(-time.Hour), testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, spends, 2)
+		assert.Equal(t, secondID, spends[1].TxID)
+		assert.Equal(t, big.NewInt(400), spends[1].Fee)
+	})
+
+	t.Run("adds the L1 data fee of the receipt", func(t *testing.T) {
+		receipt := newTxReceipt(secondHash, 102, 0)
+		receipt.GasUsed = 100
+		receipt.EffectiveGasPrice = big.NewInt(4)
+		receipt.L1Fee = big.NewInt(50)
+		_, err := txStore.InsertReceipt(ctx, &receipt)
+		require.NoError(t, err)
+
+		spends, err := txStore.FindGasSpendsSince(ctx, broadcast.Add(-time.Hour), testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, spends, 2)
+		assert.Equal(t, big.NewInt(450), spends[1].Fee)
+	})
+
+	t.Run("skips receipts saved before the given time", func(t *testing.T) {
+		spends, err := txStore.FindGasSpendsSince(ctx, time.Now().Add(time.Hour), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Empty(t, spends)
+	})
+}
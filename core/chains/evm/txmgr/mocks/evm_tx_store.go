@@ -620,6 +620,66 @@ func (_c *EvmTxStore_FindEarliestUnconfirmedTxAttemptBlock_Call) RunAndReturn(ru
 	return _c
 }
 
+// FindGasSpendsSince provides a mock function with given fields: ctx, since, chainID
+func (_m *EvmTxStore) FindGasSpendsSince(ctx context.Context, since time.Time, chainID *big.Int) ([]txmgr.GasSpend, error) {
+	ret := _m.Called(ctx, since, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindGasSpendsSince")
+	}
+
+	var r0 []txmgr.GasSpend
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, *big.Int) ([]txmgr.GasSpend, error)); ok {
+		return rf(ctx, since, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, *big.Int) []txmgr.GasSpend); ok {
+		r0 = rf(ctx, since, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.GasSpend)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, *big.Int) error); ok {
+		r1 = rf(ctx, since, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindGasSpendsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindGasSpendsSince'
+type EvmTxStore_FindGasSpendsSince_Call struct {
+	*mock.Call
+}
+
+// FindGasSpendsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindGasSpendsSince(ctx interface{}, since interface{}, chainID interface{}) *EvmTxStore_FindGasSpendsSince_Call {
+	return &EvmTxStore_FindGasSpendsSince_Call{Call: _e.mock.On("FindGasSpendsSince", ctx, since, chainID)}
+}
+
+func (_c *EvmTxStore_FindGasSpendsSince_Call) Run(run func(ctx context.Context, since time.Time, chainID *big.Int)) *EvmTxStore_FindGasSpendsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindGasSpendsSince_Call) Return(_a0 []txmgr.GasSpend, _a1 error) *EvmTxStore_FindGasSpendsSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindGasSpendsSince_Call) RunAndReturn(run func(context.Context, time.Time, *big.Int) ([]txmgr.GasSpend, error)) *EvmTxStore_FindGasSpendsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLatestSequence provides a mock function with given fields: ctx, fromAddress, chainId
 func (_m *EvmTxStore) FindLatestSequence(ctx context.Context, fromAddress common.Address, chainId *big.Int) (evmtypes.Nonce, error) {
 	ret := _m.Called(ctx, fromAddress, chainId)
@@ -738,6 +798,67 @@ func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(
 	return _c
 }
 
+// FindSequenceGaps provides a mock function with given fields: ctx, fromAddress, chainID, from
+func (_m *EvmTxStore) FindSequenceGaps(ctx context.Context, fromAddress common.Address, chainID *big.Int, from evmtypes.Nonce) ([]evmtypes.Nonce, error) {
+	ret := _m.Called(ctx, fromAddress, chainID, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSequenceGaps")
+	}
+
+	var r0 []evmtypes.Nonce
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) ([]evmtypes.Nonce, error)); ok {
+		return rf(ctx, fromAddress, chainID, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) []evmtypes.Nonce); ok {
+		r0 = rf(ctx, fromAddress, chainID, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]evmtypes.Nonce)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindSequenceGaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSequenceGaps'
+type EvmTxStore_FindSequenceGaps_Call struct {
+	*mock.Call
+}
+
+// FindSequenceGaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+//   - from evmtypes.Nonce
+func (_e *EvmTxStore_Expecter) FindSequenceGaps(ctx interface{}, fromAddress interface{}, chainID interface{}, from interface{}) *EvmTxStore_FindSequenceGaps_Call {
+	return &EvmTxStore_FindSequenceGaps_Call{Call: _e.mock.On("FindSequenceGaps", ctx, fromAddress, chainID, from)}
+}
+
+func (_c *EvmTxStore_FindSequenceGaps_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, from evmtypes.Nonce)) *EvmTxStore_FindSequenceGaps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(evmtypes.Nonce))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindSequenceGaps_Call) Return(_a0 []evmtypes.Nonce, _a1 error) *EvmTxStore_FindSequenceGaps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindSequenceGaps_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, evmtypes.Nonce) ([]evmtypes.Nonce, error)) *EvmTxStore_FindSequenceGaps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTransactionsConfirmedInBlockRange provides a mock function with given fields: ctx, highBlockNumber, lowBlockNumber, chainID
 func (_m *EvmTxStore) FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber int64, lowBlockNumber int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, highBlockNumber, lowBlockNumber, chainID)
@@ -1822,6 +1943,67 @@ func (_c *EvmTxStore_FindTxsRequiringResubmissionDueToInsufficientFunds_Call) Ru
 	return _c
 }
 
+// FindUnstartedTransactionsFromAddress provides a mock function with given fields: ctx, fromAddress, chainID, limit
+func (_m *EvmTxStore) FindUnstartedTransactionsFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit uint32) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, chainID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnstartedTransactionsFromAddress")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, uint32) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, chainID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, uint32) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, uint32) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindUnstartedTransactionsFromAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnstartedTransactionsFromAddress'
+type EvmTxStore_FindUnstartedTransactionsFromAddress_Call struct {
+	*mock.Call
+}
+
+// FindUnstartedTransactionsFromAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+//   - limit uint32
+func (_e *EvmTxStore_Expecter) FindUnstartedTransactionsFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}, limit interface{}) *EvmTxStore_FindUnstartedTransactionsFromAddress_Call {
+	return &EvmTxStore_FindUnstartedTransactionsFromAddress_Call{Call: _e.mock.On("FindUnstartedTransactionsFromAddress", ctx, fromAddress, chainID, limit)}
+}
+
+func (_c *EvmTxStore_FindUnstartedTransactionsFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit uint32)) *EvmTxStore_FindUnstartedTransactionsFromAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(uint32))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindUnstartedTransactionsFromAddress_Call) Return(_a0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], _a1 error) *EvmTxStore_FindUnstartedTransactionsFromAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindUnstartedTransactionsFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, uint32) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindUnstartedTransactionsFromAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAbandonedTransactionsByBatch provides a mock function with given fields: ctx, chainID, enabledAddrs, offset, limit
 func (_m *EvmTxStore) GetAbandonedTransactionsByBatch(ctx context.Context, chainID *big.Int, enabledAddrs []common.Address, offset uint, limit uint) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, chainID, enabledAddrs, offset, limit)
@@ -2418,6 +2600,67 @@ func (_c *EvmTxStore_ReapTxHistory_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// RewindSequences provides a mock function with given fields: ctx, fromAddress, chainID, from
+func (_m *EvmTxStore) RewindSequences(ctx context.Context, fromAddress common.Address, chainID *big.Int, from evmtypes.Nonce) ([]int64, error) {
+	ret := _m.Called(ctx, fromAddress, chainID, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RewindSequences")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) ([]int64, error)); ok {
+		return rf(ctx, fromAddress, chainID, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) []int64); ok {
+		r0 = rf(ctx, fromAddress, chainID, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_RewindSequences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RewindSequences'
+type EvmTxStore_RewindSequences_Call struct {
+	*mock.Call
+}
+
+// RewindSequences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+//   - from evmtypes.Nonce
+func (_e *EvmTxStore_Expecter) RewindSequences(ctx interface{}, fromAddress interface{}, chainID interface{}, from interface{}) *EvmTxStore_RewindSequences_Call {
+	return &EvmTxStore_RewindSequences_Call{Call: _e.mock.On("RewindSequences", ctx, fromAddress, chainID, from)}
+}
+
+func (_c *EvmTxStore_RewindSequences_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, from evmtypes.Nonce)) *EvmTxStore_RewindSequences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(evmtypes.Nonce))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_RewindSequences_Call) Return(_a0 []int64, _a1 error) *EvmTxStore_RewindSequences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_RewindSequences_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, evmtypes.Nonce) ([]int64, error)) *EvmTxStore_RewindSequences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveConfirmedMissingReceiptAttempt provides a mock function with given fields: ctx, timeout, attempt, broadcastAt
 func (_m *EvmTxStore) SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], broadcastAt time.Time) error {
 	ret := _m.Called(ctx, timeout, attempt, broadcastAt)
@@ -3199,6 +3442,54 @@ func (_c *EvmTxStore_UpdateTxForRebroadcast_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpdateTxRevertReason provides a mock function with given fields: ctx, id, revertReason
+func (_m *EvmTxStore) UpdateTxRevertReason(ctx context.Context, id int64, revertReason string) error {
+	ret := _m.Called(ctx, id, revertReason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxRevertReason")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, revertReason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_UpdateTxRevertReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxRevertReason'
+type EvmTxStore_UpdateTxRevertReason_Call struct {
+	*mock.Call
+}
+
+// UpdateTxRevertReason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - revertReason string
+func (_e *EvmTxStore_Expecter) UpdateTxRevertReason(ctx interface{}, id interface{}, revertReason interface{}) *EvmTxStore_UpdateTxRevertReason_Call {
+	return &EvmTxStore_UpdateTxRevertReason_Call{Call: _e.mock.On("UpdateTxRevertReason", ctx, id, revertReason)}
+}
+
+func (_c *EvmTxStore_UpdateTxRevertReason_Call) Run(run func(ctx context.Context, id int64, revertReason string)) *EvmTxStore_UpdateTxRevertReason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxRevertReason_Call) Return(_a0 error) *EvmTxStore_UpdateTxRevertReason_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxRevertReason_Call) RunAndReturn(run func(context.Context, int64, string) error) *EvmTxStore_UpdateTxRevertReason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTxStatesToFinalizedUsingReceiptIds provides a mock function with given fields: ctx, etxIDs, chainId
 func (_m *EvmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error {
 	ret := _m.Called(ctx, etxIDs, chainId)
@@ -43,6 +43,7 @@ type (
 	TransactionClient      = txmgrtypes.TransactionClient[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	ChainReceipt           = txmgrtypes.ChainReceipt[common.Hash, common.Hash]
 	Finalizer              = txmgrtypes.Finalizer[common.Hash, *evmtypes.Head]
+	NonceGapReport         = txmgr.SequenceGapReport[common.Address, evmtypes.Nonce]
 )
 
 var _ KeyStore = (keystore.Eth)(nil) // check interface in txmgr to avoid circular import
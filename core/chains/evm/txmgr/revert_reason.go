@@ -0,0 +1,103 @@
+package txmgr
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	pkgerrors "github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/functions/generated/functions_coordinator"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_automation_registry_master_wrapper_2_2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_automation_registry_master_wrapper_2_3"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_keeper_registry_master_wrapper_2_1"
+	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2_5"
+)
+
+// revertErrorsMetaData are the contracts, whose custom errors are decoded in revert reasons.
+var revertErrorsMetaData = []*bind.MetaData{
+	authorized_forwarder.AuthorizedForwarderMetaData,
+	functions_coordinator.FunctionsCoordinatorMetaData,
+	i_automation_registry_master_wrapper_2_2.IAutomationRegistryMasterMetaData,
+	i_automation_registry_master_wrapper_2_3.IAutomationRegistryMaster23MetaData,
+	i_keeper_registry_master_wrapper_2_1.IKeeperRegistryMasterMetaData,
+	v2.VRFCoordinatorV2MetaData,
+	vrf_coordinator_v2_5.VRFCoordinatorV25MetaData,
+}
+
+var defaultRevertReasonDecoder = sync.OnceValue(func() *RevertReasonDecoder {
+	d := NewRevertReasonDecoder()
+	for _, md := range revertErrorsMetaData {
+		// the wrappers are generated from valid ABIs
+		if err := d.RegisterMetaData(md); err != nil {
+			panic(err)
+		}
+	}
+	return d
+})
+
+// RevertReasonDecoder decodes the revert data of EVM calls. In addition to Error(string) and Panic(uint256), it
+// decodes the custom errors of registered ABIs.
+type RevertReasonDecoder struct {
+	mu     sync.RWMutex
+	errors map[[4]byte]abi.Error
+}
+
+func NewRevertReasonDecoder() *RevertReasonDecoder {
+	return &RevertReasonDecoder{errors: make(map[[4]byte]abi.Error)}
+}
+
+// RegisterMetaData registers the custom errors of a contract
+func (d *RevertReasonDecoder) RegisterMetaData(md *bind.MetaData) error {
+	parsed, err := md.GetAbi()
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to parse contract ABI")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range parsed.Errors {
+		d.errors[[4]byte(e.ID[:4])] = e
+	}
+	return nil
+}
+
+// Decode decodes the revert data of a call. Unknown custom errors are identified by their selector.
+func (d *RevertReasonDecoder) Decode(jErr evmclient.JsonError) string {
+	data := jErr.RevertData()
+	if len(data) < 4 {
+		return jErr.RevertReason()
+	}
+	d.mu.RLock()
+	e, ok := d.errors[[4]byte(data[:4])]
+	d.mu.RUnlock()
+	if !ok {
+		return jErr.RevertReason()
+	}
+	args, err := e.Unpack(data)
+	if err != nil {
+		return jErr.RevertReason()
+	}
+	var s []string
+	if values, ok := args.([]interface{}); ok {
+		for _, v := range values {
+			s = append(s, fmt.Sprint(v))
+		}
+	}
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(s, ", "))
+}
+
+// revertError is the RPC error of a reverted call, with its decoded revert reason
+type revertError struct {
+	*evmclient.JsonError
+	reason string
+}
+
+// RevertReason returns the decoded revert reason
+func (e *revertError) RevertReason() string {
+	return e.reason
+}
@@ -0,0 +1,49 @@
+package txmgr_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+)
+
+func TestRevertReasonDecoder_Decode(t *testing.T) {
+	t.Parallel()
+
+	d := txmgr.NewRevertReasonDecoder()
+	require.NoError(t, d.RegisterMetaData(v2.VRFCoordinatorV2MetaData))
+
+	parsed, err := v2.VRFCoordinatorV2MetaData.GetAbi()
+	require.NoError(t, err)
+	consumer := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	invalidConsumer := parsed.Errors["InvalidConsumer"]
+	args, err := invalidConsumer.Inputs.Pack(uint64(7), consumer)
+	require.NoError(t, err)
+	invalidConsumerData := hexutil.Encode(append(invalidConsumer.ID[:4:4], args...))
+	insufficientBalance := parsed.Errors["InsufficientBalance"]
+	insufficientBalanceData := hexutil.Encode(insufficientBalance.ID[:4])
+	errorString := "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f7420616c6c6f776564000000000000000000000000000000000000000000"
+
+	tests := []struct {
+		name   string
+		jErr   evmclient.JsonError
+		expect string
+	}{
+		{"registered custom error with args", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: invalidConsumerData}, "InvalidConsumer(7, " + consumer.Hex() + ")"},
+		{"registered custom error without args", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: insufficientBalanceData}, "InsufficientBalance()"},
+		{"Error(string)", evmclient.JsonError{Code: 3, Message: "execution reverted: not allowed", Data: errorString}, "not allowed"},
+		{"unknown custom error", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0x1425571c0000000000000000000000000000000000000000000000000000000000000001"}, "custom error 0x1425571c"},
+		{"no data", evmclient.JsonError{Code: 3, Message: "execution reverted"}, "execution reverted"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, d.Decode(test.jErr))
+		})
+	}
+}
@@ -2,15 +2,18 @@ package txmgr_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 )
 
@@ -50,3 +53,63 @@ func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 		assert.Equal(t, []int64{1, 2}, ids)
 	})
 }
+
+func Test_NewTxStrategy(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.New()
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		_, err := txmgrcommon.NewTxStrategy(subject, txmgrcommon.TxStrategyConfig{Name: "does-not-exist"})
+		require.EqualError(t, err, `unknown tx strategy "does-not-exist"`)
+	})
+
+	t.Run("send every", func(t *testing.T) {
+		s, err := txmgrcommon.NewTxStrategy(uuid.Nil, txmgrcommon.TxStrategyConfig{Name: txmgrcommon.TxStrategySendEvery})
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.SendEveryStrategy{}, s)
+	})
+
+	t.Run("latest only", func(t *testing.T) {
+		s, err := txmgrcommon.NewTxStrategy(subject, txmgrcommon.TxStrategyConfig{Name: txmgrcommon.TxStrategyLatestOnly})
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.NewDropOldestStrategy(subject, 1), s)
+
+		_, err = txmgrcommon.NewTxStrategy(uuid.Nil, txmgrcommon.TxStrategyConfig{Name: txmgrcommon.TxStrategyLatestOnly})
+		require.EqualError(t, err, "replace-by-subject-latest-only strategy requires a subject")
+	})
+
+	t.Run("priority queue", func(t *testing.T) {
+		s, err := txmgrcommon.NewTxStrategy(subject, txmgrcommon.TxStrategyConfig{Name: txmgrcommon.TxStrategyPriorityQueue, Priority: 5})
+		require.NoError(t, err)
+		scheduler, ok := s.(txmgrtypes.TxScheduler)
+		require.True(t, ok)
+		assert.Equal(t, subject, scheduler.Subject().UUID)
+		assert.Equal(t, int32(5), scheduler.Priority())
+		assert.Zero(t, scheduler.MinInterval())
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		_, err := txmgrcommon.NewTxStrategy(subject, txmgrcommon.TxStrategyConfig{Name: txmgrcommon.TxStrategyRateLimited})
+		require.EqualError(t, err, "rate-limited strategy requires a positive minInterval")
+
+		s, err := txmgrcommon.NewTxStrategy(subject, txmgrcommon.TxStrategyConfig{Name: txmgrcommon.TxStrategyRateLimited,
+			MinInterval: *commonconfig.MustNewDuration(time.Minute)})
+		require.NoError(t, err)
+		scheduler, ok := s.(txmgrtypes.TxScheduler)
+		require.True(t, ok)
+		assert.Equal(t, time.Minute, scheduler.MinInterval())
+	})
+
+	t.Run("custom strategy", func(t *testing.T) {
+		name := "custom-" + uuid.NewString()
+		require.NoError(t, txmgrcommon.RegisterTxStrategy(name, func(subject uuid.UUID, cfg txmgrcommon.TxStrategyConfig) (txmgrtypes.TxStrategy, error) {
+			return txmgrcommon.NewDropOldestStrategy(subject, cfg.QueueSize*2), nil
+		}))
+		require.Error(t, txmgrcommon.RegisterTxStrategy(name, nil))
+
+		s, err := txmgrcommon.NewTxStrategy(subject, txmgrcommon.TxStrategyConfig{Name: name, QueueSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.NewDropOldestStrategy(subject, 4), s)
+	})
+}
@@ -43,17 +43,18 @@ func (d *TestDatabaseConfig) Listener() config.Listener {
 
 type TestEvmConfig struct {
 	evmconfig.EVM
-	MaxInFlight          uint32
-	ReaperInterval       time.Duration
-	ReaperThreshold      time.Duration
-	ResendAfterThreshold time.Duration
-	BumpThreshold        uint64
-	MaxQueued            uint64
-	Enabled              bool
-	Threshold            uint32
-	MinAttempts          uint32
-	DetectionApiUrl      *url.URL
-	RpcDefaultBatchSize  uint32
+	MaxInFlight             uint32
+	ReaperInterval          time.Duration
+	ReaperThreshold         time.Duration
+	ResendAfterThreshold    time.Duration
+	SimulateBeforeBroadcast bool
+	BumpThreshold           uint64
+	MaxQueued               uint64
+	Enabled                 bool
+	Threshold               uint32
+	MinAttempts             uint32
+	DetectionApiUrl         *url.URL
+	RpcDefaultBatchSize     uint32
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
@@ -80,6 +81,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Mempool() evmconfig.Mempool {
+	return &TestMempoolConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -134,6 +139,13 @@ type TestFeeHistoryConfig struct {
 
 func (b *TestFeeHistoryConfig) CacheTimeout() time.Duration { return 0 * time.Second }
 
+type TestMempoolConfig struct {
+	evmconfig.Mempool
+}
+
+func (m *TestMempoolConfig) Blocks() uint16            { return 42 }
+func (m *TestMempoolConfig) PollPeriod() time.Duration { return time.Minute }
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -146,7 +158,9 @@ func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.
 func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) SimulateBeforeBroadcast() bool        { return t.e.SimulateBeforeBroadcast }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) Budget() evmconfig.TxBudget           { return &txBudgetConfig{} }
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -154,6 +168,12 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type txBudgetConfig struct {
+	evmconfig.TxBudget
+}
+
+func (b *txBudgetConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig          *TestEvmConfig
 	finalityDepth      uint32
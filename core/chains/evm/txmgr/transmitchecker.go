@@ -15,6 +15,7 @@ import (
 	bigmath "github.com/smartcontractkit/chainlink-common/pkg/utils/big_math"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/bytes"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
@@ -37,6 +38,7 @@ var (
 
 	_ TransmitCheckerFactory = &CheckerFactory{}
 	_ TransmitChecker        = &SimulateChecker{}
+	_ TransmitChecker        = &multiChecker{}
 	_ TransmitChecker        = &VRFV1Checker{}
 	_ TransmitChecker        = &VRFV2Checker{}
 )
@@ -48,9 +50,24 @@ type CheckerFactory struct {
 
 // BuildChecker satisfies the TransmitCheckerFactory interface.
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
+	checker, err := c.buildChecker(spec)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Simulate == nil || !*spec.Simulate || spec.CheckerType == TransmitCheckerTypeSimulate {
+		return checker, nil
+	}
+	simulator := &SimulateChecker{Client: c.Client, Pending: true}
+	if checker == NoChecker {
+		return simulator, nil
+	}
+	return &multiChecker{checkers: []TransmitChecker{simulator, checker}}, nil
+}
+
+func (c *CheckerFactory) buildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
-		return &SimulateChecker{c.Client}, nil
+		return &SimulateChecker{Client: c.Client}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -117,9 +134,31 @@ func (noChecker) Check(
 	return nil
 }
 
+// multiChecker runs checkers in order, until one of them errors.
+type multiChecker struct {
+	checkers []TransmitChecker
+}
+
+// Check satisfies the TransmitChecker interface.
+func (m *multiChecker) Check(
+	ctx context.Context,
+	l logger.SugaredLogger,
+	tx Tx,
+	a TxAttempt,
+) error {
+	for _, checker := range m.checkers {
+		if err := checker.Check(ctx, l, tx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SimulateChecker simulates transactions, producing an error if they revert on chain.
 type SimulateChecker struct {
 	Client evmclient.Client
+	// Pending simulates on top of the pending block, instead of the latest one.
+	Pending bool
 }
 
 // Check satisfies the TransmitChecker interface.
@@ -144,13 +183,24 @@ func (s *SimulateChecker) Check(
 		"data":                 hexutil.Bytes(tx.EncodedPayload),
 	}
 	var b hexutil.Bytes
-	// always run simulation on "latest" block
-	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
+	// run simulation on "latest" block, unless configured to run on "pending"
+	blockNumArg := evmclient.ToBlockNumArg(nil)
+	if s.Pending {
+		blockNumArg = "pending"
+	}
+	// the simulation decides whether the transaction is sent, so its result has to be verified by a quorum of RPCs.
+	// The pending state differs between RPCs by design, so simulations on the pending block can't be verified.
+	callCtx := ctx
+	if !s.Pending {
+		callCtx = commonclient.CtxAddQuorumReadFlag(ctx)
+	}
+	err := s.Client.CallContext(callCtx, &b, "eth_call", callArg, blockNumArg)
 	if err != nil {
 		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
+			reason := defaultRevertReasonDecoder().Decode(*jErr)
 			l.Criticalw("Transaction reverted during simulation",
-				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-			return pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String())
+				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "revertReason", reason, "returnValue", b.String())
+			return pkgerrors.Errorf("transaction reverted during simulation: %s", reason)
 		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
 			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "returnValue", b.String())
@@ -242,7 +292,7 @@ func (v *VRFV1Checker) Check(
 	var reqID [32]byte
 	copy(reqID[:], meta.RequestID.Bytes())
 	callback, err := v.Callbacks(&bind.CallOpts{
-		Context:     ctx,
+		Context:     commonclient.CtxAddQuorumReadFlag(ctx),
 		BlockNumber: blockNumber,
 	}, reqID)
 	if err != nil {
@@ -332,7 +382,7 @@ func (v *VRFV2Checker) Check(
 	latest := new(big.Int).Sub(big.NewInt(h.Number), big.NewInt(5))
 	blockNumber := bigmath.Max(latest, v.RequestBlockNumber)
 	callback, err := v.GetCommitment(&bind.CallOpts{
-		Context:     ctx,
+		Context:     commonclient.CtxAddQuorumReadFlag(ctx),
 		BlockNumber: blockNumber,
 	}, vrfRequestID)
 	if err != nil {
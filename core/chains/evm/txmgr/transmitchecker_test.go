@@ -93,6 +93,37 @@ func TestFactory(t *testing.T) {
 		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
 	})
 
+	t.Run("simulate before broadcast", func(t *testing.T) {
+		simulate := true
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{Simulate: &simulate})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulateChecker{Client: client, Pending: true}, c)
+
+		c, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeSimulate,
+			Simulate:    &simulate,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
+
+		c, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType:           txmgr.TransmitCheckerTypeVRFV1,
+			VRFCoordinatorAddress: testutils.NewAddressPtr(),
+			Simulate:              &simulate,
+		})
+		require.NoError(t, err)
+		// the simulation runs first, the VRF check is skipped if it reverts
+		client.On("CallContext", mock.Anything,
+			mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending").Return(&evmclient.JsonError{Code: 3, Message: "execution reverted"}).Once()
+		err = c.Check(tests.Context(t), logger.Sugared(logger.Test(t)), txmgr.Tx{}, txmgr.TxAttempt{})
+		require.EqualError(t, err, "transaction reverted during simulation: execution reverted")
+
+		simulate = false
+		c, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{Simulate: &simulate})
+		require.NoError(t, err)
+		require.Equal(t, txmgr.NoChecker, c)
+	})
+
 	t.Run("invalid checker type", func(t *testing.T) {
 		_, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
 			CheckerType: "invalid",
@@ -153,10 +184,25 @@ func TestTransmitCheckers(t *testing.T) {
 				}), "latest").Return(&jerr).Once()
 
 			err := checker.Check(ctx, log, tx, attempt)
-			expErrMsg := "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }"
+			expErrMsg := "transaction reverted during simulation: oh no, it reverted"
 			require.EqualError(t, err, expErrMsg)
 		})
 
+		t.Run("revert with reason", func(t *testing.T) {
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				// Error("not allowed")
+				Data: "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f7420616c6c6f776564000000000000000000000000000000000000000000",
+			}
+			pendingChecker := txmgr.SimulateChecker{Client: client, Pending: true}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending").Return(&jerr).Once()
+
+			err := pendingChecker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: not allowed")
+		})
+
 		t.Run("non revert error", func(t *testing.T) {
 			client.On("CallContext", mock.Anything,
 				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
@@ -26,6 +26,7 @@ import (
 	commonutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
@@ -602,6 +603,87 @@ func TestTxm_Reset(t *testing.T) {
 	})
 }
 
+func TestTxm_RepairSequenceGaps(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := cltest.NewKeyStore(t, db)
+	ctx := tests.Context(t)
+
+	_, addr := cltest.RandomKey{}.MustInsert(t, kst.Eth())
+	txStore := cltest.NewTestTxStore(t, db)
+	// nonce 0 and 1 are mined, 2 and 4 are lost, 3 was broadcast and 5 was never sent, both are stuck behind them
+	for i := int64(0); i < 2; i++ {
+		cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, i, i*42+1, addr)
+	}
+	stuck3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, addr)
+	unsent5 := mustInsertInProgressEthTxWithAttempt(t, txStore, 5, addr)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil)
+	ethClient.On("BatchCallContextAll", mock.Anything, mock.Anything).Return(nil).Maybe()
+	ethClient.On("PendingNonceAt", mock.Anything, addr).Return(uint64(2), nil).Maybe()
+	ethClient.On("SequenceAt", mock.Anything, addr, (*big.Int)(nil)).Return(evmtypes.Nonce(2), nil)
+
+	estimator, err := gas.NewEstimator(logger.Test(t), ethClient, cfg.EVM(), cfg.EVM().GasEstimator())
+	require.NoError(t, err)
+	txm, err := makeTestEvmTxm(t, db, ethClient, estimator, cfg.EVM(), cfg.EVM().GasEstimator(), cfg.EVM().Transactions(), gcfg.Database(), gcfg.Database().Listener(), kst.Eth())
+	require.NoError(t, err)
+
+	t.Run("rejects unknown modes", func(t *testing.T) {
+		_, err := txm.RepairSequenceGaps(ctx, addr, "bogus", 21000, true)
+		require.ErrorContains(t, err, "invalid sequence repair mode")
+	})
+
+	t.Run("reports gaps on dry run", func(t *testing.T) {
+		report, err := txm.RepairSequenceGaps(ctx, addr, txmgrcommon.SequenceRepairRewind, 21000, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, evmtypes.Nonce(2), report.OnChainSequence)
+		require.NotNil(t, report.LatestSequence)
+		assert.Equal(t, evmtypes.Nonce(5), *report.LatestSequence)
+		assert.Equal(t, []evmtypes.Nonce{2, 4}, report.Gaps)
+		assert.Empty(t, report.RewoundTxIDs)
+	})
+
+	t.Run("returns error if not started", func(t *testing.T) {
+		_, err := txm.RepairSequenceGaps(ctx, addr, txmgrcommon.SequenceRepairRewind, 21000, false)
+		require.EqualError(t, err, "not started")
+	})
+
+	servicetest.Run(t, txm)
+
+	t.Run("rewinds unsent transactions above the first gap and fills the gaps below broadcast ones", func(t *testing.T) {
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(2) && *tx.To() == addr
+		}), addr).Return(commonclient.Successful, nil).Once()
+		// the rewound transaction is sent again by the Broadcaster once it is restarted
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, addr).Return(commonclient.Successful, nil).Maybe()
+
+		report, err := txm.RepairSequenceGaps(ctx, addr, txmgrcommon.SequenceRepairRewind, 21000, false)
+		require.NoError(t, err)
+		assert.False(t, report.DryRun)
+		assert.Equal(t, []evmtypes.Nonce{2, 4}, report.Gaps)
+		assert.Equal(t, []int64{unsent5.ID}, report.RewoundTxIDs)
+		require.Len(t, report.FilledTxIDs, 1)
+
+		filled, err := txStore.FindTxWithAttempts(ctx, report.FilledTxIDs[0])
+		require.NoError(t, err)
+		require.NotNil(t, filled.Sequence)
+		assert.Equal(t, evmtypes.Nonce(2), *filled.Sequence)
+
+		// the broadcast transaction keeps its nonce, as it might still be mined
+		etx, err := txStore.FindTxWithAttempts(ctx, stuck3.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		require.NotNil(t, etx.Sequence)
+		assert.Equal(t, evmtypes.Nonce(3), *etx.Sequence)
+		assert.NotEmpty(t, etx.TxAttempts)
+	})
+}
+
 func TestTxm_GetTransactionStatus(t *testing.T) {
 	t.Parallel()
 
@@ -1105,6 +1187,12 @@ func txRequestWithValue(value big.Int) func(*txmgr.TxRequest) {
 	}
 }
 
+func txRequestWithPriority(priority int32) func(*txmgr.TxRequest) {
+	return func(tx *txmgr.TxRequest) {
+		tx.Priority = priority
+	}
+}
+
 func txRequestWithIdempotencyKey(idempotencyKey string) func(*txmgr.TxRequest) {
 	return func(tx *txmgr.TxRequest) {
 		tx.IdempotencyKey = &idempotencyKey
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"os"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
@@ -64,6 +65,8 @@ type Receipt struct {
 	BlockNumber       *big.Int        `json:"blockNumber,omitempty"`
 	TransactionIndex  uint            `json:"transactionIndex"`
 	RevertReason      []byte          `json:"revertReason,omitempty"` // Only provided by Hedera
+	EffectiveGasPrice *big.Int        `json:"effectiveGasPrice,omitempty"`
+	L1Fee             *big.Int        `json:"l1Fee,omitempty"` // Only provided by OP stack and Scroll rollups
 }
 
 // FromGethReceipt converts a gethTypes.Receipt to a Receipt
@@ -88,6 +91,8 @@ func FromGethReceipt(gr *gethTypes.Receipt) *Receipt {
 		gr.BlockNumber,
 		gr.TransactionIndex,
 		nil,
+		gr.EffectiveGasPrice,
+		nil,
 	}
 }
 
@@ -122,6 +127,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 		BlockNumber       *hexutil.Big    `json:"blockNumber,omitempty"`
 		TransactionIndex  hexutil.Uint    `json:"transactionIndex"`
 		RevertReason      hexutil.Bytes   `json:"revertReason,omitempty"` // Only provided by Hedera
+		EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice,omitempty"`
+		L1Fee             *hexutil.Big    `json:"l1Fee,omitempty"` // Only provided by OP stack and Scroll rollups
 	}
 	var enc Receipt
 	enc.PostState = r.PostState
@@ -136,6 +143,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 	enc.BlockNumber = (*hexutil.Big)(r.BlockNumber)
 	enc.TransactionIndex = hexutil.Uint(r.TransactionIndex)
 	enc.RevertReason = r.RevertReason
+	enc.EffectiveGasPrice = (*hexutil.Big)(r.EffectiveGasPrice)
+	enc.L1Fee = (*hexutil.Big)(r.L1Fee)
 	return json.Marshal(&enc)
 }
 
@@ -154,6 +163,8 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		BlockNumber       *hexutil.Big     `json:"blockNumber,omitempty"`
 		TransactionIndex  *hexutil.Uint    `json:"transactionIndex"`
 		RevertReason      *hexutil.Bytes   `json:"revertReason,omitempty"` // Only provided by Hedera
+		EffectiveGasPrice *hexutil.Big     `json:"effectiveGasPrice,omitempty"`
+		L1Fee             *hexutil.Big     `json:"l1Fee,omitempty"` // Only provided by OP stack and Scroll rollups
 	}
 	var dec Receipt
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -193,6 +204,12 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 	if dec.RevertReason != nil {
 		r.RevertReason = *dec.RevertReason
 	}
+	if dec.EffectiveGasPrice != nil {
+		r.EffectiveGasPrice = (*big.Int)(dec.EffectiveGasPrice)
+	}
+	if dec.L1Fee != nil {
+		r.L1Fee = (*big.Int)(dec.L1Fee)
+	}
 	return nil
 }
 
@@ -241,6 +258,19 @@ func (r *Receipt) GetRevertReason() *string {
 	return &revertReason
 }
 
+// GetDecodedRevertReason returns the decoded revert reason, if provided by the RPC. Revert data which is not an
+// Error(string) or Panic(uint256) is returned hex encoded.
+func (r *Receipt) GetDecodedRevertReason() *string {
+	if len(r.RevertReason) == 0 {
+		return nil
+	}
+	revertReason, err := abi.UnpackRevert(r.RevertReason)
+	if err != nil {
+		revertReason = hexutil.Encode(r.RevertReason)
+	}
+	return &revertReason
+}
+
 type Confirmations int
 
 const (
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -119,6 +120,31 @@ func TestReceipt_IsUnmined(t *testing.T) {
 	assert.True(t, receipt.IsUnmined())
 }
 
+func TestReceipt_RevertReason(t *testing.T) {
+	t.Parallel()
+
+	receipt := types.Receipt{}
+	assert.Nil(t, receipt.GetRevertReason())
+	assert.Nil(t, receipt.GetDecodedRevertReason())
+
+	// Error("boom")
+	receipt.RevertReason = hexutil.MustDecode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"626f6f6d00000000000000000000000000000000000000000000000000000000")
+	require.NotNil(t, receipt.GetRevertReason())
+	assert.Equal(t, string(receipt.RevertReason), *receipt.GetRevertReason())
+	require.NotNil(t, receipt.GetDecodedRevertReason())
+	assert.Equal(t, "boom", *receipt.GetDecodedRevertReason())
+
+	// custom errors can not be decoded without the ABI
+	receipt.RevertReason = hexutil.MustDecode("0xdeadbeef")
+	require.NotNil(t, receipt.GetRevertReason())
+	assert.Equal(t, string(receipt.RevertReason), *receipt.GetRevertReason())
+	require.NotNil(t, receipt.GetDecodedRevertReason())
+	assert.Equal(t, "0xdeadbeef", *receipt.GetDecodedRevertReason())
+}
+
 func TestReceipt_MarshalUnmarshalJson(t *testing.T) {
 	t.Parallel()
 
@@ -134,6 +160,23 @@ func TestReceipt_MarshalUnmarshalJson(t *testing.T) {
 	assert.Equal(t, receipt, parsedReceipt)
 }
 
+func TestReceipt_L1Fee(t *testing.T) {
+	t.Parallel()
+
+	receipt := &types.Receipt{}
+	require.NoError(t, receipt.UnmarshalJSON([]byte(`{"gasUsed":"0x5208","l1Fee":"0x2a"}`)))
+	assert.Equal(t, big.NewInt(42), receipt.L1Fee)
+
+	json, err := receipt.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(json), `"l1Fee":"0x2a"`)
+
+	receipt = types.FromGethReceipt(testGethReceipt)
+	json, err = receipt.MarshalJSON()
+	require.NoError(t, err)
+	assert.NotContains(t, string(json), "l1Fee")
+}
+
 func TestLog_MarshalUnmarshalJson(t *testing.T) {
 	t.Parallel()
 
@@ -113,6 +113,7 @@ type chain struct {
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
+	fundingManager  monitor.FundingManager
 	keyStore        keystore.Eth
 	gasEstimator    gas.EvmFeeEstimator
 }
@@ -228,7 +229,11 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 			orm = headtracker.NewNullORM()
 		}
 		headSaver = headtracker.NewHeadSaver(l, orm, cfg.EVM(), cfg.EVM().HeadTracker())
-		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, headSaver, opts.MailMon)
+		finalityProvider, err := headtracker.NewFinalityProvider(l, client, cfg.EVM().FinalityProvider())
+		if err != nil {
+			return nil, fmt.Errorf("failed to instantiate finality provider for chain with ID %s: %w", chainID.String(), err)
+		}
+		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, headSaver, opts.MailMon, finalityProvider)
 	} else {
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
@@ -240,13 +245,15 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		} else {
 			lpOpts := logpoller.Opts{
 				PollPeriod:               cfg.EVM().LogPollInterval(),
-				UseFinalityTag:           cfg.EVM().FinalityTagEnabled(),
+				UseFinalityTag:           cfg.EVM().FinalityTagEnabled() && cfg.EVM().FinalityProvider().Type() == "",
+				FinalityBlockTag:         cfg.EVM().FinalityBlockTag(),
 				FinalityDepth:            int64(cfg.EVM().FinalityDepth()),
 				BackfillBatchSize:        int64(cfg.EVM().LogBackfillBatchSize()),
 				RpcBatchSize:             int64(cfg.EVM().RPCDefaultBatchSize()),
 				KeepFinalizedBlocksDepth: int64(cfg.EVM().LogKeepBlocksDepth()),
 				LogPrunePageSize:         int64(cfg.EVM().LogPrunePageSize()),
 				BackupPollerBlockDelay:   int64(cfg.EVM().BackupLogPollerBlockDelay()),
+				SubscribeLogs:            cfg.EVM().LogSubscriptionEnabled(),
 				ClientErrors:             cfg.EVM().NodePool().Errors(),
 			}
 			logPoller = logpoller.NewLogPoller(logpoller.NewObservedORM(chainID, opts.DS, l), client, l, headTracker, lpOpts)
@@ -273,6 +280,12 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
+	var fundingManager monitor.FundingManager
+	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().Funding().Enabled() {
+		fundingManager = monitor.NewFundingManager(cfg.EVM().Funding(), cfg.EVM().GasEstimator(), txm, client, opts.KeyStore, l)
+		headBroadcaster.Subscribe(fundingManager)
+	}
+
 	var logBroadcaster log.Broadcaster
 	if !opts.AppConfig.EVMRPCEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -303,6 +316,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
+		fundingManager:  fundingManager,
 		keyStore:        opts.KeyStore,
 		gasEstimator:    gasEstimator,
 	}, nil
@@ -331,6 +345,11 @@ func (c *chain) Start(ctx context.Context) error {
 				return err
 			}
 		}
+		if c.fundingManager != nil {
+			if err := ms.Start(ctx, c.fundingManager); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
@@ -344,6 +363,10 @@ func (c *chain) Close() error {
 			c.logger.Debug("Chain: stopping balance monitor")
 			merr = c.balanceMonitor.Close()
 		}
+		if c.fundingManager != nil {
+			c.logger.Debug("Chain: stopping funding manager")
+			merr = multierr.Combine(merr, c.fundingManager.Close())
+		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headTracker")
@@ -370,6 +393,9 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.fundingManager != nil {
+		merr = multierr.Combine(merr, c.fundingManager.Ready())
+	}
 	return
 }
 
@@ -387,6 +413,9 @@ func (c *chain) HealthReport() map[string]error {
 	if c.balanceMonitor != nil {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	}
+	if c.fundingManager != nil {
+		services.CopyHealth(report, c.fundingManager.HealthReport())
+	}
 
 	return report
 }
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initBlocksSubCmds(s *Shell) []cli.Command {
@@ -36,6 +39,18 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "filters",
+			Usage:  "List the LogPoller filters with the storage used by their logs and their owner jobs",
+			Action: s.ListLogPollerFilters,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: false,
+				},
+			},
+		},
 		{
 			Name:   "find-lca",
 			Usage:  "Find latest common block stored in DB and on chain",
@@ -133,3 +148,69 @@ func (s *Shell) FindLCA(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &LCAPresenter{}, "Last Common Ancestor")
 }
+
+// LogPollerFilterPresenter implements TableRenderer for a LogPollerFilterResource.
+type LogPollerFilterPresenter struct {
+	JAID
+	presenters.LogPollerFilterResource
+}
+
+var logPollerFiltersHeaders = []string{"Name", "Addresses", "Logs", "Size", "Logs Last Hour", "Retention", "Max Logs Kept", "Jobs"}
+
+// ToRow presents the LogPollerFilterResource as a slice of strings.
+func (p *LogPollerFilterPresenter) ToRow() []string {
+	jobIDs := make([]string, len(p.JobIDs))
+	for i, id := range p.JobIDs {
+		jobIDs[i] = strconv.FormatInt(int64(id), 10)
+	}
+	return []string{
+		p.GetID(),
+		strings.Join(p.Addresses, ", "),
+		strconv.FormatInt(p.Logs, 10),
+		utils.FileSize(p.SizeBytes).String(),
+		strconv.FormatInt(p.LogsLastHour, 10),
+		p.Retention,
+		strconv.FormatUint(p.MaxLogsKept, 10),
+		strings.Join(jobIDs, ", "),
+	}
+}
+
+// LogPollerFilterPresenters implements TableRenderer for a slice of LogPollerFilterPresenter.
+type LogPollerFilterPresenters []LogPollerFilterPresenter
+
+// RenderTable implements TableRenderer
+func (ps LogPollerFilterPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(logPollerFiltersHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ListLogPollerFilters lists the LogPoller filters with the storage used by their logs, to find the jobs bloating the logs table.
+func (s *Shell) ListLogPollerFilters(c *cli.Context) (err error) {
+	v := url.Values{}
+
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(),
+		fmt.Sprintf(
+			"/v2/log_poller/filters?%s",
+			v.Encode(),
+		))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &LogPollerFilterPresenters{}, "LogPoller Filters")
+}
@@ -66,3 +66,27 @@ func Test_FindLCA(t *testing.T) {
 	c = cli.NewContext(nil, set, nil)
 	require.ErrorContains(t, client.FindLCA(c), "FindLCA is only available if LogPoller is enabled")
 }
+
+func Test_ListLogPollerFilters(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListLogPollerFilters, set, "")
+
+	//Incorrect chain ID
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ListLogPollerFilters(c), "does not match any local chains")
+
+	//Correct chain ID
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ListLogPollerFilters(c), "LogPollerFilters is only available if LogPoller is enabled")
+}
@@ -115,6 +115,32 @@ func initEthKeysSubCmd(s *Shell) cli.Command {
 					},
 				},
 			},
+			{
+				Name:   "repair-nonces",
+				Usage:  "Report, and optionally repair, nonce gaps of an EVM key on the given chain",
+				Action: s.RepairNonceGapsEVMKey,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "address",
+						Usage:    "address of the key",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:     "evm-chain-id, evmChainID",
+						Usage:    "chain ID of the key",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:  "mode",
+						Usage: "how to repair the gaps: 'fill' sends a zero-value transaction for every gap, 'rewind' resends the unsent transactions above the first gap with new nonces and fills the gaps left below broadcast ones",
+						Value: "fill",
+					},
+					cli.BoolFlag{
+						Name:  "apply",
+						Usage: "repair the gaps, instead of only reporting them",
+					},
+				},
+			},
 		},
 	}
 }
@@ -401,3 +427,57 @@ func (s *Shell) UpdateChainEVMKey(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &EthKeyPresenter{}, "🔑 Updated ETH key")
 }
+
+type NonceGapReportPresenter struct {
+	presenters.NonceGapReportResource
+}
+
+// RenderTable implements TableRenderer
+func (p *NonceGapReportPresenter) RenderTable(rt RendererTable) error {
+	latest := "None"
+	if p.LatestNonce != nil {
+		latest = fmt.Sprintf("%d", *p.LatestNonce)
+	}
+	headers := []string{"Address", "EVM Chain ID", "On-chain Nonce", "Latest Nonce", "Gaps", "Mode", "Dry Run", "Filled Txs", "Rewound Txs"}
+	rows := [][]string{{
+		p.Address,
+		p.EVMChainID.String(),
+		fmt.Sprintf("%d", p.OnChainNonce),
+		latest,
+		fmt.Sprintf("%v", p.Gaps),
+		p.Mode,
+		fmt.Sprintf("%v", p.DryRun),
+		fmt.Sprintf("%v", p.FilledTxIDs),
+		fmt.Sprintf("%v", p.RewoundTxIDs),
+	}}
+	renderList(headers, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// RepairNonceGapsEVMKey reports the nonce gaps of the given key on the given chain, and repairs them if --apply is set
+func (s *Shell) RepairNonceGapsEVMKey(c *cli.Context) (err error) {
+	repairURL := url.URL{Path: "/v2/keys/evm/nonce_gaps"}
+	query := repairURL.Query()
+	query.Set("address", c.String("address"))
+	query.Set("evmChainID", c.String("evmChainID"))
+	query.Set("mode", c.String("mode"))
+	query.Set("dryRun", fmt.Sprintf("%v", !c.Bool("apply")))
+	repairURL.RawQuery = query.Encode()
+
+	resp, err := s.HTTP.Post(s.ctx(), repairURL.String(), nil)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error repairing nonce gaps: %w", httpError(resp)))
+	}
+
+	return s.renderAPIResponse(resp, &NonceGapReportPresenter{}, "🔑 Nonce gaps")
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
+var evmNodeHeaders = []string{"Name", "Chain ID", "State", "Latency Score", "Config"}
+
 // EVMNodePresenter implements TableRenderer for an EVMNodeResource.
 type EVMNodePresenter struct {
 	presenters.EVMNodeResource
@@ -11,14 +13,14 @@ type EVMNodePresenter struct {
 
 // ToRow presents the EVMNodeResource as a slice of strings.
 func (p *EVMNodePresenter) ToRow() []string {
-	return []string{p.Name, p.ChainID, p.State, p.Config}
+	return []string{p.Name, p.ChainID, p.State, p.LatencyScore, p.Config}
 }
 
 // RenderTable implements TableRenderer
 func (p EVMNodePresenter) RenderTable(rt RendererTable) error {
 	var rows [][]string
 	rows = append(rows, p.ToRow())
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -34,7 +36,7 @@ func (ps EVMNodePresenters) RenderTable(rt RendererTable) error {
 		rows = append(rows, p.ToRow())
 	}
 
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -78,17 +78,19 @@ func TestShell_IndexEVMNodes(t *testing.T) {
 	rt := cmd.RendererTable{b}
 	require.NoError(t, nodes.RenderTable(rt))
 	renderLines := strings.Split(b.String(), "\n")
-	assert.Equal(t, 23, len(renderLines))
+	assert.Equal(t, 25, len(renderLines))
 	assert.Contains(t, renderLines[2], "Name")
 	assert.Contains(t, renderLines[2], n1.Name)
 	assert.Contains(t, renderLines[3], "Chain ID")
 	assert.Contains(t, renderLines[3], n1.ChainID)
 	assert.Contains(t, renderLines[4], "State")
 	assert.Contains(t, renderLines[4], n1.State)
-	assert.Contains(t, renderLines[12], "Name")
-	assert.Contains(t, renderLines[12], n2.Name)
-	assert.Contains(t, renderLines[13], "Chain ID")
-	assert.Contains(t, renderLines[13], n2.ChainID)
-	assert.Contains(t, renderLines[14], "State")
-	assert.Contains(t, renderLines[14], n2.State)
+	assert.Contains(t, renderLines[5], "Latency Score")
+	assert.Contains(t, renderLines[13], "Name")
+	assert.Contains(t, renderLines[13], n2.Name)
+	assert.Contains(t, renderLines[14], "Chain ID")
+	assert.Contains(t, renderLines[14], n2.ChainID)
+	assert.Contains(t, renderLines[15], "State")
+	assert.Contains(t, renderLines[15], n2.State)
+	assert.Contains(t, renderLines[16], "Latency Score")
 }
@@ -73,12 +73,14 @@ type EthTxPresenter struct {
 
 // RenderTable implements TableRenderer
 func (p *EthTxPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"From", "Nonce", "To", "State"})
+	table := rt.newTable([]string{"From", "Nonce", "To", "State", "Priority", "Revert Reason"})
 	table.Append([]string{
 		p.From.Hex(),
 		p.Nonce,
 		p.To.Hex(),
 		fmt.Sprint(p.State),
+		fmt.Sprint(p.Priority),
+		p.RevertReason,
 	})
 
 	render(fmt.Sprintf("Ethereum Transaction %v", p.Hash.Hex()), table)
@@ -89,7 +91,7 @@ type EthTxPresenters []EthTxPresenter
 
 // RenderTable implements TableRenderer
 func (ps EthTxPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Hash", "Nonce", "From", "GasPrice", "SentAt", "State"})
+	table := rt.newTable([]string{"Hash", "Nonce", "From", "GasPrice", "SentAt", "State", "Priority"})
 	for _, p := range ps {
 		table.Append([]string{
 			p.Hash.Hex(),
@@ -98,6 +100,7 @@ func (ps EthTxPresenters) RenderTable(rt RendererTable) error {
 			p.GasPrice,
 			p.SentAt,
 			fmt.Sprint(p.State),
+			fmt.Sprint(p.Priority),
 		})
 	}
 
@@ -87,6 +87,7 @@ func TestShell_ShowTransaction(t *testing.T) {
 
 	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
+	assert.Equal(t, tx.Priority, renderedTx.Priority)
 }
 
 func TestShell_IndexTxAttempts(t *testing.T) {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -12,7 +14,10 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -50,6 +55,51 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a run of a job without creating it",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "the pipeline variables of the run, as a JSON object or a path to a JSON file",
+				},
+				cli.StringFlag{
+					Name:  "stubs",
+					Usage: `the results replacing the ones of tasks, keyed by task name, as a JSON object or a path to a JSON file, e.g. {"ds1": {"value": "{\"price\": 1}"}, "ds2": {"error": "timeout"}}`,
+				},
+				cli.BoolFlag{
+					Name:  "dot",
+					Usage: "print the pipeline as a DOT graph annotated with the results of the run",
+				},
+			},
+		},
+		{
+			Name:   "record",
+			Usage:  "Record the vars and the results of the external tasks of a pipeline run into a replay bundle",
+			Action: s.RecordPipelineRun,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "output, o",
+					Usage: "`FILE` where the JSON replay bundle will be saved (required)",
+				},
+			},
+		},
+		{
+			Name:   "replay",
+			Usage:  "Replay a recorded pipeline run against a job spec, without creating the job",
+			Action: s.ReplayPipelineRun,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "bundle, b",
+					Usage: "`FILE` containing the JSON replay bundle of the run, as saved by the record command (required)",
+				},
+				cli.BoolFlag{
+					Name:  "dot",
+					Usage: "print the pipeline as a DOT graph annotated with the results of the run",
+				},
+			},
+		},
 	}
 }
 
@@ -209,6 +259,72 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// PipelineSimulationPresenter wraps the JSONAPI Pipeline Simulation Resource and adds rendering functionality
+type PipelineSimulationPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error", "Elapsed", "Stubbed"})
+	for _, task := range p.Tasks {
+		var output string
+		if task.Output.Valid {
+			if b, err := json.Marshal(task.Output); err == nil {
+				output = string(b)
+			}
+		}
+		table.Append([]string{
+			task.DotID,
+			string(task.Type),
+			output,
+			task.Error.ValueOrZero(),
+			task.Elapsed.Duration().String(),
+			fmt.Sprintf("%v", task.Stubbed),
+		})
+	}
+	render(fmt.Sprintf("Pipeline Simulation (%s)", p.State), table)
+
+	outputs, err := json.Marshal(p.Outputs)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(rt, "Outputs:", string(outputs))
+	return err
+}
+
+// PipelineReplayPresenter renders the Pipeline Simulation of a replayed run along with the outputs of the recorded run
+type PipelineReplayPresenter struct {
+	PipelineSimulationPresenter
+	RecordedRunID   int64                             `json:"recordedRunId"`
+	RecordedOutputs jsonserializable.JSONSerializable `json:"recordedOutputs"`
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineReplayPresenter) RenderTable(rt RendererTable) error {
+	if err := p.PipelineSimulationPresenter.RenderTable(rt); err != nil {
+		return err
+	}
+	outputs, err := json.Marshal(p.RecordedOutputs)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(rt, "Recorded outputs of run %d: %s\n", p.RecordedRunID, outputs)
+	return err
+}
+
+// PipelineSimulationDOTPresenter renders a Pipeline Simulation as a DOT graph annotated with the results of its tasks
+type PipelineSimulationDOTPresenter struct {
+	PipelineSimulationPresenter
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationDOTPresenter) RenderTable(rt RendererTable) error {
+	_, err := io.WriteString(rt, p.DOT)
+	return err
+}
+
 // ListJobs lists all jobs
 func (s *Shell) ListJobs(c *cli.Context) (err error) {
 	return s.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
@@ -276,6 +392,155 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// SimulateJob executes the pipeline of a job in the node, without creating the job or persisting the run,
+// and displays the inputs, outputs and errors of every task.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	request := web.SimulateJobRequest{}
+	request.TOML, err = getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if v := c.String("vars"); v != "" {
+		if err = decodeJSONFlag(v, &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid vars"))
+		}
+	}
+	if v := c.String("stubs"); v != "" {
+		if err = decodeJSONFlag(v, &request.Stubs); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid stubs"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderPipelineSimulation(resp, c.Bool("dot"))
+}
+
+// RecordPipelineRun saves the replay bundle of a finished pipeline run to a file, to replay the run later with
+// ReplayPipelineRun.
+func (s *Shell) RecordPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the pipeline run"))
+	}
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	runID := c.Args().First()
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/pipeline/runs/"+runID+"/replay_bundle")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	b, err := s.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	var resource presenters.ReplayBundleResource
+	if err = web.ParseJSONAPIResponse(b, &resource); err != nil {
+		return s.errorOut(err)
+	}
+	bundleJSON, err := json.MarshalIndent(resource.ReplayBundle, "", "  ")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if err = utils.WriteFileWithMaxPerms(filepath, bundleJSON, 0o600); err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	fmt.Printf("Recorded pipeline run %s to %s\n", runID, filepath)
+	return nil
+}
+
+// ReplayPipelineRun executes the pipeline of a job in the node with the vars and the results of the external tasks
+// of a recorded run, without creating the job or persisting the run, and displays the inputs, outputs and errors of
+// every task along with the outputs of the recorded run.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+	filepath := c.String("bundle")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --bundle/-b flag"))
+	}
+
+	request := web.ReplayJobRequest{}
+	request.TOML, err = getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	bundleJSON, err := os.ReadFile(filepath)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read replay bundle"))
+	}
+	if err = json.Unmarshal(bundleJSON, &request.Bundle); err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid replay bundle"))
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/replay", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if c.Bool("dot") {
+		return s.renderPipelineSimulation(resp, true)
+	}
+	return s.renderAPIResponse(resp, &PipelineReplayPresenter{
+		RecordedRunID:   request.Bundle.RunID,
+		RecordedOutputs: request.Bundle.Outputs,
+	})
+}
+
+// renderPipelineSimulation renders the trace of a simulated run as a table, or as a DOT graph
+func (s *Shell) renderPipelineSimulation(resp *http.Response, dot bool) error {
+	if dot {
+		return s.renderAPIResponse(resp, &PipelineSimulationDOTPresenter{})
+	}
+	return s.renderAPIResponse(resp, &PipelineSimulationPresenter{})
+}
+
+// decodeJSONFlag decodes a flag given as JSON or as a path to a JSON file
+func decodeJSONFlag(value string, dst interface{}) error {
+	buf, err := getBufferFromJSON(value)
+	if err != nil {
+		return err
+	}
+	return json.NewDecoder(buf).Decode(dst)
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
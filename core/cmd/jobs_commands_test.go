@@ -5,6 +5,9 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -15,11 +18,13 @@ import (
 	"github.com/urfave/cli"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -403,6 +408,156 @@ func TestShell_CreateJobV2(t *testing.T) {
 	assert.Equal(t, "0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15", output.OffChainReportingSpec.ContractAddress.String())
 }
 
+func TestShell_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.SimulateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{
+		"--vars", `{"scale": 10}`,
+		"--stubs", `{"fetch": {"value": "{\"price\": 2}"}}`,
+		`
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+fetch    [type=bridge name="undeployed-bridge"]
+parse    [type=jsonparse path="price"]
+multiply [type=multiply times="$(scale)"]
+fetch -> parse -> multiply
+"""
+`,
+	}))
+
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, fs, nil)))
+	requireJobsCount(t, app.JobORM(), 0)
+
+	output := *r.Renders[0].(*cmd.PipelineSimulationPresenter)
+	assert.Equal(t, pipeline.RunStatusCompleted, output.State)
+	assert.Equal(t, []interface{}{"20"}, output.Outputs.Val)
+	require.Len(t, output.Tasks, 3)
+	assert.True(t, output.Tasks[0].Stubbed)
+
+	fs = flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.SimulateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{"--dot", `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+answer [type=memo value=1]
+"""
+`}))
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, fs, nil)))
+
+	dot := *r.Renders[len(r.Renders)-1].(*cmd.PipelineSimulationDOTPresenter)
+	assert.Contains(t, dot.DOT, "answer")
+}
+
+func TestPipelineSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.PipelineSimulationPresenter{
+		PipelineSimulationResource: presenters.PipelineSimulationResource{
+			RunTrace: pipeline.RunTrace{
+				State:   pipeline.RunStatusCompleted,
+				Outputs: jsonserializable.JSONSerializable{Val: []interface{}{"20"}, Valid: true},
+				Tasks:   []pipeline.TaskTrace{{DotID: "fetch", Type: pipeline.TaskTypeBridge, Stubbed: true}},
+			},
+			DOT: "digraph {\n\tfetch\n}\n",
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "fetch")
+	assert.Contains(t, output, `Outputs: ["20"]`)
+	assert.NotContains(t, output, "digraph")
+
+	buffer.Reset()
+	dot := cmd.PipelineSimulationDOTPresenter{PipelineSimulationPresenter: p}
+	require.NoError(t, dot.RenderTable(r))
+	assert.Equal(t, p.DOT, buffer.String())
+
+	buffer.Reset()
+	replay := cmd.PipelineReplayPresenter{
+		PipelineSimulationPresenter: p,
+		RecordedRunID:               3,
+		RecordedOutputs:             jsonserializable.JSONSerializable{Val: []interface{}{"2"}, Valid: true},
+	}
+	require.NoError(t, replay.RenderTable(r))
+	output = buffer.String()
+	assert.Contains(t, output, `Outputs: ["20"]`)
+	assert.Contains(t, output, `Recorded outputs of run 3: ["2"]`)
+}
+
+func TestShell_RecordAndReplayPipelineRun(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	ctx := testutils.Context(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"price": 2}`))
+	}))
+	defer server.Close()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.CreateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{fmt.Sprintf(`
+type = "webhook"
+schemaVersion = 1
+externalJobID = "%s"
+observationSource = """
+fetch [type=http method=GET url="%s" allowUnrestrictedNetworkAccess="true"]
+parse [type=jsonparse path="price"]
+fetch -> parse
+"""
+`, uuid.New(), server.URL)}))
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	jobs, _, err := app.JobORM().FindJobs(ctx, 0, 1000)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	runID, err := app.RunWebhookJobV2(ctx, jobs[0].ExternalJobID, "", jsonserializable.JSONSerializable{})
+	require.NoError(t, err)
+
+	bundleFile := filepath.Join(t.TempDir(), "bundle.json")
+	fs = flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.RecordPipelineRun, fs, "")
+	require.NoError(t, fs.Parse([]string{"--output", bundleFile, fmt.Sprint(runID)}))
+	require.NoError(t, client.RecordPipelineRun(cli.NewContext(nil, fs, nil)))
+	server.Close()
+
+	fs = flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.ReplayPipelineRun, fs, "")
+	require.NoError(t, fs.Parse([]string{"--bundle", bundleFile, `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+fetch    [type=http method=GET url="http://unreachable.invalid"]
+parse    [type=jsonparse path="price"]
+multiply [type=multiply times=10]
+fetch -> parse -> multiply
+"""
+`}))
+	require.NoError(t, client.ReplayPipelineRun(cli.NewContext(nil, fs, nil)))
+
+	output := *r.Renders[len(r.Renders)-1].(*cmd.PipelineReplayPresenter)
+	assert.Equal(t, runID, output.RecordedRunID)
+	assert.Len(t, output.RecordedOutputs.Val, 1)
+	assert.Equal(t, pipeline.RunStatusCompleted, output.State)
+	assert.Equal(t, []interface{}{"20"}, output.Outputs.Val)
+	require.Len(t, output.Tasks, 3)
+	assert.True(t, output.Tasks[0].Stubbed)
+}
+
 func TestShell_DeleteJob(t *testing.T) {
 	t.Parallel()
 
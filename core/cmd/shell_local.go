@@ -41,6 +41,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -278,6 +279,50 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "export-logs",
+			Usage:  "Exports the LogPoller's finalized logs in a block range to a snapshot file",
+			Action: s.ExportLogs,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "start",
+					Usage:    "Beginning of block range to be exported",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:     "end",
+					Usage:    "End of block range to be exported, which must be finalized",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "file",
+					Usage:    "Path of the snapshot file to create",
+					Required: true,
+				},
+			},
+		},
+		{
+			Name:   "import-logs",
+			Usage:  "Imports the logs of a snapshot file matching the LogPoller's filters, after validating its blocks against the chain",
+			Action: s.ImportLogs,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "file",
+					Usage:    "Path of the snapshot file to import",
+					Required: true,
+				},
+			},
+		},
 	}
 }
 
@@ -1229,21 +1274,98 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 		return s.errorOut(errors.New("Must pass a positive value in '--start' parameter"))
 	}
 
+	chainID, err := evmChainIDFlag(c)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	err = s.runWithLockedApplication("RemoveBlocks", func(ctx context.Context, app chainlink.Application) error {
+		return app.DeleteLogPollerDataAfter(ctx, chainID, start)
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	s.Logger.Infof("RemoveBlocks: successfully removed blocks")
+
+	return nil
+}
+
+// ExportLogs - writes a snapshot of LogPoller's finalized logs in the specified block range to a file
+func (s *Shell) ExportLogs(c *cli.Context) error {
+	start, end := c.Int64("start"), c.Int64("end")
+	if start <= 0 || end < start {
+		return s.errorOut(errors.New("Must pass a positive '--start' parameter, not after the '--end' parameter"))
+	}
+	chainID, err := evmChainIDFlag(c)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	f, err := os.OpenFile(c.String("file"), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "creating snapshot file"))
+	}
+	err = s.runWithLockedApplication("ExportLogs", func(ctx context.Context, app chainlink.Application) error {
+		return app.ExportLogPollerData(ctx, chainID, f, start, end)
+	})
+	err = multierr.Append(err, f.Close())
+	if err != nil {
+		// the file was created above, so a partial snapshot is never left behind
+		return s.errorOut(multierr.Append(err, os.Remove(f.Name())))
+	}
+
+	s.Logger.Infof("ExportLogs: successfully exported logs to %s", c.String("file"))
+
+	return nil
+}
+
+// ImportLogs - imports the logs of a LogPoller snapshot file
+func (s *Shell) ImportLogs(c *cli.Context) error {
+	chainID, err := evmChainIDFlag(c)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	f, err := os.Open(c.String("file"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "opening snapshot file"))
+	}
+	defer f.Close()
+
+	var imported int
+	err = s.runWithLockedApplication("ImportLogs", func(ctx context.Context, app chainlink.Application) (err error) {
+		imported, err = app.ImportLogPollerData(ctx, chainID, f)
+		return err
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	s.Logger.Infof("ImportLogs: successfully imported %d logs", imported)
+
+	return nil
+}
+
+func evmChainIDFlag(c *cli.Context) (*big.Int, error) {
 	chainID := big.NewInt(0)
 	if c.IsSet("evm-chain-id") {
-		err := chainID.UnmarshalText([]byte(c.String("evm-chain-id")))
-		if err != nil {
-			return s.errorOut(err)
+		if err := chainID.UnmarshalText([]byte(c.String("evm-chain-id"))); err != nil {
+			return nil, err
 		}
 	}
+	return chainID, nil
+}
 
+// runWithLockedApplication instantiates the application, without starting it, on the locked database and runs fn with it.
+func (s *Shell) runWithLockedApplication(name string, fn func(ctx context.Context, app chainlink.Application) error) error {
 	cfg := s.Config
 	err := cfg.Validate()
 	if err != nil {
-		return s.errorOut(fmt.Errorf("error validating configuration: %+v", err))
+		return fmt.Errorf("error validating configuration: %+v", err)
 	}
 
-	lggr := logger.Sugared(s.Logger.Named("RemoveBlocks"))
+	lggr := logger.Sugared(s.Logger.Named(name))
 	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
 	ctx, cancel := context.WithCancel(context.Background())
 	go shutdown.HandleShutdown(func(sig string) {
@@ -1261,7 +1383,7 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 
 	if err = ldb.Open(ctx); err != nil {
 		// If not successful, we know neither locks nor connection remains opened
-		return s.errorOut(errors.Wrap(err, "opening db"))
+		return errors.Wrap(err, "opening db")
 	}
 	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
 
@@ -1270,15 +1392,8 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 
 	app, err := s.AppFactory.NewApplication(ctx, s.Config, s.Logger, ldb.DB())
 	if err != nil {
-		return s.errorOut(errors.Wrap(err, "fatal error instantiating application"))
-	}
-
-	err = app.DeleteLogPollerDataAfter(ctx, chainID, start)
-	if err != nil {
-		return s.errorOut(err)
+		return errors.Wrap(err, "fatal error instantiating application")
 	}
 
-	lggr.Infof("RemoveBlocks: successfully removed blocks")
-
-	return nil
+	return fn(ctx, app)
 }
@@ -1,10 +1,13 @@
 package cmd_test
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -570,3 +573,96 @@ func TestShell_RemoveBlocks(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestShell_ExportImportLogs(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		s.Password.Keystore = models.NewSecret("dummy")
+		c.EVM[0].Nodes[0].Name = ptr("fake")
+		c.EVM[0].Nodes[0].HTTPURL = commonconfig.MustParseURL("http://fake.com")
+		c.EVM[0].Nodes[0].WSURL = commonconfig.MustParseURL("WSS://fake.com/ws")
+		// seems to be needed for config validate
+		c.Insecure.OCRDevelopmentMode = nil
+	})
+
+	app := mocks.NewApplication(t)
+	app.On("GetSqlxDB").Maybe().Return(db)
+	shell := cmd.Shell{
+		Config:                 cfg,
+		AppFactory:             cltest.InstanceAppFactory{App: app},
+		FallbackAPIInitializer: cltest.NewMockAPIInitializer(t),
+		Runner:                 cltest.EmptyRunner{},
+		Logger:                 logger.TestLogger(t),
+	}
+	file := filepath.Join(t.TempDir(), "logs.jsonl")
+
+	t.Run("Returns error, if the block range is invalid", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportLogs, set, "")
+		require.NoError(t, set.Set("start", "100"))
+		require.NoError(t, set.Set("end", "99"))
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", file))
+		c := cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, shell.ExportLogs(c), "Must pass a positive '--start' parameter")
+	})
+	t.Run("Exports logs", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportLogs, set, "")
+		require.NoError(t, set.Set("start", "10"))
+		require.NoError(t, set.Set("end", "20"))
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", file))
+		app.On("ExportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything, int64(10), int64(20)).Run(func(args mock.Arguments) {
+			_, err := args.Get(2).(io.Writer).Write([]byte("snapshot"))
+			require.NoError(t, err)
+		}).Return(nil).Once()
+		c := cli.NewContext(nil, set, nil)
+		require.NoError(t, shell.ExportLogs(c))
+
+		// The file isn't overwritten.
+		c = cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, shell.ExportLogs(c), "creating snapshot file")
+	})
+	t.Run("Removes the file, if export fails", func(t *testing.T) {
+		failedFile := filepath.Join(t.TempDir(), "failed.jsonl")
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportLogs, set, "")
+		require.NoError(t, set.Set("start", "10"))
+		require.NoError(t, set.Set("end", "20"))
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", failedFile))
+		expectedError := fmt.Errorf("logs are not finalized")
+		app.On("ExportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything, int64(10), int64(20)).Run(func(args mock.Arguments) {
+			_, err := args.Get(2).(io.Writer).Write([]byte("snap"))
+			require.NoError(t, err)
+		}).Return(expectedError).Once()
+		c := cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, shell.ExportLogs(c), expectedError.Error())
+		assert.NoFileExists(t, failedFile)
+	})
+	t.Run("Imports logs", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ImportLogs, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", file))
+		app.On("ImportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything).Return(func(_ context.Context, _ *big.Int, r io.Reader) (int, error) {
+			b, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, "snapshot", string(b))
+			return 3, nil
+		}).Once()
+		c := cli.NewContext(nil, set, nil)
+		require.NoError(t, shell.ImportLogs(c))
+	})
+	t.Run("Returns error, if import fails", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ImportLogs, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", file))
+		expectedError := fmt.Errorf("snapshot is truncated")
+		app.On("ImportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything).Return(0, expectedError).Once()
+		c := cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, shell.ImportLogs(c), expectedError.Error())
+	})
+}
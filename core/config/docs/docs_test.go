@@ -53,7 +53,8 @@ func TestDoc(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Equal(t, 1, len(docDefaults.KeySpecific))
 		ks := evmcfg.KeySpecific{Key: new(types.EIP55Address),
-			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)},
+			Funding:      evmcfg.KeySpecificFunding{MinBalance: new(assets.Wei), TargetBalance: new(assets.Wei)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
@@ -88,6 +89,7 @@ func TestDoc(t *testing.T) {
 
 		docDefaults.Workflow.FromAddress = nil
 		docDefaults.Workflow.ForwarderAddress = nil
+		docDefaults.Funding.TreasuryKey = nil
 		docDefaults.Workflow.GasLimitDefault = &gasLimitDefault
 		docDefaults.NodePool.Errors = evmcfg.ClientErrors{}
 
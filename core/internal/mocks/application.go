@@ -15,6 +15,8 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	io "io"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -391,6 +393,56 @@ func (_c *Application_EVMORM_Call) RunAndReturn(run func() types.Configs) *Appli
 	return _c
 }
 
+// ExportLogPollerData provides a mock function with given fields: ctx, chainID, w, start, end
+func (_m *Application) ExportLogPollerData(ctx context.Context, chainID *big.Int, w io.Writer, start int64, end int64) error {
+	ret := _m.Called(ctx, chainID, w, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportLogPollerData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.Writer, int64, int64) error); ok {
+		r0 = rf(ctx, chainID, w, start, end)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ExportLogPollerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportLogPollerData'
+type Application_ExportLogPollerData_Call struct {
+	*mock.Call
+}
+
+// ExportLogPollerData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - w io.Writer
+//   - start int64
+//   - end int64
+func (_e *Application_Expecter) ExportLogPollerData(ctx interface{}, chainID interface{}, w interface{}, start interface{}, end interface{}) *Application_ExportLogPollerData_Call {
+	return &Application_ExportLogPollerData_Call{Call: _e.mock.On("ExportLogPollerData", ctx, chainID, w, start, end)}
+}
+
+func (_c *Application_ExportLogPollerData_Call) Run(run func(ctx context.Context, chainID *big.Int, w io.Writer, start int64, end int64)) *Application_ExportLogPollerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(io.Writer), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *Application_ExportLogPollerData_Call) Return(_a0 error) *Application_ExportLogPollerData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ExportLogPollerData_Call) RunAndReturn(run func(context.Context, *big.Int, io.Writer, int64, int64) error) *Application_ExportLogPollerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLCA provides a mock function with given fields: ctx, chainID
 func (_m *Application) FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.LogPollerBlock, error) {
 	ret := _m.Called(ctx, chainID)
@@ -1059,6 +1111,64 @@ func (_c *Application_ID_Call) RunAndReturn(run func() uuid.UUID) *Application_I
 	return _c
 }
 
+// ImportLogPollerData provides a mock function with given fields: ctx, chainID, r
+func (_m *Application) ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.Reader) (int, error) {
+	ret := _m.Called(ctx, chainID, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportLogPollerData")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.Reader) (int, error)); ok {
+		return rf(ctx, chainID, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.Reader) int); ok {
+		r0 = rf(ctx, chainID, r)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, io.Reader) error); ok {
+		r1 = rf(ctx, chainID, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ImportLogPollerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLogPollerData'
+type Application_ImportLogPollerData_Call struct {
+	*mock.Call
+}
+
+// ImportLogPollerData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - r io.Reader
+func (_e *Application_Expecter) ImportLogPollerData(ctx interface{}, chainID interface{}, r interface{}) *Application_ImportLogPollerData_Call {
+	return &Application_ImportLogPollerData_Call{Call: _e.mock.On("ImportLogPollerData", ctx, chainID, r)}
+}
+
+func (_c *Application_ImportLogPollerData_Call) Run(run func(ctx context.Context, chainID *big.Int, r io.Reader)) *Application_ImportLogPollerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *Application_ImportLogPollerData_Call) Return(_a0 int, _a1 error) *Application_ImportLogPollerData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ImportLogPollerData_Call) RunAndReturn(run func(context.Context, *big.Int, io.Reader) (int, error)) *Application_ImportLogPollerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobORM provides a mock function with given fields:
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -1153,6 +1263,65 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// LogPollerFilters provides a mock function with given fields: ctx, chainID
+func (_m *Application) LogPollerFilters(ctx context.Context, chainID *big.Int) ([]chainlink.LogPollerFilter, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogPollerFilters")
+	}
+
+	var r0 []chainlink.LogPollerFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]chainlink.LogPollerFilter, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []chainlink.LogPollerFilter); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]chainlink.LogPollerFilter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_LogPollerFilters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogPollerFilters'
+type Application_LogPollerFilters_Call struct {
+	*mock.Call
+}
+
+// LogPollerFilters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *Application_Expecter) LogPollerFilters(ctx interface{}, chainID interface{}) *Application_LogPollerFilters_Call {
+	return &Application_LogPollerFilters_Call{Call: _e.mock.On("LogPollerFilters", ctx, chainID)}
+}
+
+func (_c *Application_LogPollerFilters_Call) Run(run func(ctx context.Context, chainID *big.Int)) *Application_LogPollerFilters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Application_LogPollerFilters_Call) Return(_a0 []chainlink.LogPollerFilter, _a1 error) *Application_LogPollerFilters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_LogPollerFilters_Call) RunAndReturn(run func(context.Context, *big.Int) ([]chainlink.LogPollerFilter, error)) *Application_LogPollerFilters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1506,6 +1675,67 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, spec, vars, stubs
+func (_m *Application) SimulateJobV2(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, stubs pipeline.TaskStubs) (*pipeline.RunTrace, error) {
+	ret := _m.Called(ctx, spec, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.RunTrace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, map[string]interface{}, pipeline.TaskStubs) (*pipeline.RunTrace, error)); ok {
+		return rf(ctx, spec, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, map[string]interface{}, pipeline.TaskStubs) *pipeline.RunTrace); ok {
+		r0 = rf(ctx, spec, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.RunTrace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, map[string]interface{}, pipeline.TaskStubs) error); ok {
+		r1 = rf(ctx, spec, vars, stubs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars map[string]interface{}
+//   - stubs pipeline.TaskStubs
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, spec interface{}, vars interface{}, stubs interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, spec, vars, stubs)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, stubs pipeline.TaskStubs)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(map[string]interface{}), args[3].(pipeline.TaskStubs))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.RunTrace, _a1 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, pipeline.Spec, map[string]interface{}, pipeline.TaskStubs) (*pipeline.RunTrace, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
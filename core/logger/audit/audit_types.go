@@ -48,6 +48,8 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeyNonceGapsRepaired EventID = "KEY_NONCE_GAPS_REPAIRED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
@@ -66,6 +68,8 @@ const (
 	BridgeUpdated EventID = "BRIDGE_UPDATED"
 	BridgeDeleted EventID = "BRIDGE_DELETED"
 
+	PipelineTemplateCreated EventID = "PIPELINE_TEMPLATE_CREATED"
+
 	ForwarderCreated EventID = "FORWARDER_CREATED"
 	ForwarderDeleted EventID = "FORWARDER_DELETED"
 
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/batch_blockhash_store"
@@ -71,6 +72,7 @@ func (b *BatchBlockhashStore) StoreVerifyHeader(ctx context.Context, blockNumber
 		EncodedPayload: payload,
 		FeeLimit:       b.config.LimitDefault(),
 		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       txmgrtypes.TxPriorityLow,
 	})
 
 	if err != nil {
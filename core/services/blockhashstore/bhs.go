@@ -15,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
@@ -105,6 +106,7 @@ func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
 		// Set a queue size of 256. At most we store the blockhash of every block, and only the
 		// latest 256 can possibly be stored.
 		Strategy: txmgrcommon.NewQueueingTxStrategy(c.jobID, 256),
+		Priority: txmgrtypes.TxPriorityLow,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -143,6 +145,7 @@ func (c *BulletproofBHS) StoreTrusted(
 		FeeLimit:       c.config.LimitDefault(),
 
 		Strategy: txmgrcommon.NewSendEveryStrategy(),
+		Priority: txmgrtypes.TxPriorityLow,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -197,6 +200,7 @@ func (c *BulletproofBHS) StoreEarliest(ctx context.Context) error {
 		EncodedPayload: payload,
 		FeeLimit:       c.config.LimitDefault(),
 		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       txmgrtypes.TxPriorityLow,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"math/big"
 	"net/http"
+	"slices"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -38,6 +40,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
@@ -112,6 +115,8 @@ type Application interface {
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
+	// SimulateJobV2 executes the pipeline of a job spec with the given vars and task stubs, and returns a trace of the run
+	SimulateJobV2(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, stubs pipeline.TaskStubs) (*pipeline.RunTrace, error)
 
 	// Feeds
 	GetFeedsService() feeds.Service
@@ -129,6 +134,12 @@ type Application interface {
 	FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.LogPollerBlock, error)
 	// DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 	DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error
+	// ExportLogPollerData - write a snapshot of LogPoller's finalized logs in the specified block range
+	ExportLogPollerData(ctx context.Context, chainID *big.Int, w io.Writer, start, end int64) error
+	// ImportLogPollerData - import the logs of a LogPoller snapshot, validating its blocks against the chain
+	ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.Reader) (int, error)
+	// LogPollerFilters - the filters registered with the chain's LogPoller, with the storage used by their logs and their owner jobs
+	LogPollerFilters(ctx context.Context, chainID *big.Int) ([]LogPollerFilter, error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -673,6 +684,11 @@ func (app *ChainlinkApplication) Start(ctx context.Context) error {
 	))
 	defer span.End()
 
+	// job pipelines may include templates, so they must be loaded before any job is
+	if err := app.pipelineORM.LoadTemplates(ctx); err != nil {
+		return err
+	}
+
 	if app.FeedsService != nil {
 		if err := app.FeedsService.Start(ctx); err != nil {
 			app.logger.Errorf("[Feeds Service] Failed to start %v", err)
@@ -924,6 +940,11 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// SimulateJobV2 executes the pipeline of a job in memory, without creating the job or persisting the run.
+func (app *ChainlinkApplication) SimulateJobV2(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, stubs pipeline.TaskStubs) (*pipeline.RunTrace, error) {
+	return pipeline.SimulateRun(ctx, app.pipelineRunner, spec, pipeline.NewVarsFrom(vars), stubs)
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -1015,3 +1036,96 @@ func (app *ChainlinkApplication) DeleteLogPollerDataAfter(ctx context.Context, c
 
 	return nil
 }
+
+// ExportLogPollerData - write a snapshot of LogPoller's finalized logs in the specified block range
+func (app *ChainlinkApplication) ExportLogPollerData(ctx context.Context, chainID *big.Int, w io.Writer, start, end int64) error {
+	lp, closeClient, err := app.dialLogPoller(ctx, chainID, "ExportLogPollerData")
+	if err != nil {
+		return err
+	}
+	defer closeClient()
+
+	if err = lp.ExportLogs(ctx, w, start, end); err != nil {
+		return fmt.Errorf("failed to export LogPoller data: %w", err)
+	}
+	return nil
+}
+
+// ImportLogPollerData - import the logs of a LogPoller snapshot, validating its blocks against the chain
+func (app *ChainlinkApplication) ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.Reader) (int, error) {
+	lp, closeClient, err := app.dialLogPoller(ctx, chainID, "ImportLogPollerData")
+	if err != nil {
+		return 0, err
+	}
+	defer closeClient()
+
+	imported, err := lp.ImportLogs(ctx, r)
+	if err != nil {
+		return imported, fmt.Errorf("failed to import LogPoller data: %w", err)
+	}
+	return imported, nil
+}
+
+// LogPollerFilter is a filter registered with a LogPoller, with the storage used by its logs.
+type LogPollerFilter struct {
+	logpoller.FilterStats
+	// JobIDs are the jobs which may own the filter, as they watch one of its addresses.
+	JobIDs []int32
+}
+
+// LogPollerFilters - the filters registered with the chain's LogPoller, with the storage used by their logs and their owner jobs
+func (app *ChainlinkApplication) LogPollerFilters(ctx context.Context, chainID *big.Int) ([]LogPollerFilter, error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !app.Config.Feature().LogPoller() {
+		return nil, fmt.Errorf("LogPollerFilters is only available if LogPoller is enabled")
+	}
+
+	stats, err := chain.LogPoller().FilterStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LogPoller filter stats: %w", err)
+	}
+	var addresses []common.Address
+	for _, s := range stats {
+		for _, address := range s.Addresses {
+			if !slices.Contains(addresses, address) {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	contractJobs, err := app.jobORM.FindJobIDsByEVMContracts(ctx, ubig.New(chainID), addresses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find jobs: %w", err)
+	}
+
+	filters := make([]LogPollerFilter, len(stats))
+	for i, s := range stats {
+		filters[i].FilterStats = s
+		for _, address := range s.Addresses {
+			for _, id := range contractJobs[address] {
+				if !slices.Contains(filters[i].JobIDs, id) {
+					filters[i].JobIDs = append(filters[i].JobIDs, id)
+				}
+			}
+		}
+	}
+	return filters, nil
+}
+
+// dialLogPoller returns the chain's LogPoller with its client dialed, since the application is not started when
+// LogPoller data is exported or imported.
+func (app *ChainlinkApplication) dialLogPoller(ctx context.Context, chainID *big.Int, method string) (logpoller.LogPoller, func(), error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	if !app.Config.Feature().LogPoller() {
+		return nil, nil, fmt.Errorf("%s is only available if LogPoller is enabled", method)
+	}
+	if err = chain.Client().Dial(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to dial chain client: %w", err)
+	}
+	return chain.LogPoller(), chain.Client().Close, nil
+}
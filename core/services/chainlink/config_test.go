@@ -530,13 +530,23 @@ func TestConfig_Marshal(t *testing.T) {
 				BalanceMonitor: evmcfg.BalanceMonitor{
 					Enabled: ptr(true),
 				},
-				BlockBackfillDepth:   ptr[uint32](100),
-				BlockBackfillSkip:    ptr(true),
-				ChainType:            chaintype.NewConfig("Optimism"),
-				FinalityDepth:        ptr[uint32](42),
-				FinalityTagEnabled:   ptr[bool](true),
-				FlagsContractAddress: mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
-				FinalizedBlockOffset: ptr[uint32](16),
+				Funding: evmcfg.Funding{
+					Enabled:        ptr(true),
+					TreasuryKey:    mustAddress("0x0Bc2B8f2BA14aD1C3C8d8c9B3fB7e8E20a0f19e2"),
+					MinBalance:     assets.Ether(1),
+					TargetBalance:  assets.Ether(5),
+					SweepEnabled:   ptr(true),
+					SweepThreshold: assets.Ether(10),
+				},
+				BlockBackfillDepth:    ptr[uint32](100),
+				BlockBackfillSkip:     ptr(true),
+				ChainType:             chaintype.NewConfig("Optimism"),
+				FinalityDepth:         ptr[uint32](42),
+				FinalityTagEnabled:    ptr[bool](true),
+				FinalityBlockTag:      ptr("safe"),
+				FinalityHybridEnabled: ptr(true),
+				FlagsContractAddress:  mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
+				FinalizedBlockOffset:  ptr[uint32](16),
 
 				GasEstimator: evmcfg.GasEstimator{
 					Mode:               ptr("SuggestedPrice"),
@@ -577,6 +587,10 @@ func TestConfig_Marshal(t *testing.T) {
 					FeeHistory: evmcfg.FeeHistoryEstimator{
 						CacheTimeout: &second,
 					},
+					Mempool: evmcfg.MempoolEstimator{
+						Blocks:     ptr[uint16](3),
+						PollPeriod: commoncfg.MustNewDuration(30 * time.Second),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -585,6 +599,10 @@ func TestConfig_Marshal(t *testing.T) {
 						GasEstimator: evmcfg.KeySpecificGasEstimator{
 							PriceMax: assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFFFFFFFFFFFFFF")),
 						},
+						Funding: evmcfg.KeySpecificFunding{
+							MinBalance:    assets.Ether(2),
+							TargetBalance: assets.Ether(3),
+						},
 					},
 				},
 
@@ -594,6 +612,7 @@ func TestConfig_Marshal(t *testing.T) {
 				LogKeepBlocksDepth:           ptr[uint32](100000),
 				LogPrunePageSize:             ptr[uint32](0),
 				BackupLogPollerBlockDelay:    ptr[uint64](532),
+				LogSubscriptionEnabled:       ptr(true),
 				MinContractPayment:           commonassets.NewLinkFromJuels(math.MaxInt64),
 				MinIncomingConfirmations:     ptr[uint32](13),
 				NonceAutoSync:                ptr(true),
@@ -605,15 +624,24 @@ func TestConfig_Marshal(t *testing.T) {
 				NoNewFinalizedHeadsThreshold: &hour,
 
 				Transactions: evmcfg.Transactions{
-					MaxInFlight:          ptr[uint32](19),
-					MaxQueued:            ptr[uint32](99),
-					ReaperInterval:       &minute,
-					ReaperThreshold:      &minute,
-					ResendAfterThreshold: &hour,
-					ForwardersEnabled:    ptr(true),
+					MaxInFlight:             ptr[uint32](19),
+					MaxQueued:               ptr[uint32](99),
+					ReaperInterval:          &minute,
+					ReaperThreshold:         &minute,
+					ResendAfterThreshold:    &hour,
+					SimulateBeforeBroadcast: ptr(true),
+					ForwardersEnabled:       ptr(true),
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					Budget: evmcfg.TxBudget{
+						Enabled:   ptr(true),
+						Mode:      ptr("Defer"),
+						KeyHourly: assets.GWei(100_000_000),
+						KeyDaily:  assets.Ether(1),
+						JobHourly: assets.GWei(50_000_000),
+						JobDaily:  assets.GWei(500_000_000),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -625,6 +653,11 @@ func TestConfig_Marshal(t *testing.T) {
 					PersistenceEnabled:      ptr(false),
 				},
 
+				FinalityProvider: evmcfg.FinalityProvider{
+					Type:            ptr(""),
+					L1Confirmations: ptr[uint32](32),
+				},
+
 				NodePool: evmcfg.NodePool{
 					PollFailureThreshold:       ptr[uint32](5),
 					PollInterval:               &minute,
@@ -653,6 +686,16 @@ func TestConfig_Marshal(t *testing.T) {
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
 					},
+					HedgedReads: evmcfg.HedgedReads{
+						Enabled:           ptr(true),
+						LatencyPercentile: ptr[uint8](90),
+						MinDelay:          &second,
+					},
+					QuorumReads: evmcfg.QuorumReads{
+						Enabled: ptr(true),
+						Nodes:   ptr[uint32](5),
+						Quorum:  ptr[uint32](3),
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -1027,6 +1070,8 @@ BlockBackfillSkip = true
 ChainType = 'Optimism'
 FinalityDepth = 42
 FinalityTagEnabled = true
+FinalityBlockTag = 'safe'
+FinalityHybridEnabled = true
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
@@ -1034,6 +1079,7 @@ LogPollInterval = '1m0s'
 LogKeepBlocksDepth = 100000
 LogPrunePageSize = 0
 BackupLogPollerBlockDelay = 532
+LogSubscriptionEnabled = true
 MinIncomingConfirmations = 13
 MinContractPayment = '9.223372036854775807 link'
 NonceAutoSync = true
@@ -1052,13 +1098,30 @@ MaxQueued = 99
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
+SimulateBeforeBroadcast = true
 
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.Budget]
+Enabled = true
+Mode = 'Defer'
+KeyHourly = '100 milli'
+KeyDaily = '1 ether'
+JobHourly = '50 milli'
+JobDaily = '500 milli'
+
 [EVM.BalanceMonitor]
 Enabled = true
 
+[EVM.Funding]
+Enabled = true
+TreasuryKey = '0x0Bc2B8f2BA14aD1C3C8d8c9B3fB7e8E20a0f19e2'
+MinBalance = '1 ether'
+TargetBalance = '5 ether'
+SweepEnabled = true
+SweepThreshold = '10 ether'
+
 [EVM.GasEstimator]
 Mode = 'SuggestedPrice'
 PriceDefault = '9.223372036854775807 ether'
@@ -1097,6 +1160,10 @@ TransactionPercentile = 15
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
 
+[EVM.GasEstimator.Mempool]
+Blocks = 3
+PollPeriod = '30s'
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -1105,12 +1172,20 @@ MaxAllowedFinalityDepth = 1500
 FinalityTagBypass = false
 PersistenceEnabled = false
 
+[EVM.FinalityProvider]
+Type = ''
+L1Confirmations = 32
+
 [[EVM.KeySpecific]]
 Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
 
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
 
+[EVM.KeySpecific.Funding]
+MinBalance = '2 ether'
+TargetBalance = '3 ether'
+
 [EVM.NodePool]
 PollFailureThreshold = 5
 PollInterval = '1m0s'
@@ -1140,6 +1215,16 @@ Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
 
+[EVM.NodePool.HedgedReads]
+Enabled = true
+LatencyPercentile = 90
+MinDelay = '1s'
+
+[EVM.NodePool.QuorumReads]
+Enabled = true
+Nodes = 5
+Quorum = 3
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1365,7 +1450,7 @@ func TestConfig_Validate(t *testing.T) {
 		- 1: 10 errors:
 			- ChainType: invalid value (Foo): must not be set with this chain id
 			- Nodes: missing: must have at least one node
-			- ChainType: invalid value (Foo): must be one of arbitrum, astar, celo, gnosis, hedera, kroma, mantle, metis, optimismBedrock, scroll, wemix, xlayer, zkevm, zksync or omitted
+			- ChainType: invalid value (Foo): must be one of arbitrum, astar, celo, gnosis, hedera, kroma, linea, mantle, metis, optimismBedrock, scroll, taiko, wemix, xlayer, zkevm, zksync or omitted
 			- HeadTracker.HistoryDepth: invalid value (30): must be greater than or equal to FinalizedBlockOffset
 			- GasEstimator.BumpThreshold: invalid value (0): cannot be 0 if auto-purge feature is enabled for Foo
 			- Transactions.AutoPurge.Threshold: missing: needs to be set if auto-purge feature is enabled for Foo
@@ -1375,12 +1460,20 @@ func TestConfig_Validate(t *testing.T) {
 				- PriceMax: invalid value (1 gwei): must be greater than or equal to PriceDefault
 			- HeadTracker.MaxAllowedFinalityDepth: invalid value (0): must be greater than or equal to 1
 			- KeySpecific.Key: invalid value (0xde709f2102306220921060314715629080e2fb77): duplicate - must be unique
-		- 2: 5 errors:
+		- 2: 11 errors:
 			- ChainType: invalid value (Arbitrum): only "optimismBedrock" can be used with this chain id
 			- Nodes: missing: must have at least one node
-			- ChainType: invalid value (Arbitrum): must be one of arbitrum, astar, celo, gnosis, hedera, kroma, mantle, metis, optimismBedrock, scroll, wemix, xlayer, zkevm, zksync or omitted
+			- ChainType: invalid value (Arbitrum): must be one of arbitrum, astar, celo, gnosis, hedera, kroma, linea, mantle, metis, optimismBedrock, scroll, taiko, wemix, xlayer, zkevm, zksync or omitted
 			- FinalityDepth: invalid value (0): must be greater than or equal to 1
+			- FinalityBlockTag: invalid value (pending): must be finalized or safe
+			- FinalityProvider.Type: invalid value (ArbitrumL1Batch): must be used with ChainType arbitrum
 			- MinIncomingConfirmations: invalid value (0): must be greater than or equal to 1
+			- KeySpecific.Funding.TargetBalance: invalid value (1 ether): must be less than Funding.SweepThreshold for key 0xde709f2102306220921060314715629080e2fb77
+			- Transactions.Budget.Mode: invalid value (Drop): must be Reject or Defer
+			- Funding: 2 errors:
+				- TreasuryKey: missing: must be set when Enabled
+				- TargetBalance: invalid value (50 milli): must be greater than MinBalance
+			- FinalityProvider.L1Confirmations: invalid value (0): must be greater than or equal to 1
 		- 3.Nodes: 5 errors:
 				- 0: 3 errors:
 					- Name: missing: required for all nodes
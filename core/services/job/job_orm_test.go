@@ -659,6 +659,37 @@ func TestORM_CreateJob_OCRBootstrap(t *testing.T) {
 	cltest.AssertCount(t, db, "jobs", 0)
 }
 
+func TestORM_CreateJob_PipelineTemplates(t *testing.T) {
+	ctx := testutils.Context(t)
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db)
+	jobORM := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore)
+
+	spec := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource = """
+price [type=include name="median" vars=<{"value": 42}>]
+"""
+`
+	jb, err := webhook.ValidatedWebhookSpec(ctx, spec, nil)
+	require.NoError(t, err)
+	require.ErrorContains(t, jobORM.CreateJob(ctx, &jb), "failed to expand the pipeline templates")
+	cltest.AssertCount(t, db, "jobs", 0)
+
+	_, err = pipelineORM.CreateTemplate(ctx, "median", `median [type=memo value="{{ .value }}"]`)
+	require.NoError(t, err)
+	jb, err = webhook.ValidatedWebhookSpec(ctx, spec, nil)
+	require.NoError(t, err)
+	require.NoError(t, jobORM.CreateJob(ctx, &jb))
+	cltest.AssertCount(t, db, "jobs", 1)
+}
+
 func TestORM_CreateJob_EVMChainID_Validation(t *testing.T) {
 	config := configtest.NewGeneralConfig(t, nil)
 	db := pgtest.NewSqlxDB(t)
@@ -1259,6 +1290,25 @@ func Test_FindJob(t *testing.T) {
 		assert.Equal(t, job.ID, jbID)
 	})
 
+	t.Run("by evm contracts", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		commonAddr := jobSameAddress.OCROracleSpec.ContractAddress.Address()
+		ocr2Addr := common.HexToAddress(jobOCR2.OCR2OracleSpec.ContractID)
+		otherAddr := testutils.NewAddress()
+
+		jobIDs, err2 := orm.FindJobIDsByEVMContracts(ctx, jobSameAddress.OCROracleSpec.EVMChainID, []common.Address{commonAddr, ocr2Addr, otherAddr})
+		require.NoError(t, err2)
+		assert.Equal(t, map[common.Address][]int32{commonAddr: {jobSameAddress.ID}}, jobIDs)
+
+		jobIDs, err2 = orm.FindJobIDsByEVMContracts(ctx, big.NewI(0), []common.Address{ocr2Addr, otherAddr})
+		require.NoError(t, err2)
+		assert.Equal(t, map[common.Address][]int32{ocr2Addr: {jobOCR2.ID}}, jobIDs)
+
+		jobIDs, err2 = orm.FindJobIDsByEVMContracts(ctx, big.NewI(0), nil)
+		require.NoError(t, err2)
+		assert.Empty(t, jobIDs)
+	})
+
 	t.Run("by contract id without feed id", func(t *testing.T) {
 		ctx := testutils.Context(t)
 		contractID := "0x613a38AC1659769640aaE063C651F48E0250454C"
@@ -657,6 +657,66 @@ func (_c *ORM_FindJobIDByWorkflow_Call) RunAndReturn(run func(context.Context, j
 	return _c
 }
 
+// FindJobIDsByEVMContracts provides a mock function with given fields: ctx, evmChainID, addresses
+func (_m *ORM) FindJobIDsByEVMContracts(ctx context.Context, evmChainID *big.Big, addresses []common.Address) (map[common.Address][]int32, error) {
+	ret := _m.Called(ctx, evmChainID, addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobIDsByEVMContracts")
+	}
+
+	var r0 map[common.Address][]int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Big, []common.Address) (map[common.Address][]int32, error)); ok {
+		return rf(ctx, evmChainID, addresses)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Big, []common.Address) map[common.Address][]int32); ok {
+		r0 = rf(ctx, evmChainID, addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[common.Address][]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Big, []common.Address) error); ok {
+		r1 = rf(ctx, evmChainID, addresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobIDsByEVMContracts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobIDsByEVMContracts'
+type ORM_FindJobIDsByEVMContracts_Call struct {
+	*mock.Call
+}
+
+// FindJobIDsByEVMContracts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - evmChainID *big.Big
+//   - addresses []common.Address
+func (_e *ORM_Expecter) FindJobIDsByEVMContracts(ctx interface{}, evmChainID interface{}, addresses interface{}) *ORM_FindJobIDsByEVMContracts_Call {
+	return &ORM_FindJobIDsByEVMContracts_Call{Call: _e.mock.On("FindJobIDsByEVMContracts", ctx, evmChainID, addresses)}
+}
+
+func (_c *ORM_FindJobIDsByEVMContracts_Call) Run(run func(ctx context.Context, evmChainID *big.Big, addresses []common.Address)) *ORM_FindJobIDsByEVMContracts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Big), args[2].([]common.Address))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobIDsByEVMContracts_Call) Return(_a0 map[common.Address][]int32, _a1 error) *ORM_FindJobIDsByEVMContracts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobIDsByEVMContracts_Call) RunAndReturn(run func(context.Context, *big.Big, []common.Address) (map[common.Address][]int32, error)) *ORM_FindJobIDsByEVMContracts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindJobIDsWithBridge provides a mock function with given fields: ctx, name
 func (_m *ORM) FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error) {
 	ret := _m.Called(ctx, name)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -51,6 +52,7 @@ type ORM interface {
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobByExternalJobID(ctx context.Context, uuid uuid.UUID) (Job, error)
 	FindJobIDByAddress(ctx context.Context, address evmtypes.EIP55Address, evmChainID *big.Big) (int32, error)
+	FindJobIDsByEVMContracts(ctx context.Context, evmChainID *big.Big, addresses []common.Address) (map[common.Address][]int32, error)
 	FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (int32, error)
 	FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32) error
@@ -169,6 +171,14 @@ func (o *orm) AssertBridgesExist(ctx context.Context, p pipeline.Pipeline) error
 // Scans all persisted records back into jb
 func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 	p := jb.Pipeline
+	if p.HasIncludes() && o.pipelineORM != nil {
+		// the include tasks are stored as is, so that they are expanded with the latest templates on every run
+		expanded, err := pipeline.ParseWithTemplates(p.Source, o.pipelineORM.Templates())
+		if err != nil {
+			return errors.Wrap(err, "failed to expand the pipeline templates")
+		}
+		p = *expanded
+	}
 	if err := o.AssertBridgesExist(ctx, p); err != nil {
 		return err
 	}
@@ -1019,6 +1029,57 @@ WHERE ocrspec.id IS NOT NULL OR fmspec.id IS NOT NULL
 	return
 }
 
+// FindJobIDsByEVMContracts returns the ids of the jobs watching each of the contracts on the EVM chain, e.g. to find the
+// jobs owning the LogPoller filters of these contracts.
+func (o *orm) FindJobIDsByEVMContracts(ctx context.Context, evmChainID *big.Big, addresses []common.Address) (map[common.Address][]int32, error) {
+	jobIDs := make(map[common.Address][]int32)
+	if len(addresses) == 0 {
+		return jobIDs, nil
+	}
+	byteAddresses := make(pq.ByteaArray, len(addresses))
+	hexAddresses := make([]string, len(addresses))
+	for i, address := range addresses {
+		byteAddresses[i] = address.Bytes()
+		hexAddresses[i] = strings.ToLower(address.Hex())
+	}
+	stmt := `
+SELECT job_id, address FROM (
+	SELECT jobs.id AS job_id, spec.contract_address AS address, spec.evm_chain_id FROM jobs JOIN ocr_oracle_specs spec ON spec.id = jobs.ocr_oracle_spec_id
+	UNION ALL
+	SELECT jobs.id, spec.contract_address, spec.evm_chain_id FROM jobs JOIN direct_request_specs spec ON spec.id = jobs.direct_request_spec_id
+	UNION ALL
+	SELECT jobs.id, spec.contract_address, spec.evm_chain_id FROM jobs JOIN flux_monitor_specs spec ON spec.id = jobs.flux_monitor_spec_id
+	UNION ALL
+	SELECT jobs.id, spec.contract_address, spec.evm_chain_id FROM jobs JOIN keeper_specs spec ON spec.id = jobs.keeper_spec_id
+	UNION ALL
+	SELECT jobs.id, unnest(ARRAY[spec.coordinator_address, spec.batch_coordinator_address]), spec.evm_chain_id FROM jobs JOIN vrf_specs spec ON spec.id = jobs.vrf_spec_id
+	UNION ALL
+	SELECT jobs.id, unnest(ARRAY[spec.coordinator_v1_address, spec.coordinator_v2_address, spec.coordinator_v2_plus_address]), spec.evm_chain_id FROM jobs JOIN blockhash_store_specs spec ON spec.id = jobs.blockhash_store_spec_id
+	UNION ALL
+	SELECT jobs.id, unnest(ARRAY[spec.coordinator_v1_address, spec.coordinator_v2_address, spec.coordinator_v2_plus_address]), spec.evm_chain_id FROM jobs JOIN block_header_feeder_specs spec ON spec.id = jobs.block_header_feeder_spec_id
+	UNION ALL
+	SELECT jobs.id, spec.off_ramp_address, spec.evm_chain_id FROM jobs JOIN legacy_gas_station_sidecar_specs spec ON spec.id = jobs.legacy_gas_station_sidecar_spec_id
+) contracts
+WHERE address = ANY($1) AND (evm_chain_id = $2 OR evm_chain_id IS NULL)
+UNION
+SELECT jobs.id, decode(substring(spec.contract_id FROM 3), 'hex') FROM jobs JOIN ocr2_oracle_specs spec ON spec.id = jobs.ocr2_oracle_spec_id
+WHERE spec.relay = $3 AND spec.relay_config->>'chainID' = $4 AND lower(spec.contract_id) = ANY($5)
+ORDER BY job_id`
+	var rows []struct {
+		JobID   int32  `db:"job_id"`
+		Address []byte `db:"address"`
+	}
+	err := o.ds.SelectContext(ctx, &rows, stmt, byteAddresses, evmChainID, relay.NetworkEVM, evmChainID.String(), pq.Array(hexAddresses))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindJobIDsByEVMContracts failed")
+	}
+	for _, row := range rows {
+		address := common.BytesToAddress(row.Address)
+		jobIDs[address] = append(jobIDs[address], row.JobID)
+	}
+	return jobIDs, nil
+}
+
 func (o *orm) FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (jobID int32, err error) {
 	// NOTE: We want to explicitly match on NULL feed_id hence usage of `IS
 	// NOT DISTINCT FROM` instead of `=`
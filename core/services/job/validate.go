@@ -5,6 +5,8 @@ import (
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 var (
@@ -75,3 +77,34 @@ func ValidateSpec(ts string) (Type, error) {
 
 	return jb.Type, nil
 }
+
+// ValidatedPipelineSpec returns the pipeline spec of a job, e.g. to simulate its runs without creating it.
+// Only the common fields are validated, since the pipeline doesn't depend on the type specific ones.
+func ValidatedPipelineSpec(ts string) (spec pipeline.Spec, err error) {
+	jobType, err := ValidateSpec(ts)
+	if err != nil {
+		return spec, err
+	}
+	var jb Job
+	tree, err := toml.Load(ts)
+	if err != nil {
+		return spec, err
+	}
+	if err = tree.Unmarshal(&jb); err != nil {
+		return spec, err
+	}
+	if jb.Pipeline.Source == "" {
+		return spec, ErrNoPipelineSpec
+	}
+	spec = pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jobType),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return spec, nil
+}
@@ -2,6 +2,7 @@ package job
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -112,3 +113,34 @@ ds [type=http]
 		})
 	}
 }
+
+func TestValidatedPipelineSpec(t *testing.T) {
+	t.Run("returns the pipeline spec", func(t *testing.T) {
+		spec, err := ValidatedPipelineSpec(`
+type="webhook"
+schemaVersion=1
+name="my job"
+gasLimit=1000
+forwardingAllowed=true
+maxTaskDuration="10s"
+observationSource="""
+ds [type=http]
+"""
+`)
+		require.NoError(t, err)
+		require.Equal(t, "ds [type=http]\n", spec.DotDagSource)
+		require.Equal(t, "my job", spec.JobName)
+		require.Equal(t, "webhook", spec.JobType)
+		require.Equal(t, uint32(1000), *spec.GasLimit)
+		require.True(t, spec.ForwardingAllowed)
+		require.Equal(t, 10*time.Second, spec.MaxTaskDuration.Duration())
+	})
+
+	t.Run("requires a pipeline", func(t *testing.T) {
+		_, err := ValidatedPipelineSpec(`
+type="bootstrap"
+schemaVersion=1
+`)
+		require.ErrorIs(t, err, ErrNoPipelineSpec)
+	})
+}
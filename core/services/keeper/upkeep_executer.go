@@ -271,6 +271,7 @@ func buildJobSpec(
 	return map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"jobID":                  jb.ID,
+			"externalJobID":          jb.ExternalJobID,
 			"fromAddress":            upkeep.Registry.FromAddress.String(),
 			"effectiveKeeperAddress": effectiveKeeperAddress.String(),
 			"contractAddress":        upkeep.Registry.ContractAddress.String(),
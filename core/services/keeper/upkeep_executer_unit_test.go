@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -28,7 +29,8 @@ func TestBuildJobSpec(t *testing.T) {
 	contract := types.EIP55Address(testutils.NewAddress().Hex())
 	chainID := "250"
 	jb := job.Job{
-		ID: 10,
+		ID:            10,
+		ExternalJobID: uuid.New(),
 		KeeperSpec: &job.KeeperSpec{
 			FromAddress:     from,
 			ContractAddress: contract,
@@ -58,6 +60,7 @@ func TestBuildJobSpec(t *testing.T) {
 	expected := map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"jobID":                  int32(10),
+			"externalJobID":          jb.ExternalJobID,
 			"fromAddress":            from.String(),
 			"effectiveKeeperAddress": jb.KeeperSpec.FromAddress.String(),
 			"contractAddress":        contract.String(),
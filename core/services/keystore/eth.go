@@ -11,9 +11,11 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/transmission/generated/entry_point"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -35,6 +37,7 @@ type Eth interface {
 	SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, unsub func())
 
 	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignUserOperation(ctx context.Context, address common.Address, op entry_point.UserOperation, entryPoint common.Address, chainID *big.Int) ([]byte, error)
 
 	EnabledKeysForChain(ctx context.Context, chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -321,6 +324,25 @@ func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Tra
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
+// SignUserOperation returns the [R || S || V] signature of an ERC-4337 v0.6 user operation of a Chainlink smart
+// contract account (SCA), as verified by SCA.validateUserOp, with V as 0 or 1. The signature of op is ignored.
+func (ks *eth) SignUserOperation(ctx context.Context, address common.Address, op entry_point.UserOperation, entryPoint common.Address, chainID *big.Int) ([]byte, error) {
+	hash, err := SCAUserOperationHash(op, entryPoint, chainID)
+	if err != nil {
+		return nil, err
+	}
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(address.String())
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(hash[:], key.ToEcdsaPrivKey())
+}
+
 // EnabledKeysForChain returns all keys that are enabled for the given chain
 func (ks *eth) EnabledKeysForChain(ctx context.Context, chainID *big.Int) (sendingKeys []ethkey.KeyV2, err error) {
 	if chainID == nil {
@@ -589,3 +611,30 @@ func (ks *eth) notify() {
 		}
 	}
 }
+
+var (
+	// See SCALibrary.sol
+	scaDomainSeparator = common.HexToHash("0x1c7d3b72b37a35523e273aaadd7b4cd66f618bb81429ab053412d51f50ccea61")
+	scaTypeHash        = common.HexToHash("0x4750045d47fce615521b32cee713ff8db50147e98aec5ca94926b52651ca3fa0")
+)
+
+// SCAUserOperationHash returns the hash of a user operation, which is signed by the owner of the sending Chainlink smart
+// contract account, see SCALibrary._getUserOpFullHash. The signature of op is ignored.
+func SCAUserOperationHash(op entry_point.UserOperation, entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	entryPointABI, err := entry_point.EntryPointMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	op.Signature = nil
+	enc, err := entryPointABI.Methods["getUserOpHash"].Inputs.Pack(op)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode user operation: %w", err)
+	}
+	// UserOperationLib.pack hashes the encoding of the user operation up to its signature, which is empty, i.e. without
+	// the offset of the encoding and the length of the signature
+	packed := enc[32 : len(enc)-32]
+	userOpHash := crypto.Keccak256(crypto.Keccak256(packed), common.LeftPadBytes(entryPoint[:], 32), common.LeftPadBytes(chainID.Bytes(), 32))
+
+	hashOfEncoding := crypto.Keccak256(scaTypeHash[:], userOpHash)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, scaDomainSeparator[:], common.LeftPadBytes(chainID.Bytes(), 32), op.Sender[:], hashOfEncoding), nil
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +18,7 @@ import (
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/transmission/generated/entry_point"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
@@ -357,6 +359,46 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignUserOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+
+	k, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	entryPoint := testutils.NewAddress()
+	op := entry_point.UserOperation{
+		Sender:               testutils.NewAddress(),
+		Nonce:                big.NewInt(1),
+		CallData:             []byte{1, 2, 3},
+		CallGasLimit:         big.NewInt(100_000),
+		VerificationGasLimit: big.NewInt(100_000),
+		PreVerificationGas:   big.NewInt(50_000),
+		MaxFeePerGas:         big.NewInt(10),
+		MaxPriorityFeePerGas: big.NewInt(1),
+	}
+
+	_, err := ethKeyStore.SignUserOperation(ctx, testutils.NewAddress(), op, entryPoint, testutils.FixtureChainID)
+	require.EqualError(t, err, "Key not found")
+
+	sig, err := ethKeyStore.SignUserOperation(ctx, k.Address, op, entryPoint, testutils.FixtureChainID)
+	require.NoError(t, err)
+	hash, err := keystore.SCAUserOperationHash(op, entryPoint, testutils.FixtureChainID)
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(hash[:], sig)
+	require.NoError(t, err)
+	require.Equal(t, k.Address, crypto.PubkeyToAddress(*pub))
+
+	// the signature of the user operation is not signed
+	op.Signature = []byte{4, 5, 6}
+	sig2, err := ethKeyStore.SignUserOperation(ctx, k.Address, op, entryPoint, testutils.FixtureChainID)
+	require.NoError(t, err)
+	require.Equal(t, sig, sig2)
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,8 @@ import (
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	entry_point "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/transmission/generated/entry_point"
+
 	ethkey "github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 
 	mock "github.com/stretchr/testify/mock"
@@ -1143,6 +1145,68 @@ func (_c *Eth_SignTx_Call) RunAndReturn(run func(context.Context, common.Address
 	return _c
 }
 
+// SignUserOperation provides a mock function with given fields: ctx, address, op, entryPoint, chainID
+func (_m *Eth) SignUserOperation(ctx context.Context, address common.Address, op entry_point.UserOperation, entryPoint common.Address, chainID *big.Int) ([]byte, error) {
+	ret := _m.Called(ctx, address, op, entryPoint, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignUserOperation")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, entry_point.UserOperation, common.Address, *big.Int) ([]byte, error)); ok {
+		return rf(ctx, address, op, entryPoint, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, entry_point.UserOperation, common.Address, *big.Int) []byte); ok {
+		r0 = rf(ctx, address, op, entryPoint, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, entry_point.UserOperation, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, address, op, entryPoint, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Eth_SignUserOperation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignUserOperation'
+type Eth_SignUserOperation_Call struct {
+	*mock.Call
+}
+
+// SignUserOperation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - op entry_point.UserOperation
+//   - entryPoint common.Address
+//   - chainID *big.Int
+func (_e *Eth_Expecter) SignUserOperation(ctx interface{}, address interface{}, op interface{}, entryPoint interface{}, chainID interface{}) *Eth_SignUserOperation_Call {
+	return &Eth_SignUserOperation_Call{Call: _e.mock.On("SignUserOperation", ctx, address, op, entryPoint, chainID)}
+}
+
+func (_c *Eth_SignUserOperation_Call) Run(run func(ctx context.Context, address common.Address, op entry_point.UserOperation, entryPoint common.Address, chainID *big.Int)) *Eth_SignUserOperation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(entry_point.UserOperation), args[3].(common.Address), args[4].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Eth_SignUserOperation_Call) Return(_a0 []byte, _a1 error) *Eth_SignUserOperation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Eth_SignUserOperation_Call) RunAndReturn(run func(context.Context, common.Address, entry_point.UserOperation, common.Address, *big.Int) ([]byte, error)) *Eth_SignUserOperation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SubscribeToKeyChanges provides a mock function with given fields: ctx
 func (_m *Eth) SubscribeToKeyChanges(ctx context.Context) (chan struct{}, func()) {
 	ret := _m.Called(ctx)
@@ -28,8 +28,10 @@ type UpkeepOffchainConfig struct {
 }
 
 // CheckGasPrice retrieves the current gas price and compare against the max gas price configured in upkeep's offchain config
-// any errors in offchain config decoding will result in max gas price check disabled
-func CheckGasPrice(ctx context.Context, upkeepId *big.Int, offchainConfigBytes []byte, ge gas.EvmFeeEstimator, lggr logger.Logger) encoding.UpkeepFailureReason {
+// any errors in offchain config decoding will result in max gas price check disabled.
+// On rollups, the L1 data fee of the perform data is spread over the gas allocated to the upkeep, so that the max gas
+// price caps the full cost of the perform.
+func CheckGasPrice(ctx context.Context, upkeepId *big.Int, offchainConfigBytes []byte, performData []byte, gasAllocated uint64, ge gas.EvmFeeEstimator, lggr logger.Logger) encoding.UpkeepFailureReason {
 	// check for empty offchain config
 	if len(offchainConfigBytes) == 0 {
 		return encoding.UpkeepFailureReasonNone
@@ -46,29 +48,31 @@ func CheckGasPrice(ctx context.Context, upkeepId *big.Int, offchainConfigBytes [
 	}
 	lggr.Debugf("successfully decode offchain config for %s, max gas price is %s", upkeepId.String(), offchainConfig.MaxGasPrice.String())
 
-	fee, _, err := ge.GetFee(ctx, []byte{}, feeLimit, assets.NewWei(big.NewInt(maxFeePrice)), nil, nil)
+	gasLimit := gasAllocated
+	if gasLimit == 0 {
+		gasLimit = feeLimit
+	}
+	cost, err := ge.GetTotalCost(ctx, performData, gasLimit, assets.NewWei(big.NewInt(maxFeePrice)), nil, nil)
 	if err != nil {
 		lggr.Errorw("failed to get fee, gas price check is disabled", "upkeepId", upkeepId.String(), "err", err)
 		return encoding.UpkeepFailureReasonNone
 	}
 
-	if fee.ValidDynamic() {
-		lggr.Debugf("current gas price EIP-1559 is fee cap %s, tip cap %s", fee.DynamicFeeCap.String(), fee.DynamicTipCap.String())
-		if fee.DynamicFeeCap.Cmp(assets.NewWei(offchainConfig.MaxGasPrice)) > 0 {
-			// current gas price is higher than max gas price
-			lggr.Warnf("maxGasPrice %s for %s is LOWER than current gas price %d", offchainConfig.MaxGasPrice.String(), upkeepId.String(), fee.DynamicFeeCap.Int64())
-			return encoding.UpkeepFailureReasonGasPriceTooHigh
-		}
-		lggr.Debugf("maxGasPrice %s for %s is HIGHER than current gas price %d", offchainConfig.MaxGasPrice.String(), upkeepId.String(), fee.DynamicFeeCap.Int64())
+	if cost.Fee.ValidDynamic() {
+		lggr.Debugf("current gas price EIP-1559 is fee cap %s, tip cap %s, L1 data fee %s", cost.Fee.DynamicFeeCap.String(), cost.Fee.DynamicTipCap.String(), cost.L1DataFee.String())
 	} else {
-		lggr.Debugf("current gas price legacy is %s", fee.Legacy.String())
-		if fee.Legacy.Cmp(assets.NewWei(offchainConfig.MaxGasPrice)) > 0 {
-			// current gas price is higher than max gas price
-			lggr.Warnf("maxGasPrice %s for %s is LOWER than current gas price %d", offchainConfig.MaxGasPrice.String(), upkeepId.String(), fee.Legacy.Int64())
-			return encoding.UpkeepFailureReasonGasPriceTooHigh
-		}
-		lggr.Debugf("maxGasPrice %s for %s is HIGHER than current gas price %d", offchainConfig.MaxGasPrice.String(), upkeepId.String(), fee.Legacy.Int64())
+		lggr.Debugf("current gas price legacy is %s, L1 data fee %s", cost.Fee.Legacy.String(), cost.L1DataFee.String())
+	}
+	gasPrice := cost.Total()
+	if cost.FeeLimit > 0 {
+		gasPrice = assets.NewWei(new(big.Int).Div(gasPrice.ToInt(), new(big.Int).SetUint64(cost.FeeLimit)))
+	}
+	if gasPrice.Cmp(assets.NewWei(offchainConfig.MaxGasPrice)) > 0 {
+		// current gas price is higher than max gas price
+		lggr.Warnf("maxGasPrice %s for %s is LOWER than current gas price %s", offchainConfig.MaxGasPrice.String(), upkeepId.String(), gasPrice.ToInt().String())
+		return encoding.UpkeepFailureReasonGasPriceTooHigh
 	}
+	lggr.Debugf("maxGasPrice %s for %s is HIGHER than current gas price %s", offchainConfig.MaxGasPrice.String(), upkeepId.String(), gasPrice.ToInt().String())
 
 	return encoding.UpkeepFailureReasonNone
 }
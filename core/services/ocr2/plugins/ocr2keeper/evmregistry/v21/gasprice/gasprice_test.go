@@ -24,12 +24,15 @@ type WrongOffchainConfig struct {
 func TestGasPrice_Check(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	uid, _ := new(big.Int).SetString("1843548457736589226156809205796175506139185429616502850435279853710366065936", 10)
+	performData := []byte{1, 2, 3}
+	gasAllocated := uint64(500_000)
 
 	tests := []struct {
 		Name                   string
 		MaxGasPrice            *big.Int
 		CurrentLegacyGasPrice  *big.Int
 		CurrentDynamicGasPrice *big.Int
+		L1DataFeePerGas        *big.Int
 		ExpectedResult         encoding.UpkeepFailureReason
 		FailedToGetFee         bool
 		NotConfigured          bool
@@ -80,32 +83,55 @@ func TestGasPrice_Check(t *testing.T) {
 			CurrentDynamicGasPrice: big.NewInt(8_000_000_000),
 			ExpectedResult:         encoding.UpkeepFailureReasonNone,
 		},
+		{
+			Name:                  "current gas price with the L1 data fee is too high - legacy",
+			MaxGasPrice:           big.NewInt(8_000_000_000),
+			CurrentLegacyGasPrice: big.NewInt(5_000_000_000),
+			L1DataFeePerGas:       big.NewInt(4_000_000_000),
+			ExpectedResult:        encoding.UpkeepFailureReasonGasPriceTooHigh,
+		},
+		{
+			Name:                   "current gas price with the L1 data fee is less than user's max gas price - dynamic",
+			MaxGasPrice:            big.NewInt(10_000_000_000),
+			CurrentDynamicGasPrice: big.NewInt(8_000_000_000),
+			L1DataFeePerGas:        big.NewInt(1_000_000_000),
+			ExpectedResult:         encoding.UpkeepFailureReasonNone,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			ctx := testutils.Context(t)
 			ge := gasMocks.NewEvmFeeEstimator(t)
+			totalCost := func(fee gas.EvmFee, price *big.Int) gas.TotalCost {
+				l1DataFee := assets.NewWeiI(0)
+				if test.L1DataFeePerGas != nil {
+					l1DataFee = assets.NewWei(new(big.Int).Mul(test.L1DataFeePerGas, big.NewInt(int64(gasAllocated))))
+				}
+				return gas.TotalCost{
+					Fee:       fee,
+					FeeLimit:  gasAllocated,
+					L2Fee:     assets.NewWei(new(big.Int).Mul(price, big.NewInt(int64(gasAllocated)))),
+					L1DataFee: l1DataFee,
+				}
+			}
 			if test.FailedToGetFee {
-				ge.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
-					gas.EvmFee{},
-					feeLimit,
+				ge.On("GetTotalCost", mock.Anything, performData, gasAllocated, mock.Anything, mock.Anything, mock.Anything).Return(
+					gas.TotalCost{},
 					errors.New("failed to retrieve gas price"),
 				)
 			} else if test.CurrentLegacyGasPrice != nil {
-				ge.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
-					gas.EvmFee{
+				ge.On("GetTotalCost", mock.Anything, performData, gasAllocated, mock.Anything, mock.Anything, mock.Anything).Return(
+					totalCost(gas.EvmFee{
 						Legacy: assets.NewWei(test.CurrentLegacyGasPrice),
-					},
-					feeLimit,
+					}, test.CurrentLegacyGasPrice),
 					nil,
 				)
 			} else if test.CurrentDynamicGasPrice != nil {
-				ge.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
-					gas.EvmFee{
+				ge.On("GetTotalCost", mock.Anything, performData, gasAllocated, mock.Anything, mock.Anything, mock.Anything).Return(
+					totalCost(gas.EvmFee{
 						DynamicFeeCap: assets.NewWei(test.CurrentDynamicGasPrice),
 						DynamicTipCap: assets.NewWei(big.NewInt(1_000_000_000)),
-					},
-					feeLimit,
+					}, test.CurrentDynamicGasPrice),
 					nil,
 				)
 			}
@@ -121,7 +147,7 @@ func TestGasPrice_Check(t *testing.T) {
 			} else if test.MaxGasPrice != nil {
 				oc, _ = cbor.Marshal(UpkeepOffchainConfig{MaxGasPrice: test.MaxGasPrice})
 			}
-			fr := CheckGasPrice(ctx, uid, oc, ge, lggr)
+			fr := CheckGasPrice(ctx, uid, oc, performData, gasAllocated, ge, lggr)
 			assert.Equal(t, test.ExpectedResult, fr)
 		})
 	}
@@ -319,7 +319,7 @@ func (r *EvmRegistry) simulatePerformUpkeeps(ctx context.Context, checkResults [
 			// this is mostly caused by RPC flakiness
 			r.lggr.Errorw("failed get offchain config, gas price check will be disabled", "err", err, "upkeepId", upkeepId, "block", block)
 		}
-		fr := gasprice.CheckGasPrice(ctx, upkeepId, oc, r.ge, r.lggr)
+		fr := gasprice.CheckGasPrice(ctx, upkeepId, oc, cr.PerformData, cr.GasAllocated, r.ge, r.lggr)
 		if uint8(fr) == uint8(encoding.UpkeepFailureReasonGasPriceTooHigh) {
 			r.lggr.Debugf("upkeep %s upkeep failure reason is %d", upkeepId, fr)
 			checkResults[i].Eligible = false
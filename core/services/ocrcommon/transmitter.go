@@ -125,6 +125,7 @@ func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         types.TxPriorityHigh,
 	})
 	return errors.Wrap(err, "skipped OCR transmission")
 }
@@ -162,6 +163,7 @@ func (t *ocr2FeedsTransmitter) CreateEthTransaction(ctx context.Context, toAddre
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         types.TxPriorityHigh,
 	})
 
 	return errors.Wrap(err, "skipped OCR transmission")
@@ -208,6 +208,8 @@ type TaskRunResult struct {
 	FinishedAt null.Time
 	// runInfo is never persisted
 	runInfo RunInfo
+	// nestedTaskRuns are the task runs of a map task's iterations
+	nestedTaskRuns []TaskRun
 }
 
 func (result *TaskRunResult) IsPending() bool {
@@ -308,15 +310,18 @@ const (
 	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
+	TaskTypeExpr             TaskType = "expr"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
+	TaskTypeInclude          TaskType = "include"
 	TaskTypeJSONParse        TaskType = "jsonparse"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMap              TaskType = "map"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
@@ -425,6 +430,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &Base64DecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeBase64Encode:
 		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExpressionTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMap:
+		task = &MapTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeInclude:
+		task = &IncludeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, pkgerrors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -474,6 +485,16 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		}
 	}
 
+	switch t := task.(type) {
+	case *ExpressionTask:
+		err = t.compile()
+	case *MapTask:
+		err = t.compile()
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	return task, nil
 }
 
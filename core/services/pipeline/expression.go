@@ -0,0 +1,1212 @@
+package pipeline
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// This file implements the small expression language evaluated by the expr task. Expressions are side effect free:
+// they can only read pipeline Vars and call the built-in functions below. Numbers are always decimal.Decimal, so
+// arithmetic never loses precision to floats.
+//
+// Grammar, from lowest to highest precedence:
+//
+//	x if cond else y, cond ? x : y
+//	||, or
+//	&&, and
+//	==, !=
+//	<, <=, >, >=, in, not in
+//	+, -
+//	*, /, %
+//	-x, !x, not x
+//	x[index], f(args...)
+//
+// Identifiers are keypaths into the pipeline Vars, e.g. ds1 or ds1.data.0, and can also be written as $(ds1.data.0).
+
+var (
+	ErrExpressionSyntax = errors.New("expression syntax error")
+	ErrExpressionType   = errors.New("expression type error")
+)
+
+const (
+	maxExpressionLength = 4096
+	maxExpressionDepth  = 64
+	maxPowExponent      = 1024
+	// maxPowBaseDigits bounds the digits and the scale of pow bases, 78 digits fit any uint256.
+	maxPowBaseDigits = 78
+)
+
+// exprType is a bitmask of the types an expression may evaluate to, used for static type checking.
+type exprType uint8
+
+const (
+	exprNumber exprType = 1 << iota
+	exprString
+	exprBool
+	exprList
+	exprMap
+	exprNull
+
+	exprAny = exprNumber | exprString | exprBool | exprList | exprMap | exprNull
+)
+
+func (t exprType) String() string {
+	if t == exprAny {
+		return "any"
+	}
+	var names []string
+	for _, n := range []struct {
+		t    exprType
+		name string
+	}{{exprNumber, "number"}, {exprString, "string"}, {exprBool, "bool"}, {exprList, "list"}, {exprMap, "map"}, {exprNull, "null"}} {
+		if t&n.t != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Expression is a parsed and type checked expression, which can be evaluated against pipeline Vars.
+type Expression struct {
+	source string
+	root   exprNode
+}
+
+// ParseExpression parses and statically type checks the given expression.
+// Vars are only known at run time, so they are assumed to be of any type.
+func ParseExpression(source string) (*Expression, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, errors.Wrap(ErrExpressionSyntax, "empty expression")
+	} else if len(source) > maxExpressionLength {
+		return nil, errors.Wrapf(ErrExpressionSyntax, "expression is longer than %d characters", maxExpressionLength)
+	}
+	tokens, err := lexExpression(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, errors.Wrapf(ErrExpressionSyntax, "unexpected %q at position %d", tok.text, tok.pos)
+	}
+	if _, err = root.check(); err != nil {
+		return nil, err
+	}
+	return &Expression{source: source, root: root}, nil
+}
+
+// Evaluate evaluates the expression against vars. Numbers are returned as decimal.Decimal and lists as []interface{}.
+func (e *Expression) Evaluate(vars Vars) (interface{}, error) {
+	return e.root.eval(vars)
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Lexer
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokVar
+	tokOp
+)
+
+type exprToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func lexExpression(src string) ([]exprToken, error) {
+	var tokens []exprToken
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isDigit(c) || (c == '.' && i+1 < len(src) && isDigit(src[i+1])):
+			start := i
+			for i < len(src) && (isDigit(src[i]) || src[i] == '.') {
+				i++
+			}
+			if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+				j := i + 1
+				if j < len(src) && (src[j] == '+' || src[j] == '-') {
+					j++
+				}
+				if j < len(src) && isDigit(src[j]) {
+					for i = j; i < len(src) && isDigit(src[i]); i++ {
+					}
+				}
+			}
+			tokens = append(tokens, exprToken{tokNumber, src[start:i], start})
+		case c == '"' || c == '\'':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(src) && src[i] != c; i++ {
+				if src[i] == '\\' && i+1 < len(src) {
+					i++
+					switch src[i] {
+					case 'n':
+						sb.WriteByte('\n')
+					case 't':
+						sb.WriteByte('\t')
+					default:
+						sb.WriteByte(src[i])
+					}
+					continue
+				}
+				sb.WriteByte(src[i])
+			}
+			if i >= len(src) {
+				return nil, errors.Wrapf(ErrExpressionSyntax, "unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, exprToken{tokString, sb.String(), start})
+		case c == '$':
+			start := i
+			end := strings.IndexByte(src[i:], ')')
+			if !strings.HasPrefix(src[i:], "$(") || end < 0 {
+				return nil, errors.Wrapf(ErrExpressionSyntax, "malformed variable at position %d", start)
+			}
+			keypath := strings.TrimSpace(src[i+2 : i+end])
+			if !variableRegexp.MatchString("$(" + keypath + ")") {
+				return nil, errors.Wrapf(ErrExpressionSyntax, "malformed variable %q at position %d", src[i:i+end+1], start)
+			}
+			i += end + 1
+			tokens = append(tokens, exprToken{tokVar, keypath, start})
+		case isIdentStart(c):
+			start := i
+			for i < len(src) && isIdentPart(src[i]) {
+				i++
+			}
+			// keypath segments, which may also be slice indexes
+			for i+1 < len(src) && src[i] == '.' && isIdentPart(src[i+1]) {
+				for i++; i < len(src) && isIdentPart(src[i]); i++ {
+				}
+			}
+			tokens = append(tokens, exprToken{tokIdent, src[start:i], start})
+		default:
+			if i+1 < len(src) {
+				switch op := src[i : i+2]; op {
+				case "==", "!=", "<=", ">=", "&&", "||":
+					tokens = append(tokens, exprToken{tokOp, op, i})
+					i += 2
+					continue
+				}
+			}
+			if !strings.ContainsRune("+-*/%()[],?:!<>", rune(c)) {
+				r, _ := utf8.DecodeRuneInString(src[i:])
+				return nil, errors.Wrapf(ErrExpressionSyntax, "unexpected character %q at position %d", r, i)
+			}
+			tokens = append(tokens, exprToken{tokOp, string(c), i})
+			i++
+		}
+	}
+	return append(tokens, exprToken{tokEOF, "end of expression", len(src)}), nil
+}
+
+func isDigit(c byte) bool      { return c >= '0' && c <= '9' }
+func isIdentStart(c byte) bool { return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+func isIdentPart(c byte) bool  { return isIdentStart(c) || isDigit(c) }
+
+// Parser
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+	depth  int
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token if it is one of the given operators or keywords.
+func (p *exprParser) accept(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != tokOp && tok.kind != tokIdent {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *exprParser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		tok := p.peek()
+		return errors.Wrapf(ErrExpressionSyntax, "expected %q but got %q at position %d", op, tok.text, tok.pos)
+	}
+	return nil
+}
+
+func (p *exprParser) parseExpr() (exprNode, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return nil, errors.Wrapf(ErrExpressionSyntax, "expression is nested deeper than %d levels", maxExpressionDepth)
+	}
+
+	x, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.accept("if"); !ok {
+		return x, nil
+	}
+	cond, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect("else"); err != nil {
+		return nil, err
+	}
+	y, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &exprConditional{cond: cond, then: x, otherwise: y}, nil
+}
+
+func (p *exprParser) parseTernary() (exprNode, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.accept("?"); !ok {
+		return cond, nil
+	}
+	x, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(":"); err != nil {
+		return nil, err
+	}
+	y, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &exprConditional{cond: cond, then: x, otherwise: y}, nil
+}
+
+// binaryPrecedence lists the binary operators from lowest to highest precedence.
+var binaryPrecedence = [][]string{
+	{"||", "or"},
+	{"&&", "and"},
+	{"==", "!="},
+	{"<", "<=", ">", ">=", "in", "not"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(binaryPrecedence) {
+		return p.parseUnary()
+	}
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		start := p.pos
+		op, ok := p.accept(binaryPrecedence[level]...)
+		if !ok {
+			return x, nil
+		}
+		switch op {
+		case "or":
+			op = "||"
+		case "and":
+			op = "&&"
+		case "not":
+			if _, ok = p.accept("in"); !ok {
+				p.pos = start
+				return x, nil
+			}
+			op = "not in"
+		}
+		y, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &exprBinary{op: op, x: x, y: y}
+	}
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	op, ok := p.accept("-", "!", "not")
+	if !ok {
+		return p.parsePostfix()
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return nil, errors.Wrapf(ErrExpressionSyntax, "expression is nested deeper than %d levels", maxExpressionDepth)
+	}
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if op == "not" {
+		op = "!"
+	}
+	return &exprUnary{op: op, x: x}, nil
+}
+
+func (p *exprParser) parsePostfix() (exprNode, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("["); !ok {
+			return x, nil
+		}
+		index, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect("]"); err != nil {
+			return nil, err
+		}
+		x = &exprIndex{x: x, index: index}
+	}
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokNumber:
+		d, err := decimal.NewFromString(tok.text)
+		if err != nil {
+			return nil, errors.Wrapf(ErrExpressionSyntax, "invalid number %q at position %d", tok.text, tok.pos)
+		}
+		return &exprLiteral{value: d, typ: exprNumber}, nil
+	case tokString:
+		return &exprLiteral{value: tok.text, typ: exprString}, nil
+	case tokVar:
+		return &exprVar{keypath: tok.text}, nil
+	case tokIdent:
+		switch tok.text {
+		case "true", "false":
+			return &exprLiteral{value: tok.text == "true", typ: exprBool}, nil
+		case "null":
+			return &exprLiteral{value: nil, typ: exprNull}, nil
+		case "if", "else", "and", "or", "not", "in":
+			return nil, errors.Wrapf(ErrExpressionSyntax, "unexpected %q at position %d", tok.text, tok.pos)
+		}
+		if _, ok := p.accept("("); !ok {
+			return &exprVar{keypath: tok.text}, nil
+		}
+		fn, ok := exprFunctions[tok.text]
+		if !ok {
+			return nil, errors.Wrapf(ErrExpressionSyntax, "unknown function %q at position %d", tok.text, tok.pos)
+		}
+		args, err := p.parseList(")")
+		if err != nil {
+			return nil, err
+		}
+		return &exprCall{name: tok.text, fn: fn, args: args}, nil
+	case tokOp:
+		switch tok.text {
+		case "(":
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expect(")")
+		case "[":
+			elems, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &exprListLiteral{elems: elems}, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrExpressionSyntax, "unexpected %q at position %d", tok.text, tok.pos)
+}
+
+func (p *exprParser) parseList(closing string) ([]exprNode, error) {
+	var elems []exprNode
+	if _, ok := p.accept(closing); ok {
+		return elems, nil
+	}
+	for {
+		x, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, x)
+		if _, ok := p.accept(closing); ok {
+			return elems, nil
+		}
+		if err = p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// AST
+
+type exprNode interface {
+	// check returns the possible types of the node, or an error if it can never be evaluated successfully.
+	check() (exprType, error)
+	eval(vars Vars) (interface{}, error)
+}
+
+type exprLiteral struct {
+	value interface{}
+	typ   exprType
+}
+
+func (n *exprLiteral) check() (exprType, error) { return n.typ, nil }
+
+func (n *exprLiteral) eval(Vars) (interface{}, error) { return n.value, nil }
+
+type exprVar struct {
+	keypath string
+}
+
+func (n *exprVar) check() (exprType, error) { return exprAny, nil }
+
+func (n *exprVar) eval(vars Vars) (interface{}, error) {
+	v, err := vars.Get(n.keypath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "variable %s", n.keypath)
+	}
+	return exprUnwrap(v), nil
+}
+
+// exprUnwrap returns the underlying value of the ObjectParams output by tasks like memo.
+func exprUnwrap(v interface{}) interface{} {
+	o, ok := v.(ObjectParam)
+	if !ok {
+		return v
+	}
+	switch o.Type {
+	case BoolType:
+		return bool(o.BoolValue)
+	case DecimalType:
+		return o.DecimalValue.Decimal()
+	case StringType:
+		return string(o.StringValue)
+	case SliceType:
+		return []interface{}(o.SliceValue)
+	case MapType:
+		return map[string]interface{}(o.MapValue)
+	}
+	return nil
+}
+
+type exprListLiteral struct {
+	elems []exprNode
+}
+
+func (n *exprListLiteral) check() (exprType, error) {
+	for _, elem := range n.elems {
+		if _, err := elem.check(); err != nil {
+			return 0, err
+		}
+	}
+	return exprList, nil
+}
+
+func (n *exprListLiteral) eval(vars Vars) (interface{}, error) {
+	list := make([]interface{}, len(n.elems))
+	for i, elem := range n.elems {
+		v, err := elem.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = v
+	}
+	return list, nil
+}
+
+type exprUnary struct {
+	op string
+	x  exprNode
+}
+
+func (n *exprUnary) check() (exprType, error) {
+	t, err := n.x.check()
+	if err != nil {
+		return 0, err
+	}
+	if n.op == "-" {
+		return exprNumber, expectType("operand of -", t, exprNumber)
+	}
+	return exprBool, expectType("operand of !", t, exprBool)
+}
+
+func (n *exprUnary) eval(vars Vars) (interface{}, error) {
+	v, err := n.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "-" {
+		d, err := exprToDecimal(v)
+		if err != nil {
+			return nil, err
+		}
+		return d.Neg(), nil
+	}
+	b, err := exprToBool(v)
+	if err != nil {
+		return nil, err
+	}
+	return !b, nil
+}
+
+type exprBinary struct {
+	op   string
+	x, y exprNode
+}
+
+func (n *exprBinary) check() (exprType, error) {
+	tx, err := n.x.check()
+	if err != nil {
+		return 0, err
+	}
+	ty, err := n.y.check()
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case "||", "&&":
+		return exprBool, firstErr(expectType("left operand of "+n.op, tx, exprBool), expectType("right operand of "+n.op, ty, exprBool))
+	case "==", "!=":
+		return exprBool, nil
+	case "<", "<=", ">", ">=":
+		return exprBool, firstErr(expectType("left operand of "+n.op, tx, exprNumber|exprString), expectType("right operand of "+n.op, ty, exprNumber|exprString))
+	case "in", "not in":
+		return exprBool, expectType("right operand of "+n.op, ty, exprList|exprString|exprMap)
+	case "+":
+		t := tx & ty & (exprNumber | exprString)
+		if t == 0 {
+			return 0, errors.Wrapf(ErrExpressionType, "cannot add %v and %v", tx, ty)
+		}
+		return t, nil
+	default:
+		return exprNumber, firstErr(expectType("left operand of "+n.op, tx, exprNumber), expectType("right operand of "+n.op, ty, exprNumber))
+	}
+}
+
+func (n *exprBinary) eval(vars Vars) (interface{}, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	// short-circuit the logical operators
+	if n.op == "||" || n.op == "&&" {
+		bx, err := exprToBool(x)
+		if err != nil {
+			return nil, err
+		}
+		if bx == (n.op == "||") {
+			return bx, nil
+		}
+		y, err := n.y.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		return exprToBool(y)
+	}
+	y, err := n.y.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return exprEqual(x, y), nil
+	case "!=":
+		return !exprEqual(x, y), nil
+	case "<", "<=", ">", ">=":
+		cmp, err := exprCompare(x, y)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case "<":
+			return cmp < 0, nil
+		case "<=":
+			return cmp <= 0, nil
+		case ">":
+			return cmp > 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+	case "in", "not in":
+		found, err := exprContains(y, x)
+		if err != nil {
+			return nil, err
+		}
+		return found == (n.op == "in"), nil
+	case "+":
+		sx, okx := x.(string)
+		sy, oky := y.(string)
+		if okx && oky {
+			return sx + sy, nil
+		}
+	}
+
+	dx, err := exprToDecimal(x)
+	if err != nil {
+		return nil, err
+	}
+	dy, err := exprToDecimal(y)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "+":
+		return dx.Add(dy), nil
+	case "-":
+		return dx.Sub(dy), nil
+	case "*":
+		return dx.Mul(dy), nil
+	case "/":
+		if dy.IsZero() {
+			return nil, ErrDivideByZero
+		}
+		// Note that decimal library defaults to rounding to 16 precision, the same as the divide task
+		return dx.Div(dy), nil
+	case "%":
+		if dy.IsZero() {
+			return nil, ErrDivideByZero
+		}
+		return dx.Mod(dy), nil
+	}
+	return nil, errors.Errorf("unknown operator %s", n.op)
+}
+
+type exprConditional struct {
+	cond, then, otherwise exprNode
+}
+
+func (n *exprConditional) check() (exprType, error) {
+	tc, err := n.cond.check()
+	if err != nil {
+		return 0, err
+	}
+	if err = expectType("condition", tc, exprBool); err != nil {
+		return 0, err
+	}
+	tx, err := n.then.check()
+	if err != nil {
+		return 0, err
+	}
+	ty, err := n.otherwise.check()
+	if err != nil {
+		return 0, err
+	}
+	return tx | ty, nil
+}
+
+func (n *exprConditional) eval(vars Vars) (interface{}, error) {
+	v, err := n.cond.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	cond, err := exprToBool(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "condition")
+	}
+	if cond {
+		return n.then.eval(vars)
+	}
+	return n.otherwise.eval(vars)
+}
+
+type exprIndex struct {
+	x, index exprNode
+}
+
+func (n *exprIndex) check() (exprType, error) {
+	tx, err := n.x.check()
+	if err != nil {
+		return 0, err
+	}
+	ti, err := n.index.check()
+	if err != nil {
+		return 0, err
+	}
+	return exprAny, firstErr(expectType("indexed value", tx, exprList|exprMap), expectType("index", ti, exprNumber|exprString))
+}
+
+func (n *exprIndex) eval(vars Vars) (interface{}, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := x.(map[string]interface{}); ok {
+		key, ok := index.(string)
+		if !ok {
+			return nil, errors.Wrapf(ErrBadInput, "map index must be a string, got %T", index)
+		}
+		v, exists := m[key]
+		if !exists {
+			return nil, errors.Wrapf(ErrKeypathNotFound, "key %v", key)
+		}
+		return v, nil
+	}
+	list, err := exprToList(x)
+	if err != nil {
+		return nil, err
+	}
+	d, err := exprToDecimal(index)
+	if err != nil {
+		return nil, err
+	}
+	if !d.IsInteger() || d.Sign() < 0 || d.Cmp(decimal.NewFromInt(int64(len(list)))) >= 0 {
+		return nil, errors.Wrapf(ErrIndexOutOfRange, "index %v out of range for list of length %d", d, len(list))
+	}
+	return list[d.IntPart()], nil
+}
+
+type exprCall struct {
+	name string
+	fn   exprFunction
+	args []exprNode
+}
+
+func (n *exprCall) check() (exprType, error) {
+	if len(n.args) < n.fn.minArgs || (!n.fn.variadic && len(n.args) > len(n.fn.args)) {
+		return 0, errors.Wrapf(ErrExpressionType, "wrong number of arguments for %s: got %d", n.name, len(n.args))
+	}
+	for i, arg := range n.args {
+		t, err := arg.check()
+		if err != nil {
+			return 0, err
+		}
+		expected := n.fn.args[min(i, len(n.fn.args)-1)]
+		if err = expectType(fmt.Sprintf("argument %d of %s", i+1, n.name), t, expected); err != nil {
+			return 0, err
+		}
+	}
+	return n.fn.result, nil
+}
+
+func (n *exprCall) eval(vars Vars) (interface{}, error) {
+	args := make([]interface{}, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	v, err := n.fn.call(args)
+	return v, errors.Wrap(err, n.name)
+}
+
+func expectType(what string, actual, expected exprType) error {
+	if actual&expected == 0 {
+		return errors.Wrapf(ErrExpressionType, "%s must be %v, got %v", what, expected, actual)
+	}
+	return nil
+}
+
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Built-in functions
+
+type exprFunction struct {
+	// args are the accepted types of each argument, the last one repeats if variadic
+	args     []exprType
+	minArgs  int
+	variadic bool
+	result   exprType
+	call     func(args []interface{}) (interface{}, error)
+}
+
+var exprFunctions map[string]exprFunction
+
+func init() {
+	numberFn := func(f func(decimal.Decimal) decimal.Decimal) exprFunction {
+		return exprFunction{args: []exprType{exprNumber}, minArgs: 1, result: exprNumber, call: func(args []interface{}) (interface{}, error) {
+			d, err := exprToDecimal(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return f(d), nil
+		}}
+	}
+	stringFn := func(f func(string) string) exprFunction {
+		return exprFunction{args: []exprType{exprString}, minArgs: 1, result: exprString, call: func(args []interface{}) (interface{}, error) {
+			s, err := exprToString(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return f(s), nil
+		}}
+	}
+	stringPredicate := func(f func(string, string) bool) exprFunction {
+		return exprFunction{args: []exprType{exprString, exprString}, minArgs: 2, result: exprBool, call: func(args []interface{}) (interface{}, error) {
+			s, err := exprToString(args[0])
+			if err != nil {
+				return nil, err
+			}
+			x, err := exprToString(args[1])
+			if err != nil {
+				return nil, err
+			}
+			return f(s, x), nil
+		}}
+	}
+	listFn := func(f func([]decimal.Decimal) (interface{}, error)) exprFunction {
+		return exprFunction{args: []exprType{exprList}, minArgs: 1, result: exprNumber, call: func(args []interface{}) (interface{}, error) {
+			ds, err := exprToDecimals(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return f(ds)
+		}}
+	}
+	// min and max accept either numbers or a single list of numbers
+	extremumFn := func(sign int) exprFunction {
+		return exprFunction{args: []exprType{exprNumber | exprList}, minArgs: 1, variadic: true, result: exprNumber, call: func(args []interface{}) (interface{}, error) {
+			var ds []decimal.Decimal
+			if len(args) == 1 {
+				if _, err := exprToList(args[0]); err == nil {
+					var err2 error
+					if ds, err2 = exprToDecimals(args[0]); err2 != nil {
+						return nil, err2
+					}
+				}
+			}
+			if ds == nil {
+				for _, arg := range args {
+					d, err := exprToDecimal(arg)
+					if err != nil {
+						return nil, err
+					}
+					ds = append(ds, d)
+				}
+			}
+			if len(ds) == 0 {
+				return nil, errors.Wrap(ErrWrongInputCardinality, "empty list")
+			}
+			res := ds[0]
+			for _, d := range ds[1:] {
+				if d.Cmp(res) == sign {
+					res = d
+				}
+			}
+			return res, nil
+		}}
+	}
+
+	exprFunctions = map[string]exprFunction{
+		"abs":   numberFn(decimal.Decimal.Abs),
+		"floor": numberFn(decimal.Decimal.Floor),
+		"ceil":  numberFn(decimal.Decimal.Ceil),
+		"min":   extremumFn(-1),
+		"max":   extremumFn(1),
+		"round": {args: []exprType{exprNumber, exprNumber}, minArgs: 1, result: exprNumber, call: func(args []interface{}) (interface{}, error) {
+			d, err := exprToDecimal(args[0])
+			if err != nil {
+				return nil, err
+			}
+			places := int32(0)
+			if len(args) > 1 {
+				p, err := exprToInt(args[1], -maxPowExponent, maxPowExponent)
+				if err != nil {
+					return nil, err
+				}
+				places = int32(p)
+			}
+			return d.Round(places), nil
+		}},
+		"pow": {args: []exprType{exprNumber, exprNumber}, minArgs: 2, result: exprNumber, call: func(args []interface{}) (interface{}, error) {
+			base, err := exprToDecimal(args[0])
+			if err != nil {
+				return nil, err
+			}
+			if base.NumDigits() > maxPowBaseDigits || base.Exponent() > maxPowBaseDigits || base.Exponent() < -maxPowBaseDigits {
+				return nil, errors.Wrapf(ErrBadInput, "expected a base of at most %d digits, got %v", maxPowBaseDigits, base)
+			}
+			exp, err := exprToInt(args[1], -maxPowExponent, maxPowExponent)
+			if err != nil {
+				return nil, err
+			}
+			return base.PowInt32(int32(exp))
+		}},
+		"sum": listFn(func(ds []decimal.Decimal) (interface{}, error) {
+			return decimal.Sum(decimal.Zero, ds...), nil
+		}),
+		"avg": listFn(func(ds []decimal.Decimal) (interface{}, error) {
+			if len(ds) == 0 {
+				return nil, errors.Wrap(ErrWrongInputCardinality, "empty list")
+			}
+			return decimal.Avg(ds[0], ds[1:]...), nil
+		}),
+		"median": listFn(func(ds []decimal.Decimal) (interface{}, error) {
+			if len(ds) == 0 {
+				return nil, errors.Wrap(ErrWrongInputCardinality, "empty list")
+			}
+			sort.Slice(ds, func(i, j int) bool { return ds[i].LessThan(ds[j]) })
+			if len(ds)%2 == 1 {
+				return ds[len(ds)/2], nil
+			}
+			return decimal.Avg(ds[len(ds)/2-1], ds[len(ds)/2]), nil
+		}),
+		"len": {args: []exprType{exprString | exprList | exprMap}, minArgs: 1, result: exprNumber, call: func(args []interface{}) (interface{}, error) {
+			switch v := args[0].(type) {
+			case string:
+				return decimal.NewFromInt(int64(utf8.RuneCountInString(v))), nil
+			case map[string]interface{}:
+				return decimal.NewFromInt(int64(len(v))), nil
+			}
+			list, err := exprToList(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return decimal.NewFromInt(int64(len(list))), nil
+		}},
+		"contains": {args: []exprType{exprString | exprList | exprMap, exprAny}, minArgs: 2, result: exprBool, call: func(args []interface{}) (interface{}, error) {
+			return exprContains(args[0], args[1])
+		}},
+		"lower":      stringFn(strings.ToLower),
+		"upper":      stringFn(strings.ToUpper),
+		"trim":       stringFn(strings.TrimSpace),
+		"startsWith": stringPredicate(strings.HasPrefix),
+		"endsWith":   stringPredicate(strings.HasSuffix),
+		"split": {args: []exprType{exprString, exprString}, minArgs: 2, result: exprList, call: func(args []interface{}) (interface{}, error) {
+			s, err := exprToString(args[0])
+			if err != nil {
+				return nil, err
+			}
+			sep, err := exprToString(args[1])
+			if err != nil {
+				return nil, err
+			}
+			parts := strings.Split(s, sep)
+			list := make([]interface{}, len(parts))
+			for i, part := range parts {
+				list[i] = part
+			}
+			return list, nil
+		}},
+		"join": {args: []exprType{exprList, exprString}, minArgs: 2, result: exprString, call: func(args []interface{}) (interface{}, error) {
+			list, err := exprToList(args[0])
+			if err != nil {
+				return nil, err
+			}
+			sep, err := exprToString(args[1])
+			if err != nil {
+				return nil, err
+			}
+			parts := make([]string, len(list))
+			for i, v := range list {
+				parts[i] = exprFormat(v)
+			}
+			return strings.Join(parts, sep), nil
+		}},
+		"number": {args: []exprType{exprNumber | exprString}, minArgs: 1, result: exprNumber, call: func(args []interface{}) (interface{}, error) {
+			return exprToDecimal(args[0])
+		}},
+		"string": {args: []exprType{exprAny}, minArgs: 1, result: exprString, call: func(args []interface{}) (interface{}, error) {
+			return exprFormat(args[0]), nil
+		}},
+	}
+}
+
+// Runtime conversions
+
+func exprToDecimal(v interface{}) (decimal.Decimal, error) {
+	d, err := utils.ToDecimal(v)
+	if err != nil {
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "expected a number, got %T (%v)", v, v)
+	}
+	return d, nil
+}
+
+func exprToDecimals(v interface{}) ([]decimal.Decimal, error) {
+	list, err := exprToList(v)
+	if err != nil {
+		return nil, err
+	}
+	ds := make([]decimal.Decimal, len(list))
+	for i, elem := range list {
+		if ds[i], err = exprToDecimal(elem); err != nil {
+			return nil, errors.Wrapf(err, "element %d", i)
+		}
+	}
+	return ds, nil
+}
+
+func exprToInt(v interface{}, lo, hi int64) (int64, error) {
+	d, err := exprToDecimal(v)
+	if err != nil {
+		return 0, err
+	}
+	if !d.IsInteger() || d.LessThan(decimal.NewFromInt(lo)) || d.GreaterThan(decimal.NewFromInt(hi)) {
+		return 0, errors.Wrapf(ErrBadInput, "expected an integer between %d and %d, got %v", lo, hi, d)
+	}
+	return d.IntPart(), nil
+}
+
+func exprToBool(v interface{}) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, errors.Wrapf(ErrBadInput, "expected a bool, got %T (%v)", v, v)
+	}
+	return b, nil
+}
+
+func exprToString(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.Wrapf(ErrBadInput, "expected a string, got %T (%v)", v, v)
+	}
+	return s, nil
+}
+
+func exprToList(v interface{}) ([]interface{}, error) {
+	if list, ok := v.([]interface{}); ok {
+		return list, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, errors.Wrapf(ErrBadInput, "expected a list, got %T (%v)", v, v)
+	}
+	list := make([]interface{}, rv.Len())
+	for i := range list {
+		list[i] = rv.Index(i).Interface()
+	}
+	return list, nil
+}
+
+func exprFormat(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case decimal.Decimal:
+		return x.String()
+	case string:
+		return x
+	}
+	if d, err := utils.ToDecimal(v); err == nil {
+		return d.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// exprEqual compares numbers by value, so 1 == 1.0 == "1" if either side is a number.
+func exprEqual(x, y interface{}) bool {
+	_, sx := x.(string)
+	_, sy := y.(string)
+	if !sx || !sy {
+		dx, errx := utils.ToDecimal(x)
+		dy, erry := utils.ToDecimal(y)
+		if errx == nil && erry == nil {
+			return dx.Equal(dy)
+		}
+	}
+	lx, errx := exprToList(x)
+	ly, erry := exprToList(y)
+	if errx == nil && erry == nil {
+		if len(lx) != len(ly) {
+			return false
+		}
+		for i := range lx {
+			if !exprEqual(lx[i], ly[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// exprCompare compares two strings lexically, and anything else as numbers.
+func exprCompare(x, y interface{}) (int, error) {
+	sx, okx := x.(string)
+	sy, oky := y.(string)
+	if okx && oky {
+		return strings.Compare(sx, sy), nil
+	}
+	dx, err := exprToDecimal(x)
+	if err != nil {
+		return 0, err
+	}
+	dy, err := exprToDecimal(y)
+	if err != nil {
+		return 0, err
+	}
+	return dx.Cmp(dy), nil
+}
+
+func exprContains(haystack, needle interface{}) (bool, error) {
+	switch h := haystack.(type) {
+	case string:
+		s, err := exprToString(needle)
+		if err != nil {
+			return false, err
+		}
+		return strings.Contains(h, s), nil
+	case map[string]interface{}:
+		s, err := exprToString(needle)
+		if err != nil {
+			return false, err
+		}
+		_, exists := h[s]
+		return exists, nil
+	}
+	list, err := exprToList(haystack)
+	if err != nil {
+		return false, err
+	}
+	for _, elem := range list {
+		if exprEqual(elem, needle) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
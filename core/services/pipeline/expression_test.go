@@ -0,0 +1,177 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestExpression_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"a":    "1.5",
+		"b":    float64(4),
+		"c":    int64(3),
+		"d":    true,
+		"name": "Chainlink",
+		"ds1": map[string]interface{}{
+			"prices": []interface{}{"1.1", float64(2.2), int64(3)},
+			"symbol": "LINK",
+		},
+	})
+
+	tests := []struct {
+		name string
+		expr string
+		want interface{}
+	}{
+		{"arithmetic is decimal", "0.1 + 0.2", "0.3"},
+		{"precedence", "1 + 2 * 3 - 4 / 2", "5"},
+		{"parentheses", "(1 + 2) * 3", "9"},
+		{"modulo", "10 % 4", "2"},
+		{"unary minus", "-a * 2", "-3"},
+		{"vars of mixed types", "a * b / c", "2"},
+		{"dollar vars", "$(a) + $(ds1.prices.2)", "4.5"},
+		{"big numbers", "1e18 * 1e18", "1000000000000000000000000000000000000"},
+		{"python style conditional", "a * b / c if d else 0", "2"},
+		{"ternary conditional", "!d ? 1 : 2", "2"},
+		{"nested conditional", "1 if b < 1 else 2 if b < 5 else 3", "2"},
+		{"comparison of numbers", "a < b", true},
+		{"comparison of string and number", "a >= 1.5", true},
+		{"comparison of strings", "'abc' < 'abd'", true},
+		{"numeric equality", "b == 4.0", true},
+		{"string equality", "name != 'Chainlink'", false},
+		{"logical operators", "d && b > 3 || false", true},
+		{"keyword operators", "not d or (b > 3 and c == 3)", true},
+		{"short circuit", "d || missing", true},
+		{"in list", "3 in ds1.prices", true},
+		{"not in list", "4 not in ds1.prices", true},
+		{"in string", "'link' in lower(name)", true},
+		{"in map", "'symbol' in ds1", true},
+		{"string concatenation", "name + ' ' + ds1.symbol", "Chainlink LINK"},
+		{"index list", "ds1.prices[1] * 2", "4.4"},
+		{"index map", "ds1['symbol']", "LINK"},
+		{"list literal", "sum([a, b, c])", "8.5"},
+		{"null", "null", nil},
+		{"abs", "abs(-2.5)", "2.5"},
+		{"min", "min(b, c, a)", "1.5"},
+		{"max of list", "max(ds1.prices)", "3"},
+		{"round", "round(2 / 3, 4)", "0.6667"},
+		{"floor and ceil", "floor(1.5) + ceil(1.5)", "3"},
+		{"pow", "pow(10, 18)", "1000000000000000000"},
+		{"sum", "sum(ds1.prices)", "6.3"},
+		{"avg", "avg(ds1.prices)", "2.1"},
+		{"median", "median([5, 1, 3, 2])", "2.5"},
+		{"len", "len(ds1.prices) + len(name)", "12"},
+		{"contains", "contains(ds1.prices, '1.1')", true},
+		{"upper", "upper(ds1.symbol)", "LINK"},
+		{"trim", "trim('  x ')", "x"},
+		{"startsWith and endsWith", "startsWith(name, 'Chain') && endsWith(name, 'link')", true},
+		{"split", "split('a,b', ',')", []interface{}{"a", "b"}},
+		{"join", "join(ds1.prices, '|')", "1.1|2.2|3"},
+		{"number", "number('1.25') * 4", "5"},
+		{"string", "string(b) + '!'", "4!"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := pipeline.ParseExpression(test.expr)
+			require.NoError(t, err)
+			got, err := expr.Evaluate(vars)
+			require.NoError(t, err)
+			if d, ok := got.(decimal.Decimal); ok {
+				assert.Equal(t, test.want, d.String())
+			} else {
+				assert.Equal(t, test.want, got)
+			}
+		})
+	}
+}
+
+func TestExpression_EvaluateErrors(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"a":    "foo",
+		"zero": 0,
+		"list": []interface{}{1, 2},
+	})
+
+	tests := []struct {
+		name string
+		expr string
+		err  error
+	}{
+		{"missing var", "missing + 1", pipeline.ErrKeypathNotFound},
+		{"not a number", "a * 2", pipeline.ErrBadInput},
+		{"not a bool", "a ? 1 : 2", pipeline.ErrBadInput},
+		{"divide by zero", "1 / zero", pipeline.ErrDivideByZero},
+		{"index out of range", "list[2]", pipeline.ErrIndexOutOfRange},
+		{"empty avg", "avg([])", pipeline.ErrWrongInputCardinality},
+		{"pow exponent too large", "pow(2, 100000)", pipeline.ErrBadInput},
+		{"pow base too large", "pow(1e100, 1024)", pipeline.ErrBadInput},
+		{"pow base too precise", "pow(1.000000000000000000000000000000000000000000000000000000000000000000000000000000000000001, 1024)", pipeline.ErrBadInput},
+		{"pow base too small", "pow(1e-100, -1024)", pipeline.ErrBadInput},
+		{"round places too large", "round(1, 100000)", pipeline.ErrBadInput},
+		{"round places too small", "round(1, -100000)", pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := pipeline.ParseExpression(test.expr)
+			require.NoError(t, err)
+			_, err = expr.Evaluate(vars)
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestParseExpression_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		expr   string
+		err    error
+		errMsg string
+	}{
+		{"empty", " ", pipeline.ErrExpressionSyntax, "empty expression"},
+		{"unbalanced parentheses", "(1 + 2", pipeline.ErrExpressionSyntax, `expected ")"`},
+		{"trailing tokens", "1 2", pipeline.ErrExpressionSyntax, `unexpected "2" at position 2`},
+		{"unterminated string", "'abc", pipeline.ErrExpressionSyntax, "unterminated string"},
+		{"unknown character", "a ; b", pipeline.ErrExpressionSyntax, "unexpected character ';'"},
+		{"unknown function", "exec('rm')", pipeline.ErrExpressionSyntax, `unknown function "exec"`},
+		{"missing else", "1 if a", pipeline.ErrExpressionSyntax, `expected "else"`},
+		{"too deep", "-----------------------------------------------------------------------1", pipeline.ErrExpressionSyntax, "nested deeper"},
+		{"string arithmetic", "'a' * 2", pipeline.ErrExpressionType, "left operand of * must be number, got string"},
+		{"adding string and number", "'a' + 2", pipeline.ErrExpressionType, "cannot add string and number"},
+		{"non bool condition", "1 if 2 else 3", pipeline.ErrExpressionType, "condition must be bool, got number"},
+		{"negating a string", "!'a'", pipeline.ErrExpressionType, "operand of ! must be bool, got string"},
+		{"wrong argument type", "upper(1)", pipeline.ErrExpressionType, "argument 1 of upper must be string, got number"},
+		{"wrong number of arguments", "pow(2)", pipeline.ErrExpressionType, "wrong number of arguments for pow: got 1"},
+		{"function result type", "len(a) + 'x'", pipeline.ErrExpressionType, "cannot add number and string"},
+		{"in number", "1 in 2", pipeline.ErrExpressionType, "right operand of in must be string|list|map, got number"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := pipeline.ParseExpression(test.expr)
+			require.ErrorIs(t, err, test.err)
+			require.ErrorContains(t, err, test.errMsg)
+		})
+	}
+}
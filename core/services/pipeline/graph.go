@@ -19,12 +19,19 @@ import (
 // for us to `dot.Unmarshal(...)` a DOT string directly into it.
 type Graph struct {
 	*simple.DirectedGraph
+	// templates expands include tasks, which are kept as IncludeTasks if nil
+	templates TemplateResolver
 }
 
 func NewGraph() *Graph {
 	return &Graph{DirectedGraph: simple.NewDirectedGraph()}
 }
 
+// NewGraphWithTemplates returns a Graph expanding include tasks with the given templates.
+func NewGraphWithTemplates(templates TemplateResolver) *Graph {
+	return &Graph{DirectedGraph: simple.NewDirectedGraph(), templates: templates}
+}
+
 func (g *Graph) NewNode() graph.Node {
 	return &GraphNode{Node: g.DirectedGraph.NewNode()}
 }
@@ -37,6 +44,19 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	if g.DirectedGraph == nil {
 		g.DirectedGraph = simple.NewDirectedGraph()
 	}
+	if err = g.unmarshalDOT(bs); err != nil {
+		return err
+	}
+	if g.templates != nil {
+		if err = g.expandIncludes(g.templates, 0); err != nil {
+			return err
+		}
+	}
+	g.AddImplicitDependenciesAsEdges()
+	return nil
+}
+
+func (g *Graph) unmarshalDOT(bs []byte) (err error) {
 	defer func() {
 		if rerr := recover(); rerr != nil {
 			err = fmt.Errorf("could not unmarshal DOT into a pipeline.Graph: %v", rerr)
@@ -48,7 +68,6 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "could not unmarshal DOT into a pipeline.Graph")
 	}
-	g.AddImplicitDependenciesAsEdges()
 	return nil
 }
 
@@ -160,6 +179,8 @@ type Pipeline struct {
 	Tasks  []Task
 	tree   *Graph
 	Source string
+	// templates expanded the include tasks when the runner initialized the pipeline, nil otherwise
+	templates *pinnedTemplates
 }
 
 func (p *Pipeline) UnmarshalText(bs []byte) (err error) {
@@ -171,6 +192,24 @@ func (p *Pipeline) UnmarshalText(bs []byte) (err error) {
 	return nil
 }
 
+// HasIncludes returns whether the pipeline was parsed without expanding its include tasks.
+func (p *Pipeline) HasIncludes() bool {
+	for _, task := range p.Tasks {
+		if task.Type() == TaskTypeInclude {
+			return true
+		}
+	}
+	return false
+}
+
+// TemplateVersions returns the versions the include tasks without a version expanded to, see pinnedTemplates.
+func (p *Pipeline) TemplateVersions() TemplateVersions {
+	if p.templates == nil {
+		return nil
+	}
+	return p.templates.Versions()
+}
+
 func (p *Pipeline) MinTimeout() (time.Duration, bool, error) {
 	var minTimeout time.Duration = 1<<63 - 1
 	var aTimeoutSet bool
@@ -208,11 +247,17 @@ func (p *Pipeline) ByDotID(id string) Task {
 	return nil
 }
 
+// Parse parses the pipeline, keeping its include tasks unexpanded.
 func Parse(text string) (*Pipeline, error) {
+	return ParseWithTemplates(text, nil)
+}
+
+// ParseWithTemplates parses the pipeline, expanding its include tasks with the given templates.
+func ParseWithTemplates(text string, templates TemplateResolver) (*Pipeline, error) {
 	if strings.TrimSpace(text) == "" {
 		return nil, errors.New("empty pipeline")
 	}
-	g := NewGraph()
+	g := NewGraphWithTemplates(templates)
 	err := g.UnmarshalText([]byte(text))
 
 	if err != nil {
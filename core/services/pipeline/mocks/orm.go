@@ -180,6 +180,64 @@ func (_c *ORM_CreateSpec_Call) RunAndReturn(run func(context.Context, pipeline.P
 	return _c
 }
 
+// CreateTemplate provides a mock function with given fields: ctx, name, dotDagSource
+func (_m *ORM) CreateTemplate(ctx context.Context, name string, dotDagSource string) (pipeline.Template, error) {
+	ret := _m.Called(ctx, name, dotDagSource)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplate")
+	}
+
+	var r0 pipeline.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (pipeline.Template, error)); ok {
+		return rf(ctx, name, dotDagSource)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) pipeline.Template); ok {
+		r0 = rf(ctx, name, dotDagSource)
+	} else {
+		r0 = ret.Get(0).(pipeline.Template)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, dotDagSource)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type ORM_CreateTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - dotDagSource string
+func (_e *ORM_Expecter) CreateTemplate(ctx interface{}, name interface{}, dotDagSource interface{}) *ORM_CreateTemplate_Call {
+	return &ORM_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", ctx, name, dotDagSource)}
+}
+
+func (_c *ORM_CreateTemplate_Call) Run(run func(ctx context.Context, name string, dotDagSource string)) *ORM_CreateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_CreateTemplate_Call) Return(_a0 pipeline.Template, _a1 error) *ORM_CreateTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_CreateTemplate_Call) RunAndReturn(run func(context.Context, string, string) (pipeline.Template, error)) *ORM_CreateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DataSource provides a mock function with given fields:
 func (_m *ORM) DataSource() sqlutil.DataSource {
 	ret := _m.Called()
@@ -378,6 +436,65 @@ func (_c *ORM_FindRun_Call) RunAndReturn(run func(context.Context, int64) (pipel
 	return _c
 }
 
+// FindTemplates provides a mock function with given fields: ctx, name
+func (_m *ORM) FindTemplates(ctx context.Context, name string) ([]pipeline.Template, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTemplates")
+	}
+
+	var r0 []pipeline.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]pipeline.Template, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []pipeline.Template); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTemplates'
+type ORM_FindTemplates_Call struct {
+	*mock.Call
+}
+
+// FindTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ORM_Expecter) FindTemplates(ctx interface{}, name interface{}) *ORM_FindTemplates_Call {
+	return &ORM_FindTemplates_Call{Call: _e.mock.On("FindTemplates", ctx, name)}
+}
+
+func (_c *ORM_FindTemplates_Call) Run(run func(ctx context.Context, name string)) *ORM_FindTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_FindTemplates_Call) Return(_a0 []pipeline.Template, _a1 error) *ORM_FindTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindTemplates_Call) RunAndReturn(run func(context.Context, string) ([]pipeline.Template, error)) *ORM_FindTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllRuns provides a mock function with given fields: ctx
 func (_m *ORM) GetAllRuns(ctx context.Context) ([]pipeline.Run, error) {
 	ret := _m.Called(ctx)
@@ -436,6 +553,64 @@ func (_c *ORM_GetAllRuns_Call) RunAndReturn(run func(context.Context) ([]pipelin
 	return _c
 }
 
+// GetAllTemplates provides a mock function with given fields: ctx
+func (_m *ORM) GetAllTemplates(ctx context.Context) ([]pipeline.Template, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTemplates")
+	}
+
+	var r0 []pipeline.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]pipeline.Template, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []pipeline.Template); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetAllTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllTemplates'
+type ORM_GetAllTemplates_Call struct {
+	*mock.Call
+}
+
+// GetAllTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ORM_Expecter) GetAllTemplates(ctx interface{}) *ORM_GetAllTemplates_Call {
+	return &ORM_GetAllTemplates_Call{Call: _e.mock.On("GetAllTemplates", ctx)}
+}
+
+func (_c *ORM_GetAllTemplates_Call) Run(run func(ctx context.Context)) *ORM_GetAllTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ORM_GetAllTemplates_Call) Return(_a0 []pipeline.Template, _a1 error) *ORM_GetAllTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetAllTemplates_Call) RunAndReturn(run func(context.Context) ([]pipeline.Template, error)) *ORM_GetAllTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUnfinishedRuns provides a mock function with given fields: _a0, _a1, _a2
 func (_m *ORM) GetUnfinishedRuns(_a0 context.Context, _a1 time.Time, _a2 func(pipeline.Run) error) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -722,6 +897,52 @@ func (_c *ORM_InsertRun_Call) RunAndReturn(run func(context.Context, *pipeline.R
 	return _c
 }
 
+// LoadTemplates provides a mock function with given fields: ctx
+func (_m *ORM) LoadTemplates(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadTemplates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_LoadTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadTemplates'
+type ORM_LoadTemplates_Call struct {
+	*mock.Call
+}
+
+// LoadTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ORM_Expecter) LoadTemplates(ctx interface{}) *ORM_LoadTemplates_Call {
+	return &ORM_LoadTemplates_Call{Call: _e.mock.On("LoadTemplates", ctx)}
+}
+
+func (_c *ORM_LoadTemplates_Call) Run(run func(ctx context.Context)) *ORM_LoadTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ORM_LoadTemplates_Call) Return(_a0 error) *ORM_LoadTemplates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_LoadTemplates_Call) RunAndReturn(run func(context.Context) error) *ORM_LoadTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *ORM) Name() string {
 	ret := _m.Called()
@@ -915,6 +1136,53 @@ func (_c *ORM_StoreRun_Call) RunAndReturn(run func(context.Context, *pipeline.Ru
 	return _c
 }
 
+// Templates provides a mock function with no fields
+func (_m *ORM) Templates() pipeline.TemplateResolver {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Templates")
+	}
+
+	var r0 pipeline.TemplateResolver
+	if rf, ok := ret.Get(0).(func() pipeline.TemplateResolver); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.TemplateResolver)
+		}
+	}
+
+	return r0
+}
+
+// ORM_Templates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Templates'
+type ORM_Templates_Call struct {
+	*mock.Call
+}
+
+// Templates is a helper method to define mock.On call
+func (_e *ORM_Expecter) Templates() *ORM_Templates_Call {
+	return &ORM_Templates_Call{Call: _e.mock.On("Templates")}
+}
+
+func (_c *ORM_Templates_Call) Run(run func()) *ORM_Templates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ORM_Templates_Call) Return(_a0 pipeline.TemplateResolver) *ORM_Templates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_Templates_Call) RunAndReturn(run func() pipeline.TemplateResolver) *ORM_Templates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Transact provides a mock function with given fields: _a0, _a1
 func (_m *ORM) Transact(_a0 context.Context, _a1 func(pipeline.ORM) error) error {
 	ret := _m.Called(_a0, _a1)
@@ -32,6 +32,9 @@ type Spec struct {
 	JobType string `json:"-"`
 
 	Pipeline *Pipeline `json:"-" db:"-"` // This may be nil, or may be populated manually as a cache. There is no locking on this, so be careful
+	// TemplateVersions pins the templates included without a version when the runner initializes the pipeline, e.g.
+	// to replay a recorded run with the versions it expanded
+	TemplateVersions TemplateVersions `json:"-" db:"-"`
 }
 
 func (s *Spec) GetOrParsePipeline() (*Pipeline, error) {
@@ -64,6 +67,8 @@ type Run struct {
 	FinishedAt       null.Time                         `json:"finishedAt"`
 	PipelineTaskRuns []TaskRun                         `json:"taskRuns"`
 	State            RunStatus                         `json:"state"`
+	// TemplateVersions pins the templates of the includes without a version, see pinnedTemplates
+	TemplateVersions TemplateVersions `json:"templateVersions,omitempty"`
 
 	Pending bool
 	// FailSilently is used to signal that a task with the failEarly flag has failed, and we want to not put this in the db
@@ -201,6 +206,27 @@ func (r *Run) StringAllErrors() []*string {
 	return allErrors
 }
 
+// TemplateVersions maps the names of the templates included without a version to the version they expanded to.
+type TemplateVersions map[string]int32
+
+func (tv *TemplateVersions) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("TemplateVersions#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, tv)
+}
+
+func (tv TemplateVersions) Value() (driver.Value, error) {
+	if len(tv) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(tv)
+}
+
 type RunErrors []null.String
 
 func (re *RunErrors) Scan(value interface{}) error {
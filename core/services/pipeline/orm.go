@@ -95,6 +95,16 @@ type ORM interface {
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 
+	// CreateTemplate stores a new version of the named template, numbered after its latest one, and caches it.
+	CreateTemplate(ctx context.Context, name string, dotDagSource string) (Template, error)
+	// FindTemplates returns all the versions of the named template, latest first.
+	FindTemplates(ctx context.Context, name string) ([]Template, error)
+	GetAllTemplates(ctx context.Context) ([]Template, error)
+	// LoadTemplates replaces the cached templates with the ones stored in the database.
+	LoadTemplates(ctx context.Context) error
+	// Templates returns the cached templates, which expand the include tasks of pipelines.
+	Templates() TemplateResolver
+
 	DataSource() sqlutil.DataSource
 	WithDataSource(sqlutil.DataSource) ORM
 	Transact(context.Context, func(ORM) error) error
@@ -105,6 +115,8 @@ type orm struct {
 	ds                sqlutil.DataSource
 	lggr              logger.Logger
 	maxSuccessfulRuns uint64
+	// templates is shared with the ORMs derived by WithDataSource and Transact
+	templates *TemplateCache
 	// jobID => count
 	pm     sync.Map
 	wg     sync.WaitGroup
@@ -118,6 +130,7 @@ func NewORM(ds sqlutil.DataSource, lggr logger.Logger, jobPipelineMaxSuccessfulR
 		ds:                ds,
 		lggr:              lggr.Named("PipelineORM"),
 		maxSuccessfulRuns: jobPipelineMaxSuccessfulRuns,
+		templates:         NewTemplateCache(),
 		stopCh:            make(chan struct{}),
 	}
 }
@@ -176,6 +189,7 @@ func (o *orm) withDataSource(ds sqlutil.DataSource) *orm {
 		ds:                ds,
 		lggr:              o.lggr,
 		maxSuccessfulRuns: o.maxSuccessfulRuns,
+		templates:         o.templates,
 		stopCh:            make(chan struct{}),
 	}
 }
@@ -226,8 +240,8 @@ func (o *orm) InsertRun(ctx context.Context, run *Run) error {
 	if run.Status() == RunStatusCompleted {
 		defer o.prune(ctx, o.ds, run.PruningKey)
 	}
-	query, args, err := o.ds.BindNamed(`INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
-		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+	query, args, err := o.ds.BindNamed(`INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, template_versions)
+		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :template_versions)
 		RETURNING *;`, run)
 	if err != nil {
 		return fmt.Errorf("error binding arg: %w", err)
@@ -285,7 +299,7 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 			if run.Outputs.Val == nil || len(run.FatalErrors)+len(run.AllErrors) == 0 {
 				return fmt.Errorf("run must have both Outputs and Errors, got Outputs: %#v, FatalErrors: %#v, AllErrors: %#v", run.Outputs.Val, run.FatalErrors, run.AllErrors)
 			}
-			sql := `UPDATE pipeline_runs SET state = :state, finished_at = :finished_at, all_errors= :all_errors, fatal_errors= :fatal_errors, outputs = :outputs WHERE id = :id`
+			sql := `UPDATE pipeline_runs SET state = :state, finished_at = :finished_at, all_errors= :all_errors, fatal_errors= :fatal_errors, outputs = :outputs, template_versions = :template_versions WHERE id = :id`
 			if _, err = tx.ds.NamedExecContext(ctx, sql, run); err != nil {
 				return fmt.Errorf("failed to update pipeline run %d: %w", run.ID, err)
 			}
@@ -365,9 +379,9 @@ func (o *orm) InsertFinishedRuns(ctx context.Context, runs []*Run, saveSuccessfu
 	err := o.transact(ctx, func(tx *orm) error {
 		pipelineRunsQuery := `
 INSERT INTO pipeline_runs 
-	(pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
+	(pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, template_versions)
 VALUES 
-	(:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state) 
+	(:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :template_versions) 
 RETURNING id
 	`
 
@@ -481,8 +495,8 @@ func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSucce
 }
 
 func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool) error {
-	sql := `INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
-		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+	sql := `INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, template_versions)
+		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :template_versions)
 		RETURNING id;`
 
 	query, args, err := o.ds.BindNamed(sql, run)
@@ -768,3 +782,37 @@ LIMIT $3
 		o.lggr.Debugw("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
 	}
 }
+
+func (o *orm) CreateTemplate(ctx context.Context, name string, dotDagSource string) (t Template, err error) {
+	sql := `INSERT INTO pipeline_templates (name, version, dot_dag_source, created_at)
+	SELECT $1, COALESCE(MAX(version), 0) + 1, $2, NOW() FROM pipeline_templates WHERE name = $1
+	RETURNING *;`
+	if err = o.ds.GetContext(ctx, &t, sql, name, dotDagSource); err != nil {
+		return t, errors.Wrap(err, "failed to create pipeline template")
+	}
+	o.templates.Add(t)
+	return t, nil
+}
+
+func (o *orm) FindTemplates(ctx context.Context, name string) (templates []Template, err error) {
+	err = o.ds.SelectContext(ctx, &templates, `SELECT * FROM pipeline_templates WHERE name = $1 ORDER BY version DESC`, name)
+	return templates, errors.Wrap(err, "failed to find pipeline templates")
+}
+
+func (o *orm) GetAllTemplates(ctx context.Context) (templates []Template, err error) {
+	err = o.ds.SelectContext(ctx, &templates, `SELECT * FROM pipeline_templates ORDER BY name, version`)
+	return templates, errors.Wrap(err, "failed to load pipeline templates")
+}
+
+func (o *orm) LoadTemplates(ctx context.Context) error {
+	templates, err := o.GetAllTemplates(ctx)
+	if err != nil {
+		return err
+	}
+	o.templates.Load(templates)
+	return nil
+}
+
+func (o *orm) Templates() TemplateResolver {
+	return o.templates
+}
@@ -107,6 +107,45 @@ func Test_PipelineORM_CreateSpec(t *testing.T) {
 	assert.Equal(t, maxTaskDuration, actual.MaxTaskDuration)
 }
 
+func Test_PipelineORM_Templates(t *testing.T) {
+	ctx := testutils.Context(t)
+	db, orm, _ := setupLiteORM(t)
+
+	t1, err := orm.CreateTemplate(ctx, "eth-usd", `a [type=memo value="1"]`)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), t1.Version)
+	t2, err := orm.CreateTemplate(ctx, "eth-usd", `a [type=memo value="2"]`)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), t2.Version)
+	other, err := orm.CreateTemplate(ctx, "btc-usd", `a [type=memo value="3"]`)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), other.Version)
+
+	templates, err := orm.FindTemplates(ctx, "eth-usd")
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, t2.DotDagSource, templates[0].DotDagSource)
+	assert.Equal(t, t1.DotDagSource, templates[1].DotDagSource)
+
+	all, err := orm.GetAllTemplates(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+
+	// created templates are cached
+	latest, err := orm.Templates().ResolveTemplate("eth-usd", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), latest.Version)
+
+	// and loaded by new ORMs
+	reloaded := pipeline.NewORM(db, logger.TestLogger(t), 123456)
+	_, err = reloaded.Templates().ResolveTemplate("eth-usd", 0)
+	require.ErrorIs(t, err, pipeline.ErrTemplateNotFound)
+	require.NoError(t, reloaded.LoadTemplates(ctx))
+	latest, err = reloaded.Templates().ResolveTemplate("eth-usd", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), latest.Version)
+}
+
 func Test_PipelineORM_FindRun(t *testing.T) {
 	db, orm, _ := setupLiteORM(t)
 
@@ -0,0 +1,159 @@
+package pipeline
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// ReplayBundle records a finished run, so that it can be replayed deterministically, e.g. against a modified spec
+// during a post-mortem. It holds the vars of the run, the template versions it expanded, and the results of its tasks
+// which interact with the outside world or depend on chance (see isRecordedTask), which are replayed as stubs of the
+// tasks with the same name and type.
+type ReplayBundle struct {
+	RunID            int64                             `json:"runId"`
+	JobID            int32                             `json:"jobId"`
+	JobName          string                            `json:"jobName"`
+	DotDagSource     string                            `json:"dotDagSource"`
+	TemplateVersions TemplateVersions                  `json:"templateVersions,omitempty"`
+	Vars             jsonserializable.JSONSerializable `json:"vars"`
+	Results          map[string]RecordedResult         `json:"results"`
+	Outputs          jsonserializable.JSONSerializable `json:"outputs"`
+	FatalErrors      RunErrors                         `json:"fatalErrors"`
+	CreatedAt        time.Time                         `json:"createdAt"`
+}
+
+// RecordedResult is the result of a task of a recorded run.
+type RecordedResult struct {
+	Type TaskType `json:"type"`
+	TaskStub
+}
+
+// NewReplayBundle records the run, which must have finished and have its task runs loaded. Its include tasks are
+// expanded with the given templates, in the versions pinned by the run.
+func NewReplayBundle(run Run, templates TemplateResolver) (*ReplayBundle, error) {
+	if !run.State.Finished() {
+		return nil, errors.Errorf("cannot record run %d: run has not finished", run.ID)
+	}
+	if len(run.PipelineTaskRuns) == 0 {
+		return nil, errors.Errorf("cannot record run %d: its task runs were not saved", run.ID)
+	}
+	pinned := pinTemplates(templates, run.TemplateVersions)
+	if pinned != nil {
+		templates = pinned
+	}
+	p, err := ParseWithTemplates(run.PipelineSpec.DotDagSource, templates)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot record run %d", run.ID)
+	}
+
+	bundle := &ReplayBundle{
+		RunID:        run.ID,
+		JobID:        run.PipelineSpec.JobID,
+		JobName:      run.PipelineSpec.JobName,
+		DotDagSource: run.PipelineSpec.DotDagSource,
+		Results:      make(map[string]RecordedResult),
+		Outputs:      run.Outputs,
+		FatalErrors:  run.FatalErrors,
+		CreatedAt:    run.CreatedAt,
+	}
+
+	// the runner sets the results of tasks on the vars of the run, which must not be replayed as inputs
+	vars := make(map[string]interface{})
+	if inputs, ok := run.Inputs.Val.(map[string]interface{}); ok {
+		for k, v := range inputs {
+			if p.ByDotID(k) == nil {
+				vars[k] = v
+			}
+		}
+	}
+	bundle.Vars = jsonserializable.JSONSerializable{Val: vars, Valid: true}
+
+	for _, taskRun := range run.PipelineTaskRuns {
+		task := p.ByDotID(taskRun.DotID)
+		if task == nil || !taskRun.FinishedAt.Valid || !isRecordedTask(task, templates) {
+			continue
+		}
+		result := RecordedResult{Type: taskRun.Type, TaskStub: TaskStub{Value: taskRun.Output.Val}}
+		if taskRun.Error.Valid {
+			result.TaskStub = TaskStub{Error: taskRun.Error.String}
+		}
+		bundle.Results[taskRun.DotID] = result
+	}
+	if pinned != nil {
+		// runs recorded before versions were pinned on runs are recorded with the latest versions
+		bundle.TemplateVersions = pinned.Versions()
+	}
+	return bundle, nil
+}
+
+// RunVars returns the vars of the recorded run.
+func (b *ReplayBundle) RunVars() map[string]interface{} {
+	vars, _ := b.Vars.Val.(map[string]interface{})
+	return vars
+}
+
+// Stubs returns the stubs replaying the recorded results on the pipeline of the spec. Every recorded task of the
+// spec must have a recorded result of the same type, so that nothing is run for real. Results of tasks which were
+// removed from the spec are ignored. Its include tasks are expanded with the given templates, in the versions of the
+// recorded run, so the spec must be run with the same Spec.TemplateVersions.
+func (b *ReplayBundle) Stubs(spec Spec, templates TemplateResolver) (TaskStubs, error) {
+	if pinned := pinTemplates(templates, b.TemplateVersions); pinned != nil {
+		templates = pinned
+	}
+	p, err := ParseWithTemplates(spec.DotDagSource, templates)
+	if err != nil {
+		return nil, err
+	}
+	stubs := make(TaskStubs)
+	var unrecorded []string
+	for _, task := range p.Tasks {
+		result, ok := b.Results[task.DotID()]
+		if ok && result.Type == task.Type() {
+			stubs[task.DotID()] = result.TaskStub
+			continue
+		}
+		if isRecordedTask(task, templates) {
+			unrecorded = append(unrecorded, task.DotID())
+		}
+	}
+	if len(unrecorded) > 0 {
+		sort.Strings(unrecorded)
+		return nil, errors.Errorf("cannot replay run %d: no recorded results for tasks %v, add them to the results of the bundle", b.RunID, unrecorded)
+	}
+	return stubs, nil
+}
+
+// isRecordedTask returns whether the result of the task depends on the outside world, or on chance like the answer
+// picked by any tasks, so that it must be recorded to replay the run. Map tasks are recorded when their subgraph is,
+// since the tasks of iterations cannot be stubbed, so their results are recorded as a whole.
+func isRecordedTask(task Task, templates TemplateResolver) bool {
+	switch task.Type() {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall, TaskTypeEstimateGasLimit, TaskTypeETHTx,
+		TaskTypeAny, TaskTypeVRF, TaskTypeVRFV2, TaskTypeVRFV2Plus:
+		return true
+	case TaskTypeMap:
+		p, err := ParseWithTemplates(task.(*MapTask).DAG, templates)
+		if err != nil {
+			return false
+		}
+		for _, t := range p.Tasks {
+			if isRecordedTask(t, templates) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pinTemplates returns the templates pinned on the given versions, or nil when there are no templates to expand include
+// tasks with.
+func pinTemplates(templates TemplateResolver, versions TemplateVersions) *pinnedTemplates {
+	if templates == nil {
+		return nil
+	}
+	return newPinnedTemplates(templates, versions)
+}
@@ -0,0 +1,148 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestReplayBundle(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), http.DefaultClient, http.DefaultClient)
+
+	newServer := func(body string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	server1, server2 := newServer(`{"price": 2}`), newServer(`{"price": 4}`)
+
+	spec := pipeline.Spec{DotDagSource: `
+		ds1       [type=http method=GET url="$(url1)"]
+		ds2       [type=http method=GET url="$(url2)"]
+		ds1_parse [type=jsonparse path="price"]
+		ds2_parse [type=jsonparse path="price"]
+		answer    [type=median]
+		ds1 -> ds1_parse -> answer
+		ds2 -> ds2_parse -> answer
+	`}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"url1": server1.URL, "url2": server2.URL})
+	run, _, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusCompleted, run.State)
+
+	recorded, err := pipeline.NewReplayBundle(*run, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"url1": server1.URL, "url2": server2.URL}, recorded.RunVars(), "task results are not recorded as vars")
+	require.Len(t, recorded.Results, 2)
+	assert.Equal(t, pipeline.TaskTypeHTTP, recorded.Results["ds1"].Type)
+	assert.Equal(t, `{"price": 2}`, recorded.Results["ds1"].Value)
+
+	// the bundle is shared as JSON, and replayed once the servers are gone
+	b, err := json.Marshal(recorded)
+	require.NoError(t, err)
+	var bundle pipeline.ReplayBundle
+	require.NoError(t, json.Unmarshal(b, &bundle))
+	server1.Close()
+	server2.Close()
+
+	t.Run("replays the recorded results against a modified spec", func(t *testing.T) {
+		modified := pipeline.Spec{DotDagSource: `
+			ds1       [type=http method=GET url="$(url1)"]
+			ds2       [type=http method=GET url="$(url2)"]
+			ds1_parse [type=jsonparse path="price"]
+			ds2_parse [type=jsonparse path="price"]
+			answer    [type=median]
+			scaled    [type=multiply times=10]
+			ds1 -> ds1_parse -> answer
+			ds2 -> ds2_parse -> answer -> scaled
+		`}
+		stubs, err := bundle.Stubs(modified, nil)
+		require.NoError(t, err)
+		assert.Len(t, stubs, 2)
+
+		trace, err := pipeline.SimulateRun(testutils.Context(t), r, modified, pipeline.NewVarsFrom(bundle.RunVars()), stubs)
+		require.NoError(t, err)
+		require.Equal(t, pipeline.RunStatusCompleted, trace.State)
+		outputs := trace.Outputs.Val.([]interface{})
+		require.Len(t, outputs, 1)
+		assert.Equal(t, "30", outputs[0].(interface{ String() string }).String())
+	})
+
+	t.Run("ignores the results of removed tasks", func(t *testing.T) {
+		stubs, err := bundle.Stubs(pipeline.Spec{DotDagSource: `ds1 [type=http method=GET url="$(url1)"]`}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.TaskStubs{"ds1": {Value: `{"price": 2}`}}, stubs)
+	})
+
+	t.Run("requires results for every recorded task", func(t *testing.T) {
+		_, err := bundle.Stubs(pipeline.Spec{DotDagSource: `
+			ds1    [type=bridge name="prices"]
+			ds3    [type=http method=GET url="$(url1)"]
+			prices [type=map input="[1]" dag=<fetch [type=http method=GET url="$(url1)"]>]
+			scaled [type=map input="[1]" dag=<double [type=expr expr="item * 2"]>]
+		`}, nil)
+		require.ErrorContains(t, err, "no recorded results for tasks [ds1 ds3 prices]")
+	})
+
+	t.Run("records any tasks and the template versions of the run", func(t *testing.T) {
+		templates := pipeline.NewTemplateCache()
+		templates.Add(pipeline.Template{Name: "fetch", Version: 1, DotDagSource: `ds [type=http method=GET url="{{ .url }}"]`})
+		templates.Add(pipeline.Template{Name: "fetch", Version: 2, DotDagSource: `ds [type=memo value=1]`})
+
+		finishedAt := null.TimeFrom(time.Now())
+		source := `
+			price [type=include name="fetch" vars=<{"url": "http://example.com"}>]
+			pick  [type=any]
+			price -> pick
+		`
+		recorded, err := pipeline.NewReplayBundle(pipeline.Run{
+			ID:               2,
+			State:            pipeline.RunStatusCompleted,
+			PipelineSpec:     pipeline.Spec{DotDagSource: source},
+			TemplateVersions: pipeline.TemplateVersions{"fetch": 1},
+			PipelineTaskRuns: []pipeline.TaskRun{
+				{DotID: "price", Type: pipeline.TaskTypeHTTP, Output: jsonserializable.JSONSerializable{Val: "2", Valid: true}, FinishedAt: finishedAt},
+				{DotID: "pick", Type: pipeline.TaskTypeAny, Output: jsonserializable.JSONSerializable{Val: "2", Valid: true}, FinishedAt: finishedAt},
+			},
+		}, templates)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.TemplateVersions{"fetch": 1}, recorded.TemplateVersions)
+		require.Len(t, recorded.Results, 2)
+		assert.Equal(t, pipeline.TaskTypeAny, recorded.Results["pick"].Type)
+
+		// the version 1 of the template is replayed, although a version 2 was created since
+		stubs, err := recorded.Stubs(pipeline.Spec{DotDagSource: source}, templates)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.TaskStubs{"price": {Value: "2"}, "pick": {Value: "2"}}, stubs)
+
+		_, err = recorded.Stubs(pipeline.Spec{DotDagSource: `pick [type=any]`}, nil)
+		require.NoError(t, err)
+		_, err = (&pipeline.ReplayBundle{RunID: 2}).Stubs(pipeline.Spec{DotDagSource: `pick [type=any]`}, nil)
+		require.ErrorContains(t, err, "no recorded results for tasks [pick]")
+	})
+
+	t.Run("records runs which have finished", func(t *testing.T) {
+		_, err := pipeline.NewReplayBundle(pipeline.Run{ID: 1, State: pipeline.RunStatusRunning}, nil)
+		require.ErrorContains(t, err, "cannot record run 1: run has not finished")
+		_, err = pipeline.NewReplayBundle(pipeline.Run{ID: 1, State: pipeline.RunStatusCompleted, PipelineSpec: spec}, nil)
+		require.ErrorContains(t, err, "cannot record run 1: its task runs were not saved")
+	})
+}
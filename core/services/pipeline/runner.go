@@ -301,7 +301,7 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	defer cancel()
 
 	var pipeline *Pipeline
-	if spec.Pipeline != nil {
+	if spec.Pipeline != nil && !spec.Pipeline.HasIncludes() {
 		// assume if set that it has been pre-initialized
 		pipeline = spec.Pipeline
 	} else {
@@ -314,6 +314,7 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 
 	run := NewRun(spec, vars)
 	taskRunResults := r.run(ctx, pipeline, run, vars)
+	run.TemplateVersions = pipeline.TemplateVersions()
 
 	if run.Pending {
 		return run, nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via ExecuteRun", spec.ID)
@@ -322,11 +323,29 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	return run, taskRunResults, nil
 }
 
-func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
+// InitializePipeline parses the pipeline of the spec, unless it was already parsed, expanding its include tasks with
+// the templates of the pipeline ORM, so that every run includes the latest version of unpinned templates.
+func (r *runner) InitializePipeline(spec Spec) (*Pipeline, error) {
+	return r.initializePipeline(spec, nil)
+}
+
+// initializePipeline is InitializePipeline expanding the include tasks with the given pinned templates, including the
+// ones of the subgraphs of map tasks, so that the whole run pins the same versions.
+func (r *runner) initializePipeline(spec Spec, templates *pinnedTemplates) (pipeline *Pipeline, err error) {
+	if templates == nil {
+		templates = newPinnedTemplates(ormTemplates{r.orm}, spec.TemplateVersions)
+	}
 	pipeline, err = spec.GetOrParsePipeline()
 	if err != nil {
 		return
 	}
+	if pipeline.HasIncludes() {
+		pipeline, err = ParseWithTemplates(spec.DotDagSource, templates)
+		if err != nil {
+			return
+		}
+	}
+	pipeline.templates = templates
 
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
@@ -368,6 +387,10 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeMap:
+			task.(*MapTask).runNested = func(ctx context.Context, source string, vars Vars) (TaskRunResults, []TaskRun, error) {
+				return r.runNested(ctx, spec, templates, source, vars)
+			}
 		default:
 		}
 	}
@@ -375,6 +398,31 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	return pipeline, nil
 }
 
+// ormTemplates resolves templates with the ones of the ORM, which are only fetched when a pipeline includes one.
+type ormTemplates struct {
+	orm ORM
+}
+
+func (t ormTemplates) ResolveTemplate(name string, version int32) (Template, error) {
+	return t.orm.Templates().ResolveTemplate(name, version)
+}
+
+// runNested runs the subgraph of a map task in memory, as part of a run of the given spec.
+func (r *runner) runNested(ctx context.Context, spec Spec, templates *pinnedTemplates, source string, vars Vars) (TaskRunResults, []TaskRun, error) {
+	spec.DotDagSource = source
+	spec.Pipeline = nil
+	pipeline, err := r.initializePipeline(spec, templates)
+	if err != nil {
+		return nil, nil, err
+	}
+	run := NewRun(spec, vars)
+	trrs := r.run(ctx, pipeline, run, vars)
+	if run.Pending {
+		return nil, nil, pkgerrors.New("unexpected async run of map task subgraph")
+	}
+	return trrs, run.PipelineTaskRuns, nil
+}
+
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
@@ -481,6 +529,14 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		}
 	}
 
+	// task runs of map task iterations are recorded along with the run, but are not part of its outputs or errors
+	for _, result := range scheduler.results {
+		for _, taskRun := range result.nestedTaskRuns {
+			taskRun.PipelineRunID = run.ID
+			run.PipelineTaskRuns = append(run.PipelineTaskRuns, taskRun)
+		}
+	}
+
 	// TODO: drop this once we stop using TaskRunResults
 	var taskRunResults TaskRunResults
 	for _, result := range scheduler.results {
@@ -552,7 +608,18 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var (
+		result         Result
+		runInfo        RunInfo
+		nestedTaskRuns []TaskRun
+	)
+	if stub := taskRun.task.Base().stub; stub != nil {
+		result = *stub
+	} else if mapTask, ok := taskRun.task.(*MapTask); ok {
+		result, runInfo, nestedTaskRuns = mapTask.runIterations(ctx, l, taskRun.vars, taskRun.inputs)
+	} else {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -574,12 +641,13 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		finishedAt = null.TimeFrom(now)
 	}
 	return TaskRunResult{
-		ID:         taskRun.task.Base().uuid,
-		Task:       taskRun.task,
-		Result:     result,
-		CreatedAt:  start,
-		FinishedAt: finishedAt,
-		runInfo:    runInfo,
+		ID:             taskRun.task.Base().uuid,
+		Task:           taskRun.task,
+		Result:         result,
+		CreatedAt:      start,
+		FinishedAt:     finishedAt,
+		runInfo:        runInfo,
+		nestedTaskRuns: nestedTaskRuns,
 	}
 }
 
@@ -626,13 +694,18 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 }
 
 func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool, fn func(tx sqlutil.DataSource) error) (incomplete bool, err error) {
-	pipeline, err := r.InitializePipeline(run.PipelineSpec)
+	// resumed runs expand the template versions they pinned when they started
+	pipeline, err := r.initializePipeline(run.PipelineSpec, newPinnedTemplates(ormTemplates{r.orm}, run.TemplateVersions))
 	if err != nil {
 		return false, err
 	}
+	run.TemplateVersions = pipeline.TemplateVersions()
 
 	// retain old UUID values
 	for _, taskRun := range run.PipelineTaskRuns {
+		if isNestedDotID(taskRun.DotID) {
+			continue
+		}
 		task := pipeline.ByDotID(taskRun.DotID)
 		if task == nil || task.Base() == nil {
 			return false, pkgerrors.Errorf("failed to match a pipeline task for dot ID: %v", taskRun.DotID)
@@ -677,6 +750,8 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 
 	for {
 		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})))
+		// map tasks may have pinned the templates included by their subgraph
+		run.TemplateVersions = pipeline.TemplateVersions()
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
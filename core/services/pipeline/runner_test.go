@@ -1055,4 +1055,66 @@ succeed;
 		require.Len(t, trrs, 1)
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
+
+	t.Run("expands include tasks with the templates of the ORM", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		r, orm := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+		templates := pipeline.NewTemplateCache()
+		templates.Add(pipeline.Template{Name: "double", Version: 1, DotDagSource: `double [type=multiply input="{{ .value }}" times=2]`})
+		orm.On("Templates").Return(templates)
+
+		spec := pipeline.Spec{DotDagSource: `price [type=include name="double" vars=<{"value": 21}>]`}
+		vars := pipeline.NewVarsFrom(nil)
+
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.Equal(t, "42", trrs[0].Result.Value.(decimal.Decimal).String())
+
+		// a cached *Pipeline with unexpanded include tasks is expanded too
+		spec.Pipeline, err = spec.ParsePipeline()
+		require.NoError(t, err)
+		require.True(t, spec.Pipeline.HasIncludes())
+
+		_, trrs, err = r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.Equal(t, "42", trrs[0].Result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("pins the template versions on the run", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		r, orm := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+		templates := pipeline.NewTemplateCache()
+		templates.Add(pipeline.Template{Name: "double", Version: 1, DotDagSource: `double [type=multiply input="{{ .value }}" times=2]`})
+		orm.On("Templates").Return(templates)
+
+		spec := pipeline.Spec{DotDagSource: `price [type=include name="double" vars=<{"value": 21}>]`}
+		vars := pipeline.NewVarsFrom(nil)
+
+		run, _, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.TemplateVersions{"double": 1}, run.TemplateVersions)
+
+		templates.Add(pipeline.Template{Name: "double", Version: 2, DotDagSource: `double [type=multiply input="{{ .value }}" times=3]`})
+
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.Equal(t, "63", trrs[0].Result.Value.(decimal.Decimal).String())
+		assert.Equal(t, pipeline.TemplateVersions{"double": 2}, run.TemplateVersions)
+
+		// a pre-initialized pipeline keeps the versions it was expanded with
+		spec.Pipeline, err = r.InitializePipeline(spec)
+		require.NoError(t, err)
+		templates.Add(pipeline.Template{Name: "double", Version: 3, DotDagSource: `double [type=multiply input="{{ .value }}" times=4]`})
+
+		run, trrs, err = r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.Equal(t, "63", trrs[0].Result.Value.(decimal.Decimal).String())
+		assert.Equal(t, pipeline.TemplateVersions{"double": 2}, run.TemplateVersions)
+	})
 }
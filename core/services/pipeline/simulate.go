@@ -0,0 +1,227 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// TaskStub replaces the result of a task when simulating a run, e.g. with a recorded response of a bridge.
+type TaskStub struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+func (s TaskStub) result() *Result {
+	if s.Error != "" {
+		return &Result{Error: errors.New(s.Error)}
+	}
+	return &Result{Value: s.Value}
+}
+
+// TaskStubs are keyed by the dot ID of the task they replace.
+type TaskStubs map[string]TaskStub
+
+// RunTrace describes every task run of a simulated run, along with the values passed between them.
+type RunTrace struct {
+	State       RunStatus                         `json:"state"`
+	Outputs     jsonserializable.JSONSerializable `json:"outputs"`
+	AllErrors   RunErrors                         `json:"allErrors"`
+	FatalErrors RunErrors                         `json:"fatalErrors"`
+	Tasks       []TaskTrace                       `json:"tasks"`
+	Edges       []EdgeTrace                       `json:"edges"`
+	CreatedAt   time.Time                         `json:"createdAt"`
+	FinishedAt  null.Time                         `json:"finishedAt"`
+}
+
+type TaskTrace struct {
+	DotID      string                            `json:"dotId"`
+	Type       TaskType                          `json:"type"`
+	Inputs     []TaskTraceInput                  `json:"inputs"`
+	Output     jsonserializable.JSONSerializable `json:"output"`
+	Error      null.String                       `json:"error"`
+	Stubbed    bool                              `json:"stubbed"`
+	CreatedAt  time.Time                         `json:"createdAt"`
+	FinishedAt null.Time                         `json:"finishedAt"`
+	Elapsed    models.Interval                   `json:"elapsed"`
+}
+
+// TaskTraceInput is the result of a dependency, as passed to the task.
+type TaskTraceInput struct {
+	DotID string                            `json:"dotId"`
+	Value jsonserializable.JSONSerializable `json:"value"`
+	Error null.String                       `json:"error"`
+}
+
+// EdgeTrace is a dependency between tasks. Implicit edges are added for $(...) references, and don't pass results.
+type EdgeTrace struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Implicit bool   `json:"implicit"`
+}
+
+// SimulateRun executes the pipeline of the spec in memory, with the results of the stubbed tasks replaced, and
+// returns a trace of the run. Nothing is persisted, but tasks which aren't stubbed are run for real, so ethtx tasks
+// must be stubbed.
+func SimulateRun(ctx context.Context, runner Runner, spec Spec, vars Vars, stubs TaskStubs) (*RunTrace, error) {
+	// always initialize a new pipeline, so that stubs are not left on a cached one
+	spec.Pipeline = nil
+	p, err := runner.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+	for dotID := range stubs {
+		if p.ByDotID(dotID) == nil {
+			return nil, errors.Errorf("cannot stub task %s: no such task", dotID)
+		}
+	}
+	for _, task := range p.Tasks {
+		if stub, ok := stubs[task.DotID()]; ok {
+			task.Base().stub = stub.result()
+			continue
+		}
+		if task.Type() == TaskTypeETHTx {
+			return nil, errors.Errorf("ethtx task %s must be stubbed to simulate the run", task.DotID())
+		}
+	}
+	spec.Pipeline = p
+
+	run, trrs, err := runner.ExecuteRun(ctx, spec, vars)
+	if err != nil {
+		return nil, err
+	}
+	return NewRunTrace(p, run, trrs), nil
+}
+
+// NewRunTrace traces a run of the pipeline which was executed in memory.
+func NewRunTrace(p *Pipeline, run *Run, trrs TaskRunResults) *RunTrace {
+	trace := &RunTrace{
+		State:       run.State,
+		Outputs:     run.Outputs,
+		AllErrors:   run.AllErrors,
+		FatalErrors: run.FatalErrors,
+		CreatedAt:   run.CreatedAt,
+		FinishedAt:  run.FinishedAt,
+	}
+
+	results := make(map[string]TaskRunResult, len(trrs))
+	for _, trr := range trrs {
+		results[trr.Task.DotID()] = trr
+	}
+	for _, task := range p.Tasks {
+		tt := TaskTrace{
+			DotID:   task.DotID(),
+			Type:    task.Type(),
+			Stubbed: task.Base().stub != nil,
+		}
+		for _, input := range task.Inputs() {
+			trace.Edges = append(trace.Edges, EdgeTrace{From: input.InputTask.DotID(), To: task.DotID(), Implicit: !input.PropagateResult})
+			if !input.PropagateResult {
+				continue
+			}
+			result := results[input.InputTask.DotID()].Result
+			tt.Inputs = append(tt.Inputs, TaskTraceInput{DotID: input.InputTask.DotID(), Value: result.OutputDB(), Error: result.ErrorDB()})
+		}
+		// tasks which didn't run, e.g. after a failEarly task errored, have no result
+		if trr, ok := results[task.DotID()]; ok {
+			tt.Output = trr.Result.OutputDB()
+			tt.Error = trr.Result.ErrorDB()
+			tt.CreatedAt = trr.CreatedAt
+			tt.FinishedAt = trr.FinishedAt
+			if trr.FinishedAt.Valid {
+				tt.Elapsed = models.Interval(trr.FinishedAt.Time.Sub(trr.CreatedAt))
+			}
+		}
+		trace.Tasks = append(trace.Tasks, tt)
+	}
+
+	// the task runs of map task iterations follow the pipeline's tasks
+	var nested []TaskRun
+	for _, taskRun := range run.PipelineTaskRuns {
+		if isNestedDotID(taskRun.DotID) {
+			nested = append(nested, taskRun)
+		}
+	}
+	sort.SliceStable(nested, func(i, j int) bool { return nested[i].DotID < nested[j].DotID })
+	for _, taskRun := range nested {
+		tt := TaskTrace{
+			DotID:      taskRun.DotID,
+			Type:       taskRun.Type,
+			Output:     taskRun.Output,
+			Error:      taskRun.Error,
+			CreatedAt:  taskRun.CreatedAt,
+			FinishedAt: taskRun.FinishedAt,
+		}
+		if taskRun.FinishedAt.Valid {
+			tt.Elapsed = models.Interval(taskRun.FinishedAt.Time.Sub(taskRun.CreatedAt))
+		}
+		trace.Tasks = append(trace.Tasks, tt)
+	}
+	return trace
+}
+
+// maxTraceLabelLength truncates the values shown in DOT labels, the JSON trace has them in full
+const maxTraceLabelLength = 64
+
+// DOT renders the trace as a DOT graph, with every task labelled with its result and elapsed time, and every edge
+// with the value it passed.
+func (t *RunTrace) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph {\n")
+	tasks := make(map[string]TaskTrace, len(t.Tasks))
+	for _, task := range t.Tasks {
+		tasks[task.DotID] = task
+		label := fmt.Sprintf("%s (%s)\n%s\n%s", task.DotID, task.Type, traceLabel(task.Output, task.Error), task.Elapsed.Duration())
+		if task.Stubbed {
+			label += " (stubbed)"
+		}
+		var attrs string
+		if task.Error.Valid {
+			attrs = " color=red"
+		}
+		if isNestedDotID(task.DotID) {
+			attrs += " style=dotted"
+		}
+		fmt.Fprintf(&b, "\t%q [type=%q label=%q%s];\n", task.DotID, task.Type, label, attrs)
+	}
+	for _, edge := range t.Edges {
+		if edge.Implicit {
+			fmt.Fprintf(&b, "\t%q -> %q [style=dashed];\n", edge.From, edge.To)
+			continue
+		}
+		from := tasks[edge.From]
+		fmt.Fprintf(&b, "\t%q -> %q [label=%q];\n", edge.From, edge.To, traceLabel(from.Output, from.Error))
+	}
+	for _, task := range t.Tasks {
+		if mapTask, _, ok := strings.Cut(task.DotID, "["); ok {
+			fmt.Fprintf(&b, "\t%q -> %q [style=dotted];\n", mapTask, task.DotID)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func traceLabel(output jsonserializable.JSONSerializable, err null.String) string {
+	label := "null"
+	if err.Valid {
+		label = "error: " + err.String
+	} else if output.Valid {
+		if bs, merr := output.MarshalJSON(); merr == nil {
+			label = string(bs)
+		}
+	}
+	if utf8.RuneCountInString(label) > maxTraceLabelLength {
+		label = string([]rune(label)[:maxTraceLabelLength-1]) + "…"
+	}
+	return label
+}
@@ -0,0 +1,94 @@
+package pipeline_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestSimulateRun(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), http.DefaultClient, http.DefaultClient)
+
+	t.Run("traces the inputs and outputs of every task", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"price": 2}`))
+		}))
+		defer server.Close()
+
+		spec := pipeline.Spec{DotDagSource: `
+			ds1       [type=http method=GET url="$(url)"]
+			ds2       [type=http method=GET url="http://unreachable.invalid"]
+			ds1_parse [type=jsonparse path="price"]
+			ds2_parse [type=jsonparse path="price"]
+			answer    [type=median]
+			ds1 -> ds1_parse -> answer
+			ds2 -> ds2_parse -> answer
+		`}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"url": server.URL})
+		stubs := pipeline.TaskStubs{"ds2": {Value: `{"price": 4}`}}
+
+		trace, err := pipeline.SimulateRun(testutils.Context(t), r, spec, vars, stubs)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, trace.State)
+		assert.Len(t, trace.Edges, 4)
+
+		tasks := make(map[string]pipeline.TaskTrace)
+		for _, task := range trace.Tasks {
+			tasks[task.DotID] = task
+		}
+		require.Len(t, tasks, 5)
+		assert.False(t, tasks["ds1"].Stubbed)
+		assert.Equal(t, `{"price": 2}`, tasks["ds1"].Output.Val)
+		assert.True(t, tasks["ds2"].Stubbed)
+		assert.Equal(t, `{"price": 4}`, tasks["ds2"].Output.Val)
+		require.Len(t, tasks["ds2_parse"].Inputs, 1)
+		assert.Equal(t, "ds2", tasks["ds2_parse"].Inputs[0].DotID)
+		assert.Equal(t, `{"price": 4}`, tasks["ds2_parse"].Inputs[0].Value.Val)
+		assert.Len(t, tasks["answer"].Inputs, 2)
+		assert.Equal(t, "3", tasks["answer"].Output.Val.(interface{ String() string }).String())
+
+		dot := trace.DOT()
+		assert.Contains(t, dot, `"ds1_parse" -> "answer" [label="2"];`)
+		assert.Contains(t, dot, `(stubbed)`)
+	})
+
+	t.Run("stubs errors", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+			ds    [type=bridge name="missing"]
+			parse [type=jsonparse path="price"]
+			ds -> parse
+		`}
+		trace, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), pipeline.TaskStubs{"ds": {Error: "adapter down"}})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, trace.State)
+		assert.Equal(t, "adapter down", trace.Tasks[0].Error.String)
+		assert.Contains(t, trace.DOT(), `"ds" -> "parse" [label="error: adapter down"];`)
+	})
+
+	t.Run("rejects stubs of unknown tasks", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `ds [type=memo value=1]`}
+		_, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), pipeline.TaskStubs{"ds2": {Value: 1}})
+		require.ErrorContains(t, err, "cannot stub task ds2: no such task")
+	})
+
+	t.Run("requires ethtx tasks to be stubbed", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `tx [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x"]`}
+		_, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, err, "ethtx task tx must be stubbed to simulate the run")
+
+		trace, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), pipeline.TaskStubs{"tx": {Value: nil}})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, trace.State)
+	})
+}
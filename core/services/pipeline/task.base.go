@@ -25,6 +25,8 @@ type BaseTask struct {
 	Tags string `mapstructure:"tags" json:"-"`
 
 	uuid uuid.UUID
+	// stub replaces the result of the task when simulating a run
+	stub *Result
 }
 
 func NewBaseTask(id int, dotID string, inputs []TaskDependency, outputs []Task, index int32) BaseTask {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-viper/mapstructure/v2"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
@@ -17,6 +18,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -39,6 +41,11 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// TxStrategy selects a registered TxStrategy, e.g. {"name": "priority-queue", "priority": 10}.
+	// The external job ID is used as the strategy's subject.
+	TxStrategy string `json:"txStrategy"`
+	// Blobs, if set, is a list of blobs, which are sent as an EIP-4844 blob tx.
+	Blobs string `json:"blobs"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
@@ -94,6 +101,8 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		txMetaMap             MapParam
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
+		txStrategyMap         MapParam
+		blobs                 SliceParam
 		failOnRevert          BoolParam
 	)
 	err = multierr.Combine(
@@ -104,6 +113,8 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
+		errors.Wrap(ResolveParam(&txStrategyMap, From(VarExpr(t.TxStrategy, vars), JSONWithVarExprs(t.TxStrategy, vars, false), MapParam{})), "txStrategy"),
+		errors.Wrap(ResolveParam(&blobs, From(VarExpr(t.Blobs, vars), JSONWithVarExprs(t.Blobs, vars, false), nil)), "blobs"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
 	)
 	if err != nil {
@@ -135,8 +146,15 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
 	}
 
-	// TODO(sc-55115): Allow job specs to pass in the strategy that they want
-	strategy := txmgrcommon.NewSendEveryStrategy()
+	strategy, err := decodeTxStrategy(txStrategyMap, vars)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	blobSidecar, err := decodeBlobs(blobs)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
 
 	var forwarderAddress common.Address
 	if t.forwardingAllowed {
@@ -157,6 +175,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		Strategy:         strategy,
 		Checker:          transmitChecker,
 		SignalCallback:   true,
+		BlobSidecar:      blobSidecar,
 	}
 
 	if minOutgoingConfirmations > 0 {
@@ -177,6 +196,23 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 	return Result{Value: nil}, runInfo
 }
 
+// decodeBlobs returns the encoded blob sidecar of the given blobs, or nil if there are none
+func decodeBlobs(blobs SliceParam) ([]byte, error) {
+	if len(blobs) == 0 {
+		return nil, nil
+	}
+	bs := make([][]byte, len(blobs))
+	for i, blob := range blobs {
+		var b BytesParam
+		if err := b.UnmarshalPipelineParam(blob); err != nil {
+			return nil, errors.Wrapf(err, "blob %d", i)
+		}
+		bs[i] = b
+	}
+	sidecar, err := txmgr.NewBlobSidecar(bs)
+	return sidecar, errors.Wrap(err, "blobs")
+}
+
 func decodeMeta(metaMap MapParam) (*txmgr.TxMeta, error) {
 	var txMeta txmgr.TxMeta
 	metaDecoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
@@ -242,6 +278,39 @@ func decodeTransmitChecker(checkerMap MapParam) (txmgr.TransmitCheckerSpec, erro
 	return transmitChecker, nil
 }
 
+// decodeTxStrategy decodes the txStrategy param into the strategy of the tx, with the external job ID of the job as
+// its subject. Without a txStrategy, every tx is sent.
+func decodeTxStrategy(strategyMap MapParam, vars Vars) (txmgrtypes.TxStrategy, error) {
+	if len(strategyMap) == 0 {
+		return txmgrcommon.NewSendEveryStrategy(), nil
+	}
+	var cfg txmgrcommon.TxStrategyConfig
+	strategyDecoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:      &cfg,
+		ErrorUnused: true,
+		TagName:     "json",
+		DecodeHook:  mapstructure.TextUnmarshallerHookFunc(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "txStrategy: %v", err)
+	}
+	if err = strategyDecoder.Decode(strategyMap); err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "txStrategy: %v", err)
+	}
+
+	var subject uuid.UUID
+	if externalJobID, err := vars.Get("jobSpec.externalJobID"); err == nil {
+		if id, ok := externalJobID.(uuid.UUID); ok {
+			subject = id
+		}
+	}
+	strategy, err := txmgrcommon.NewTxStrategy(subject, cfg)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "txStrategy: %v", err)
+	}
+	return strategy, nil
+}
+
 // txMeta is really only used for logging, so this is best-effort
 func setJobIDOnMeta(lggr logger.Logger, vars Vars, meta *txmgr.TxMeta) {
 	jobID, err := vars.Get("jobSpec.databaseID")
@@ -0,0 +1,65 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+func Test_decodeTxStrategy(t *testing.T) {
+	t.Parallel()
+
+	externalJobID := uuid.New()
+	vars := NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"externalJobID": externalJobID,
+		},
+	})
+	resolve := func(t *testing.T, js string) MapParam {
+		var m MapParam
+		require.NoError(t, ResolveParam(&m, From(JSONWithVarExprs(js, vars, false))))
+		return m
+	}
+
+	t.Run("defaults to send every", func(t *testing.T) {
+		s, err := decodeTxStrategy(MapParam{}, vars)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.NewSendEveryStrategy(), s)
+	})
+
+	t.Run("uses external job ID as subject", func(t *testing.T) {
+		s, err := decodeTxStrategy(resolve(t, `{"name": "replace-by-subject-latest-only"}`), vars)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.NewDropOldestStrategy(externalJobID, 1), s)
+	})
+
+	t.Run("decodes options", func(t *testing.T) {
+		s, err := decodeTxStrategy(resolve(t, `{"name": "rate-limited", "queueSize": 3, "minInterval": "1m"}`), vars)
+		require.NoError(t, err)
+		scheduler, ok := s.(txmgrtypes.TxScheduler)
+		require.True(t, ok)
+		assert.Equal(t, externalJobID, scheduler.Subject().UUID)
+		assert.Equal(t, time.Minute, scheduler.MinInterval())
+	})
+
+	t.Run("rejects unknown options", func(t *testing.T) {
+		_, err := decodeTxStrategy(resolve(t, `{"name": "priority-queue", "foo": 1}`), vars)
+		require.ErrorIs(t, err, ErrBadInput)
+	})
+
+	t.Run("rejects unknown strategy", func(t *testing.T) {
+		_, err := decodeTxStrategy(resolve(t, `{"name": "foo"}`), vars)
+		require.ErrorIs(t, err, ErrBadInput)
+	})
+
+	t.Run("requires a subject", func(t *testing.T) {
+		_, err := decodeTxStrategy(resolve(t, `{"name": "priority-queue"}`), NewVarsFrom(nil))
+		require.ErrorIs(t, err, ErrBadInput)
+	})
+}
@@ -606,3 +606,39 @@ func TestETHTxTask(t *testing.T) {
 }
 
 func ptr[T any](t T) *T { return &t }
+
+func TestETHTxTask_Blobs(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	sidecar, err := txmgr.NewBlobSidecar([][]byte{{1, 2, 3}, []byte("foobar")})
+	require.NoError(t, err)
+
+	task := pipeline.ETHTxTask{
+		BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+		To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+		Data:             "foobar",
+		GasLimit:         "12345",
+		MinConfirmations: "0",
+		EVMChainID:       "0",
+		Blobs:            `[ "0x010203", $(blob) ]`,
+	}
+
+	keyStore := keystoremocks.NewEth(t)
+	txManager := txmmocks.NewMockEvmTxManager(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	relayExtenders := evmtest.NewChainRelayExtenders(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+		TxManager: txManager, KeyStore: keyStore})
+	legacyChains := evmrelay.NewLegacyChainsFromRelayerExtenders(relayExtenders)
+
+	keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+	txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+		return assert.Equal(t, sidecar, tx.BlobSidecar)
+	})).Return(txmgr.Tx{}, nil)
+	task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{
+		"blob": []byte("foobar"),
+	}), nil)
+	require.NoError(t, result.Error)
+}
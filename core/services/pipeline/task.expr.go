@@ -0,0 +1,61 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ExpressionTask evaluates an expression over the pipeline Vars, e.g.
+//
+//	expr [type="expr" expr="ds1 * ds2 / ds3 if flag > 0 else fallback"]
+//
+// See expression.go for the supported syntax and functions.
+//
+// Return types:
+//
+//	decimal.Decimal
+//	string
+//	bool
+//	[]interface{}
+//	nil
+//	any value read from the Vars
+type ExpressionTask struct {
+	BaseTask `mapstructure:",squash"`
+	Expr     string `json:"expr"`
+
+	expression *Expression
+}
+
+var _ Task = (*ExpressionTask)(nil)
+
+func (t *ExpressionTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+// compile parses and type checks the expression, so that invalid expressions are rejected when the job is created.
+func (t *ExpressionTask) compile() (err error) {
+	t.expression, err = ParseExpression(t.Expr)
+	return errors.Wrapf(err, "expr task %s", t.DotID())
+}
+
+func (t *ExpressionTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	if t.expression == nil {
+		if err = t.compile(); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	value, err := t.expression.Evaluate(vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expr")}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
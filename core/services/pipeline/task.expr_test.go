@@ -0,0 +1,76 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestExpressionTask(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"ds1": map[string]interface{}{"price": "1234.5"},
+		"ds2": float64(2),
+	})
+
+	t.Run("evaluates the expression", func(t *testing.T) {
+		task := pipeline.ExpressionTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Expr: "ds1.price * ds2 if ds2 > 0 else 0"}
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		assert.False(t, runInfo.IsPending)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "2469", result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("evaluates the outputs of memo tasks", func(t *testing.T) {
+		var memo pipeline.ObjectParam
+		require.NoError(t, memo.UnmarshalPipelineParam("10"))
+		task := pipeline.ExpressionTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Expr: "memo * ds2"}
+		memoVars := vars.Copy()
+		require.NoError(t, memoVars.Set("memo", memo))
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), memoVars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "20", result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("returns evaluation errors", func(t *testing.T) {
+		task := pipeline.ExpressionTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Expr: "ds1.price / (ds2 - 2)"}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrDivideByZero)
+	})
+
+	t.Run("fails if an input errored", func(t *testing.T) {
+		task := pipeline.ExpressionTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Expr: "ds2"}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Error: pipeline.ErrBadInput}})
+		require.ErrorIs(t, result.Error, pipeline.ErrTooManyErrors)
+	})
+
+	t.Run("runs in a pipeline", func(t *testing.T) {
+		p, err := pipeline.Parse(`
+			a    [type=memo value="3"]
+			b    [type=memo value="4"]
+			expr [type=expr expr="pow(a, 2) + pow(b, 2) == 25 ? 'right' : 'wrong'"]
+			a -> expr
+			b -> expr
+		`)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 3)
+		assert.Equal(t, pipeline.TaskTypeExpr, p.Tasks[2].Type())
+	})
+
+	t.Run("rejects invalid expressions when parsing the pipeline", func(t *testing.T) {
+		_, err := pipeline.Parse(`expr [type=expr expr="upper(ds1) * 2"]`)
+		require.ErrorIs(t, err, pipeline.ErrExpressionType)
+		require.ErrorContains(t, err, "expr task expr")
+
+		_, err = pipeline.Parse(`expr [type=expr]`)
+		require.ErrorIs(t, err, pipeline.ErrExpressionSyntax)
+	})
+}
@@ -0,0 +1,28 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// IncludeTask is an include task of a pipeline parsed without templates. It is replaced by the tasks of its template
+// when the runner initializes the pipeline, so it is never run.
+type IncludeTask struct {
+	BaseTask `mapstructure:",squash"`
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Vars     string `json:"vars"`
+}
+
+var _ Task = (*IncludeTask)(nil)
+
+func (t *IncludeTask) Type() TaskType {
+	return TaskTypeInclude
+}
+
+func (t *IncludeTask) Run(_ context.Context, _ logger.Logger, _ Vars, _ []Result) (Result, RunInfo) {
+	return Result{Error: errors.Errorf("include task %s was not expanded with pipeline templates", t.DotID())}, RunInfo{}
+}
@@ -0,0 +1,175 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// MapTask runs its subgraph once per element of the input list, and returns the list of the subgraph's results:
+//
+//	prices [type=map input="$(assets)" concurrency=4 dag=<
+//	    fetch [type=http method=GET url="$(item.url)"]
+//	    parse [type=jsonparse path="price" data="$(fetch)"]
+//	>]
+//
+// Every iteration sees the pipeline's Vars, along with the element as $(item) and its position as $(index). The
+// subgraph must have a single output task, and cannot contain async tasks. Since angle brackets end the dag
+// attribute, its tasks depend on each other through their $(...) references rather than explicit edges. Its task
+// runs are recorded with the run of the pipeline, prefixed with the map task's name and the iteration, e.g.
+// prices[0].fetch.
+//
+// Return types:
+//
+//	[]interface{}
+type MapTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Input       string `json:"input"`
+	DAG         string `json:"dag"`
+	Concurrency string `json:"concurrency"`
+
+	// runNested is set by the runner, to run the subgraph as part of the pipeline run
+	runNested func(ctx context.Context, source string, vars Vars) (TaskRunResults, []TaskRun, error)
+}
+
+var _ Task = (*MapTask)(nil)
+
+const (
+	// maxMapIterations bounds the number of iterations, since all their task runs are kept in memory and recorded
+	maxMapIterations  = 1000
+	maxMapConcurrency = 100
+
+	mapItemVar  = "item"
+	mapIndexVar = "index"
+)
+
+func (t *MapTask) Type() TaskType {
+	return TaskTypeMap
+}
+
+// compile validates the subgraph, so that invalid map tasks are rejected when the job is created.
+func (t *MapTask) compile() error {
+	source := strings.TrimSpace(t.DAG)
+	// values quoted in angle brackets only have them removed if they don't contain any, see GraphNode.SetAttribute
+	if strings.HasPrefix(source, "<") && strings.HasSuffix(source, ">") {
+		source = source[1 : len(source)-1]
+	}
+	t.DAG = source
+
+	p, err := Parse(source)
+	if err != nil {
+		return errors.Wrapf(err, "map task %s: invalid subgraph", t.DotID())
+	}
+	var outputs int
+	for _, task := range p.Tasks {
+		if len(task.Outputs()) == 0 {
+			outputs++
+		}
+	}
+	if outputs != 1 {
+		return errors.Errorf("map task %s: subgraph must have exactly one output task, found %d", t.DotID(), outputs)
+	}
+	if p.RequiresPreInsert() {
+		return errors.Errorf("map task %s: subgraph cannot contain async tasks", t.DotID())
+	}
+	return nil
+}
+
+func (t *MapTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	result, runInfo, _ = t.runIterations(ctx, lggr, vars, inputs)
+	return result, runInfo
+}
+
+// runIterations runs the subgraph for every element of the input, and also returns the task runs of all iterations.
+func (t *MapTask) runIterations(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo, taskRuns []TaskRun) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo, nil
+	}
+
+	var (
+		items            SliceParam
+		maybeConcurrency MaybeUint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&items, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybeConcurrency, From(t.Concurrency)), "concurrency"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo, nil
+	}
+	concurrency := uint64(1)
+	if c, isSet := maybeConcurrency.Uint64(); isSet {
+		if c == 0 || c > maxMapConcurrency {
+			return Result{Error: errors.Wrapf(ErrBadInput, "concurrency must be between 1 and %d, got %d", maxMapConcurrency, c)}, runInfo, nil
+		}
+		concurrency = c
+	}
+	if len(items) > maxMapIterations {
+		return Result{Error: errors.Wrapf(ErrBadInput, "input has %d elements, more than the maximum of %d", len(items), maxMapIterations)}, runInfo, nil
+	}
+	if t.runNested == nil {
+		return Result{Error: errors.New("map task can only be run by the pipeline runner")}, runInfo, nil
+	}
+
+	values := make([]interface{}, len(items))
+	errs := make([]error, len(items))
+	iterationTaskRuns := make([][]TaskRun, len(items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, item interface{}) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			iterationVars := vars.Copy()
+			errs[i] = multierr.Combine(iterationVars.Set(mapItemVar, item), iterationVars.Set(mapIndexVar, i))
+			if errs[i] != nil {
+				return
+			}
+
+			trrs, runs, err := t.runNested(ctx, t.DAG, iterationVars)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			final, err := trrs.FinalResult().SingularResult()
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			values[i], errs[i] = final.Value, final.Error
+
+			for j := range runs {
+				runs[j].DotID = fmt.Sprintf("%s[%d].%s", t.DotID(), i, runs[j].DotID)
+			}
+			iterationTaskRuns[i] = runs
+		}(i, item)
+	}
+	wg.Wait()
+
+	for _, runs := range iterationTaskRuns {
+		taskRuns = append(taskRuns, runs...)
+	}
+	for i, err := range errs {
+		if err != nil {
+			lggr.Debugw("Map task iteration failed", "index", i, "err", err)
+			return Result{Error: errors.Wrapf(err, "iteration %d", i)}, runInfo, taskRuns
+		}
+	}
+	return Result{Value: values}, runInfo, taskRuns
+}
+
+// isNestedDotID returns whether the task run belongs to a map task iteration, rather than to the pipeline itself.
+func isNestedDotID(dotID string) bool {
+	return strings.Contains(dotID, "[")
+}
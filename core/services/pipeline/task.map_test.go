@@ -0,0 +1,114 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMapTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	t.Run("runs the subgraph for every element", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+			scale   [type=memo value="10"]
+			results [type=map input="$(prices)" concurrency=2 dag=<
+				double [type=expr expr="item * 2 + index"]
+				scaled [type=expr expr="$(double) * $(scale)"]
+			>]
+			scale -> results
+		`}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"prices": []interface{}{1, 2, 3}})
+
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.NoError(t, result.Error)
+		values := result.Value.([]interface{})
+		require.Len(t, values, 3)
+		for i, expected := range []string{"20", "50", "80"} {
+			assert.Equal(t, expected, values[i].(decimal.Decimal).String())
+		}
+
+		var dotIDs []string
+		for _, taskRun := range run.PipelineTaskRuns {
+			dotIDs = append(dotIDs, taskRun.DotID)
+		}
+		assert.ElementsMatch(t, []string{
+			"scale", "results",
+			"results[0].double", "results[0].scaled",
+			"results[1].double", "results[1].scaled",
+			"results[2].double", "results[2].scaled",
+		}, dotIDs)
+		assert.Len(t, run.Outputs.Val, 1, "iterations are not outputs of the run")
+	})
+
+	t.Run("returns the error of a failed iteration", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+			results [type=map input="[4, 0, 1]" dag=<inverse [type=expr expr="1 / item"]>]
+		`}
+
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.ErrorIs(t, result.Error, pipeline.ErrDivideByZero)
+		require.ErrorContains(t, result.Error, "iteration 1")
+		assert.True(t, run.HasFatalErrors())
+	})
+
+	t.Run("returns an empty list for an empty input", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `results [type=map input="[]" dag=<double [type=expr expr="item * 2"]>]`}
+
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.NoError(t, result.Error)
+		assert.Equal(t, []interface{}{}, result.Value)
+	})
+
+	t.Run("rejects invalid concurrency", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `results [type=map input="[1]" concurrency=1000 dag=<double [type=expr expr="item * 2"]>]`}
+
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+
+	t.Run("cannot run outside of the runner", func(t *testing.T) {
+		task := pipeline.MapTask{BaseTask: pipeline.NewBaseTask(0, "results", nil, nil, 0), Input: "[1]", DAG: `double [type=expr expr="item * 2"]`}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "map task can only be run by the pipeline runner")
+	})
+}
+
+func TestMapTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name, source, err string
+	}{
+		{"invalid subgraph", `results [type=map input="[1]" dag=<double [type=unknown]>]`, "map task results: invalid subgraph"},
+		{"several outputs", `results [type=map input="[1]" dag=<a [type=memo value=1] b [type=memo value=2]>]`, "subgraph must have exactly one output task, found 2"},
+		{"async task", `results [type=map input="[1]" dag=<tx [type=ethtx to="0x0" data="0x"]>]`, "subgraph cannot contain async tasks"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pipeline.Parse(tt.source)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package pipeline
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// Pipeline templates are named and versioned pipeline fragments, which are inlined in place of include tasks when the
+// pipeline is parsed:
+//
+//	price [type=include name="eth-usd" version=2 vars=<{"pair": "ETH/USD"}>]
+//
+// The template's DOT source is first rendered with text/template using the include's vars, e.g. {{ .pair }}. Its
+// single output task, the only one without dependants, then replaces the include task and takes over its name, while
+// the other tasks are prefixed with the include's name, e.g. price__ds1. $(...) references to the template's own
+// tasks are rewritten accordingly, so expr tasks inside templates should use the $(ds1) form to refer to them.
+//
+// Includes without a version expand to the latest version of the template, so that upgrading a template upgrades
+// every job which uses it the next time its pipeline is initialized. The versions they expanded to are pinned in the
+// TemplateVersions of each run, so that resuming a run, e.g. after an async task, expands the same versions.
+//
+// Includes are only expanded when a TemplateResolver is given, see ParseWithTemplates. Pipelines parsed without one,
+// e.g. when decoding a job spec, keep their include tasks as IncludeTask placeholders. The runner expands them with the
+// templates of the pipeline ORM whenever it initializes the pipeline of a run, and the job ORM checks that they expand
+// when a job is created.
+//
+// The pipeline ORM caches the templates: they are loaded from the database by LoadTemplates when the application
+// starts, and CreateTemplate adds the new versions. Templates inserted in the database by any other means, e.g. by
+// another node sharing the database, are only picked up after a restart.
+
+var (
+	ErrTemplateNotFound = errors.New("pipeline template not found")
+
+	templateNameRegexp = regexp.MustCompile(`\A[a-zA-Z0-9_.-]+\z`)
+)
+
+const (
+	// templateTaskSeparator joins the include's name and a template task's name, e.g. price__ds1
+	templateTaskSeparator = "__"
+	maxTemplateDepth      = 8
+)
+
+type Template struct {
+	ID           int64     `json:"-"`
+	Name         string    `json:"name"`
+	Version      int32     `json:"version"`
+	DotDagSource string    `json:"dotDagSource"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// ValidateTemplate checks the name of a new template and the syntax of its source.
+// The rendered DAG depends on the vars of each include, so it can only be checked when parsing the including pipeline.
+func ValidateTemplate(name, dotDagSource string) error {
+	if !templateNameRegexp.MatchString(name) {
+		return errors.Errorf("invalid template name %q: only letters, digits, '_', '-' and '.' are allowed", name)
+	}
+	if strings.TrimSpace(dotDagSource) == "" {
+		return errors.New("empty template source")
+	}
+	_, err := template.New(name).Option("missingkey=error").Parse(dotDagSource)
+	return errors.Wrapf(err, "invalid template %s", name)
+}
+
+// Render renders the template's source with the given vars.
+func (t Template) Render(vars map[string]interface{}) (string, error) {
+	tmpl, err := template.New(t.Name).Option("missingkey=error").Parse(t.DotDagSource)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid template %s", t.Name)
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, vars); err != nil {
+		return "", errors.Wrapf(err, "failed to render template %s version %d", t.Name, t.Version)
+	}
+	return buf.String(), nil
+}
+
+// TemplateResolver looks up the templates referenced by include tasks.
+type TemplateResolver interface {
+	// ResolveTemplate returns the given version of the template, or its latest version if version is 0.
+	ResolveTemplate(name string, version int32) (Template, error)
+}
+
+// TemplateCache is a TemplateResolver keeping every version of every template in memory, since pipelines are parsed
+// synchronously and often, e.g. once per run.
+type TemplateCache struct {
+	mu sync.RWMutex
+	// name => versions in ascending order
+	templates map[string][]Template
+}
+
+var _ TemplateResolver = (*TemplateCache)(nil)
+
+func NewTemplateCache() *TemplateCache {
+	return &TemplateCache{templates: make(map[string][]Template)}
+}
+
+// Load replaces the cached templates with the given ones.
+func (c *TemplateCache) Load(all []Template) {
+	templates := make(map[string][]Template)
+	for _, t := range all {
+		templates[t.Name] = append(templates[t.Name], t)
+	}
+	for _, versions := range templates {
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.templates = templates
+}
+
+// Add caches a newly created template version.
+func (c *TemplateCache) Add(t Template) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	versions := c.templates[t.Name]
+	i := sort.Search(len(versions), func(i int) bool { return versions[i].Version >= t.Version })
+	if i < len(versions) && versions[i].Version == t.Version {
+		versions[i] = t
+		return
+	}
+	c.templates[t.Name] = append(versions[:i], append([]Template{t}, versions[i:]...)...)
+}
+
+func (c *TemplateCache) ResolveTemplate(name string, version int32) (Template, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	versions := c.templates[name]
+	if len(versions) == 0 {
+		return Template{}, errors.Wrapf(ErrTemplateNotFound, "%s", name)
+	}
+	if version == 0 {
+		return versions[len(versions)-1], nil
+	}
+	for _, t := range versions {
+		if t.Version == version {
+			return t, nil
+		}
+	}
+	return Template{}, errors.Wrapf(ErrTemplateNotFound, "%s version %d", name, version)
+}
+
+// pinnedTemplates is a TemplateResolver expanding every include of a template without a version to the same version:
+// the one pinned by a previous expansion, e.g. of the resumed run, or else the latest one, which is then pinned.
+type pinnedTemplates struct {
+	templates TemplateResolver
+
+	// mu guards versions, since map tasks expand the includes of their subgraph concurrently
+	mu       sync.Mutex
+	versions TemplateVersions
+}
+
+var _ TemplateResolver = (*pinnedTemplates)(nil)
+
+func newPinnedTemplates(templates TemplateResolver, versions TemplateVersions) *pinnedTemplates {
+	pinned := make(TemplateVersions, len(versions))
+	for name, version := range versions {
+		pinned[name] = version
+	}
+	return &pinnedTemplates{templates: templates, versions: pinned}
+}
+
+func (p *pinnedTemplates) ResolveTemplate(name string, version int32) (Template, error) {
+	if version != 0 {
+		return p.templates.ResolveTemplate(name, version)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pinned, ok := p.versions[name]; ok {
+		return p.templates.ResolveTemplate(name, pinned)
+	}
+	t, err := p.templates.ResolveTemplate(name, 0)
+	if err != nil {
+		return Template{}, err
+	}
+	p.versions[name] = t.Version
+	return t, nil
+}
+
+// Versions returns a copy of the pinned versions.
+func (p *pinnedTemplates) Versions() TemplateVersions {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.versions) == 0 {
+		return nil
+	}
+	versions := make(TemplateVersions, len(p.versions))
+	for name, version := range p.versions {
+		versions[name] = version
+	}
+	return versions
+}
+
+// expandIncludes replaces every include task of the graph with the tasks of the template it references.
+func (g *Graph) expandIncludes(templates TemplateResolver, depth int) error {
+	var includes []*GraphNode
+	for nodes := g.Nodes(); nodes.Next(); {
+		node := nodes.Node().(*GraphNode)
+		if TaskType(strings.ToLower(node.attrs["type"])) == TaskTypeInclude {
+			includes = append(includes, node)
+		}
+	}
+	if len(includes) == 0 {
+		return nil
+	}
+	if depth >= maxTemplateDepth {
+		return errors.Errorf("templates are included deeper than %d levels", maxTemplateDepth)
+	}
+	sort.Slice(includes, func(i, j int) bool { return includes[i].ID() < includes[j].ID() })
+	for _, node := range includes {
+		if err := g.expandInclude(node, templates, depth); err != nil {
+			return errors.Wrapf(err, "include task %s", node.dotID)
+		}
+	}
+	return nil
+}
+
+func (g *Graph) expandInclude(node *GraphNode, templates TemplateResolver, depth int) error {
+	name := node.attrs["name"]
+	if name == "" {
+		return errors.New("missing template name")
+	}
+	var version int32
+	if v := node.attrs["version"]; v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || parsed <= 0 {
+			return errors.Errorf("invalid template version %q", v)
+		}
+		version = int32(parsed)
+	}
+	vars := make(map[string]interface{})
+	if v := strings.TrimSpace(node.attrs["vars"]); v != "" {
+		if err := json.Unmarshal([]byte(v), &vars); err != nil {
+			return errors.Wrap(err, "vars must be a JSON object")
+		}
+	}
+
+	tmpl, err := templates.ResolveTemplate(name, version)
+	if err != nil {
+		return err
+	}
+	source, err := tmpl.Render(vars)
+	if err != nil {
+		return err
+	}
+	sub := &Graph{DirectedGraph: simple.NewDirectedGraph()}
+	if err = sub.unmarshalDOT([]byte(source)); err != nil {
+		return errors.Wrapf(err, "template %s version %d", tmpl.Name, tmpl.Version)
+	}
+	if err = sub.expandIncludes(templates, depth+1); err != nil {
+		return errors.Wrapf(err, "template %s version %d", tmpl.Name, tmpl.Version)
+	}
+	// tasks linked only by $(...) references must not be mistaken for outputs or inputs of the template
+	sub.AddImplicitDependenciesAsEdges()
+
+	// the output task takes over the include's name, the other ones are prefixed with it
+	var output *GraphNode
+	renames := make(map[string]string)
+	for nodes := sub.Nodes(); nodes.Next(); {
+		n := nodes.Node().(*GraphNode)
+		if sub.From(n.ID()).Len() > 0 {
+			renames[n.dotID] = node.dotID + templateTaskSeparator + n.dotID
+			continue
+		}
+		if output != nil {
+			return errors.Errorf("template %s version %d must have exactly one output task, found %s and %s", tmpl.Name, tmpl.Version, output.dotID, n.dotID)
+		}
+		output = n
+		renames[n.dotID] = node.dotID
+	}
+	if output == nil {
+		return errors.Errorf("template %s version %d is empty", tmpl.Name, tmpl.Version)
+	}
+
+	existing := make(map[string]struct{})
+	for nodes := g.Nodes(); nodes.Next(); {
+		existing[nodes.Node().(*GraphNode).dotID] = struct{}{}
+	}
+	predecessors := graph.NodesOf(g.To(node.ID()))
+
+	mapped := make(map[int64]*GraphNode)
+	for nodes := sub.Nodes(); nodes.Next(); {
+		n := nodes.Node().(*GraphNode)
+		target := node
+		if n != output {
+			if _, exists := existing[renames[n.dotID]]; exists {
+				return errors.Errorf("template task %s conflicts with an existing task", renames[n.dotID])
+			}
+			target = g.NewNode().(*GraphNode)
+			target.dotID = renames[n.dotID]
+			g.AddNode(target)
+		}
+		target.attrs = make(map[string]string, len(n.attrs))
+		for k, v := range n.attrs {
+			target.attrs[k] = renameVariables(v, renames)
+		}
+		mapped[n.ID()] = target
+	}
+
+	for edges := sub.Edges(); edges.Next(); {
+		e := edges.Edge().(*GraphEdge)
+		edge := g.NewEdge(mapped[e.From().ID()], mapped[e.To().ID()]).(*GraphEdge)
+		edge.SetIsImplicit(e.IsImplicit())
+		g.SetEdge(edge)
+	}
+
+	// the include's dependencies become dependencies of the template's input tasks
+	if sub.To(output.ID()).Len() > 0 {
+		for _, pred := range predecessors {
+			g.RemoveEdge(pred.ID(), node.ID())
+		}
+		for nodes := sub.Nodes(); nodes.Next(); {
+			n := nodes.Node()
+			if sub.To(n.ID()).Len() > 0 {
+				continue
+			}
+			for _, pred := range predecessors {
+				g.SetEdge(g.NewEdge(pred, mapped[n.ID()]))
+			}
+		}
+	}
+	return nil
+}
+
+// renameVariables rewrites the $(...) references whose first keypath segment was renamed.
+func renameVariables(value string, renames map[string]string) string {
+	return variableRegexp.ReplaceAllStringFunc(value, func(v string) string {
+		keypath := strings.TrimSpace(v[2 : len(v)-1])
+		head, rest, _ := strings.Cut(keypath, KeypathSeparator)
+		renamed, ok := renames[head]
+		if !ok {
+			return v
+		}
+		if rest != "" {
+			return "$(" + renamed + KeypathSeparator + rest + ")"
+		}
+		return "$(" + renamed + ")"
+	})
+}
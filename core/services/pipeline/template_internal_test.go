@@ -0,0 +1,59 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinnedTemplates(t *testing.T) {
+	t.Parallel()
+
+	cache := NewTemplateCache()
+	cache.Add(Template{Name: "a", Version: 1, DotDagSource: "a1"})
+	cache.Add(Template{Name: "a", Version: 2, DotDagSource: "a2"})
+	cache.Add(Template{Name: "b", Version: 1, DotDagSource: "b1"})
+
+	pinned := newPinnedTemplates(cache, TemplateVersions{"a": 1})
+	assert.Equal(t, TemplateVersions{"a": 1}, pinned.Versions())
+
+	// pinned versions win over the latest ones
+	tmpl, err := pinned.ResolveTemplate("a", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "a1", tmpl.DotDagSource)
+
+	// the latest version of the other templates is pinned on first use
+	tmpl, err = pinned.ResolveTemplate("b", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "b1", tmpl.DotDagSource)
+	cache.Add(Template{Name: "b", Version: 2, DotDagSource: "b2"})
+	tmpl, err = pinned.ResolveTemplate("b", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "b1", tmpl.DotDagSource)
+
+	// explicit versions are resolved as is and not pinned
+	tmpl, err = pinned.ResolveTemplate("a", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "a2", tmpl.DotDagSource)
+
+	_, err = pinned.ResolveTemplate("c", 0)
+	require.ErrorIs(t, err, ErrTemplateNotFound)
+
+	assert.Equal(t, TemplateVersions{"a": 1, "b": 1}, pinned.Versions())
+}
+
+func TestTemplateVersions_Value(t *testing.T) {
+	t.Parallel()
+
+	v, err := TemplateVersions(nil).Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = TemplateVersions{"a": 2}.Value()
+	require.NoError(t, err)
+
+	var versions TemplateVersions
+	require.NoError(t, versions.Scan(v))
+	assert.Equal(t, TemplateVersions{"a": 2}, versions)
+}
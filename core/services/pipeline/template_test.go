@@ -0,0 +1,207 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func newTemplateCache(templates ...pipeline.Template) *pipeline.TemplateCache {
+	cache := pipeline.NewTemplateCache()
+	for _, t := range templates {
+		cache.Add(t)
+	}
+	return cache
+}
+
+func TestTemplateCache_ResolveTemplate(t *testing.T) {
+	t.Parallel()
+
+	cache := newTemplateCache(
+		pipeline.Template{Name: "a", Version: 2, DotDagSource: "v2"},
+		pipeline.Template{Name: "a", Version: 1, DotDagSource: "v1"},
+		pipeline.Template{Name: "b", Version: 1, DotDagSource: "b1"},
+	)
+
+	tmpl, err := cache.ResolveTemplate("a", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", tmpl.DotDagSource)
+
+	tmpl, err = cache.ResolveTemplate("a", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", tmpl.DotDagSource)
+
+	cache.Add(pipeline.Template{Name: "a", Version: 3, DotDagSource: "v3"})
+	tmpl, err = cache.ResolveTemplate("a", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "v3", tmpl.DotDagSource)
+
+	_, err = cache.ResolveTemplate("a", 4)
+	require.ErrorIs(t, err, pipeline.ErrTemplateNotFound)
+	_, err = cache.ResolveTemplate("c", 0)
+	require.ErrorIs(t, err, pipeline.ErrTemplateNotFound)
+}
+
+func TestValidateTemplate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, pipeline.ValidateTemplate("eth-usd.v2", `a [type=memo value="{{ .value }}"]`))
+	require.ErrorContains(t, pipeline.ValidateTemplate("eth/usd", `a [type=memo]`), "invalid template name")
+	require.ErrorContains(t, pipeline.ValidateTemplate("eth-usd", " "), "empty template source")
+	require.ErrorContains(t, pipeline.ValidateTemplate("eth-usd", `a [type=memo value="{{ .value"]`), "invalid template eth-usd")
+}
+
+func TestParse_KeepsIncludeTasks(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+		price  [type=include name="median" version=1 vars=<{"a": 1}>]
+		answer [type=expr expr="$(price) * 2"]
+		price -> answer
+	`)
+	require.NoError(t, err)
+	require.True(t, p.HasIncludes())
+	include := p.ByDotID("price").(*pipeline.IncludeTask)
+	assert.Equal(t, "median", include.Name)
+	assert.Equal(t, "1", include.Version)
+
+	result, _ := include.Run(tests.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorContains(t, result.Error, "include task price was not expanded")
+
+	p, err = pipeline.Parse(`answer [type=memo value="1"]`)
+	require.NoError(t, err)
+	assert.False(t, p.HasIncludes())
+}
+
+func TestParseWithTemplates(t *testing.T) {
+	t.Parallel()
+
+	median := pipeline.Template{Name: "median", Version: 1, DotDagSource: `
+		ds1    [type=memo value="{{ .a }}"]
+		ds2    [type=memo value="{{ .b }}"]
+		median [type=median values=<[ $(ds1), $(ds2) ]>]
+		ds1 -> median
+		ds2 -> median
+	`}
+	scaled := pipeline.Template{Name: "scaled", Version: 1, DotDagSource: `
+		price    [type=include name="median" vars=<{"a": {{ .a }}, "b": {{ .b }}}>]
+		multiply [type=multiply input="$(price)" times="{{ .times }}"]
+		price -> multiply
+	`}
+	cache := newTemplateCache(median, scaled, pipeline.Template{Name: "median", Version: 2, DotDagSource: `median [type=memo value="{{ .a }}"]`})
+
+	t.Run("expands the template in place of the include task", func(t *testing.T) {
+		p, err := pipeline.ParseWithTemplates(`
+			trigger [type=memo value="0"]
+			price   [type=include name="median" version=1 vars=<{"a": 1, "b": 3}>]
+			answer  [type=expr expr="$(price) * 2"]
+			trigger -> price -> answer
+		`, cache)
+		require.NoError(t, err)
+
+		dotIDs := make(map[string]pipeline.Task)
+		for _, task := range p.Tasks {
+			dotIDs[task.DotID()] = task
+		}
+		require.Len(t, dotIDs, 5)
+		require.Contains(t, dotIDs, "price__ds1")
+		require.Contains(t, dotIDs, "price__ds2")
+		assert.Equal(t, "1", dotIDs["price__ds1"].(*pipeline.MemoTask).Value)
+		assert.Equal(t, "3", dotIDs["price__ds2"].(*pipeline.MemoTask).Value)
+
+		price := dotIDs["price"].(*pipeline.MedianTask)
+		assert.Equal(t, "[ $(price__ds1), $(price__ds2) ]", price.Values)
+		assert.Len(t, price.Inputs(), 2)
+		assert.Equal(t, []pipeline.Task{dotIDs["answer"]}, price.Outputs())
+
+		// the include's dependencies become dependencies of the template's inputs
+		assert.Equal(t, "trigger", dotIDs["price__ds1"].Inputs()[0].InputTask.DotID())
+		assert.Equal(t, "trigger", dotIDs["price__ds2"].Inputs()[0].InputTask.DotID())
+	})
+
+	t.Run("includes the latest version by default", func(t *testing.T) {
+		p, err := pipeline.ParseWithTemplates(`price [type=include name="median" vars=<{"a": 5}>]`, cache)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 1)
+		assert.Equal(t, "price", p.Tasks[0].DotID())
+		assert.Equal(t, "5", p.Tasks[0].(*pipeline.MemoTask).Value)
+	})
+
+	t.Run("expands nested includes", func(t *testing.T) {
+		p, err := pipeline.ParseWithTemplates(`price [type=include name="scaled" vars=<{"a": 1, "b": 3, "times": 100}>]`, cache)
+		require.NoError(t, err)
+
+		dotIDs := make(map[string]pipeline.Task)
+		for _, task := range p.Tasks {
+			dotIDs[task.DotID()] = task
+		}
+		require.Len(t, dotIDs, 2, "version 2 of median has a single task")
+		assert.Equal(t, "1", dotIDs["price__price"].(*pipeline.MemoTask).Value)
+		assert.Equal(t, "$(price__price)", dotIDs["price"].(*pipeline.MultiplyTask).Input)
+		assert.Equal(t, "100", dotIDs["price"].(*pipeline.MultiplyTask).Times)
+	})
+
+	t.Run("expands templates whose tasks are only linked by variables", func(t *testing.T) {
+		cache := newTemplateCache(pipeline.Template{Name: "fetch", Version: 1, DotDagSource: `
+			ds1 [type=http method=GET url="{{ .url }}"]
+			p   [type=jsonparse path="price" data="$(ds1)"]
+		`})
+		p, err := pipeline.ParseWithTemplates(`
+			trigger [type=memo value="0"]
+			price   [type=include name="fetch" vars=<{"url": "https://example.com"}>]
+			trigger -> price
+		`, cache)
+		require.NoError(t, err)
+
+		dotIDs := make(map[string]pipeline.Task)
+		for _, task := range p.Tasks {
+			dotIDs[task.DotID()] = task
+		}
+		require.Len(t, dotIDs, 3)
+		price := dotIDs["price"].(*pipeline.JSONParseTask)
+		assert.Equal(t, "$(price__ds1)", price.Data)
+		require.Len(t, price.Inputs(), 1)
+		assert.Equal(t, "price__ds1", price.Inputs()[0].InputTask.DotID())
+		assert.False(t, price.Inputs()[0].PropagateResult, "the implicit edge doesn't pass results")
+		assert.Equal(t, "trigger", dotIDs["price__ds1"].Inputs()[0].InputTask.DotID())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, test := range []struct {
+			name   string
+			source string
+			err    string
+		}{
+			{"unknown template", `a [type=include name="unknown"]`, "pipeline template not found"},
+			{"unknown version", `a [type=include name="median" version=3]`, "median version 3"},
+			{"invalid version", `a [type=include name="median" version=x]`, `invalid template version "x"`},
+			{"missing name", `a [type=include]`, "missing template name"},
+			{"invalid vars", `a [type=include name="median" vars="[1]"]`, "vars must be a JSON object"},
+			{"missing var", `a [type=include name="median" version=1 vars=<{"a": 1}>]`, `map has no entry for key "b"`},
+			{"conflicting task", `
+				price__ds1 [type=memo value="1"]
+				price      [type=include name="median" version=1 vars=<{"a": 1, "b": 3}>]
+			`, "template task price__ds1 conflicts with an existing task"},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := pipeline.ParseWithTemplates(test.source, cache)
+				require.ErrorContains(t, err, test.err)
+			})
+		}
+	})
+
+	t.Run("rejects templates with several outputs", func(t *testing.T) {
+		cache := newTemplateCache(pipeline.Template{Name: "split", Version: 1, DotDagSource: `
+			a [type=memo value="1"]
+			b [type=memo value="2"]
+		`})
+		_, err := pipeline.ParseWithTemplates(`x [type=include name="split"]`, cache)
+		require.ErrorContains(t, err, "must have exactly one output task")
+	})
+}
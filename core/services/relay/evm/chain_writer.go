@@ -32,7 +32,17 @@ type ChainWriterService interface {
 // Compile-time assertion that chainWriter implements the ChainWriterService interface.
 var _ ChainWriterService = (*chainWriter)(nil)
 
-func NewChainWriterService(logger logger.Logger, client evmclient.Client, txm evmtxmgr.TxManager, estimator gas.EvmFeeEstimator, config types.ChainWriterConfig) (ChainWriterService, error) {
+type ChainWriterOption func(*chainWriter)
+
+// WithUserOpTransmitter sends the transactions as user operations of the transmitter's smart contract account instead of
+// through the txm. The ChainWriter manages the lifecycle of the transmitter.
+func WithUserOpTransmitter(userOps *UserOpTransmitter) ChainWriterOption {
+	return func(w *chainWriter) {
+		w.userOps = userOps
+	}
+}
+
+func NewChainWriterService(logger logger.Logger, client evmclient.Client, txm evmtxmgr.TxManager, estimator gas.EvmFeeEstimator, config types.ChainWriterConfig, opts ...ChainWriterOption) (ChainWriterService, error) {
 	if config.MaxGasPrice == nil {
 		return nil, fmt.Errorf("max gas price is required")
 	}
@@ -47,6 +57,9 @@ func NewChainWriterService(logger logger.Logger, client evmclient.Client, txm ev
 		contracts:       config.Contracts,
 		parsedContracts: &codec.ParsedTypes{EncoderDefs: map[string]types.CodecEntry{}, DecoderDefs: map[string]types.CodecEntry{}},
 	}
+	for _, opt := range opts {
+		opt(&w)
+	}
 
 	if err := w.parseContracts(); err != nil {
 		return nil, fmt.Errorf("%w: failed to parse contracts", err)
@@ -68,6 +81,7 @@ type chainWriter struct {
 	txm         evmtxmgr.TxManager
 	ge          gas.EvmFeeEstimator
 	maxGasPrice *assets.Wei
+	userOps     *UserOpTransmitter
 
 	contracts       map[string]*types.ContractConfig
 	parsedContracts *codec.ParsedTypes
@@ -110,6 +124,14 @@ func (w *chainWriter) SubmitTransaction(ctx context.Context, contract, method st
 		v = value
 	}
 
+	if w.userOps != nil {
+		// The account sends the call, and the bundler estimates its gas, so the method's FromAddress and GasLimit don't apply.
+		if _, err = w.userOps.SendUserOperation(ctx, common.HexToAddress(toAddress), calldata, v, transactionID); err != nil {
+			return fmt.Errorf("%w; failed to send user operation", err)
+		}
+		return nil
+	}
+
 	var txMeta *txmgrtypes.TxMeta[common.Address, common.Hash]
 	if meta != nil && meta.WorkflowExecutionID != nil {
 		txMeta = &txmgrtypes.TxMeta[common.Address, common.Hash]{
@@ -175,6 +197,9 @@ func (w *chainWriter) parseContracts() error {
 }
 
 func (w *chainWriter) GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error) {
+	if w.userOps != nil {
+		return w.userOps.TransactionStatus(transactionID)
+	}
 	return w.txm.GetTransactionStatus(ctx, transactionID)
 }
 
@@ -218,6 +243,9 @@ func (w *chainWriter) GetFeeComponents(ctx context.Context) (*commontypes.ChainF
 
 func (w *chainWriter) Close() error {
 	return w.StopOnce(w.Name(), func() error {
+		if w.userOps != nil {
+			return w.userOps.Close()
+		}
 		return nil
 	})
 }
@@ -238,6 +266,9 @@ func (w *chainWriter) Ready() error {
 
 func (w *chainWriter) Start(ctx context.Context) error {
 	return w.StartOnce(w.Name(), func() error {
+		if w.userOps != nil {
+			return w.userOps.Start(ctx)
+		}
 		return nil
 	})
 }
@@ -166,7 +166,7 @@ func (P *DstCommitProvider) Name() string {
 func (P *DstCommitProvider) Close() error {
 	versionFinder := ccip.NewEvmVersionFinder()
 
-	unregisterFuncs := make([]func() error, 0, 2)
+	unregisterFuncs := make([]func() error, 0, 3)
 	unregisterFuncs = append(unregisterFuncs, func() error {
 		if P.seenCommitStoreAddress == nil {
 			return nil
@@ -179,6 +179,7 @@ func (P *DstCommitProvider) Close() error {
 		}
 		return ccip.CloseOffRampReader(P.lggr, versionFinder, *P.seenOffRampAddress, P.client, P.lp, nil, big.NewInt(0))
 	})
+	unregisterFuncs = append(unregisterFuncs, P.contractTransmitter.Close)
 
 	var multiErr error
 	for _, fn := range unregisterFuncs {
@@ -226,6 +227,9 @@ func (P *SrcCommitProvider) Start(ctx context.Context) error {
 }
 
 func (P *DstCommitProvider) Start(ctx context.Context) error {
+	if err := P.contractTransmitter.Start(ctx); err != nil {
+		return err
+	}
 	if P.startBlock != 0 {
 		P.lggr.Infow("start replaying dst chain", "fromBlock", P.startBlock)
 		return P.lp.Replay(ctx, int64(P.startBlock))
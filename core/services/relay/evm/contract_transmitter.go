@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/hex"
+	"maps"
 	"math/big"
 	"time"
 
@@ -231,12 +232,28 @@ func (oc *contractTransmitter) FromAccount() (ocrtypes.Account, error) {
 	return ocrtypes.Account(oc.transmitter.FromAddress().String()), nil
 }
 
-func (oc *contractTransmitter) Start(ctx context.Context) error { return nil }
-func (oc *contractTransmitter) Close() error                    { return nil }
+// Start starts the transmitter if it has a lifecycle, e.g. to track the user operations of a UserOpTransmitter.
+func (oc *contractTransmitter) Start(ctx context.Context) error {
+	if s, ok := oc.transmitter.(services.ServiceCtx); ok {
+		return s.Start(ctx)
+	}
+	return nil
+}
+
+func (oc *contractTransmitter) Close() error {
+	if s, ok := oc.transmitter.(services.ServiceCtx); ok {
+		return s.Close()
+	}
+	return nil
+}
 
-// Has no state/lifecycle so it's always healthy and ready
+// Has no state/lifecycle of its own so it's always healthy and ready
 func (oc *contractTransmitter) Ready() error { return nil }
 func (oc *contractTransmitter) HealthReport() map[string]error {
-	return map[string]error{oc.Name(): nil}
+	hp := map[string]error{oc.Name(): nil}
+	if s, ok := oc.transmitter.(services.ServiceCtx); ok {
+		maps.Copy(hp, s.HealthReport())
+	}
+	return hp
 }
 func (oc *contractTransmitter) Name() string { return oc.lggr.Name() }
@@ -35,6 +35,7 @@ import (
 	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -675,7 +676,7 @@ type configTransmitterOpts struct {
 
 // newOnChainContractTransmitter creates a new contract transmitter.
 func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts, transmissionContractABI abi.ABI, ocrTransmitterOpts ...OCRTransmitterOption) (*contractTransmitter, error) {
-	transmitter, err := generateTransmitterFrom(ctx, rargs, ethKeystore, configWatcher, opts)
+	transmitter, err := generateTransmitterFrom(ctx, lggr, rargs, ethKeystore, configWatcher, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -692,7 +693,23 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 	)
 }
 
-func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts) (Transmitter, error) {
+// newTxStrategy creates the TxStrategy selected in the relay config, or a queueing strategy by default.
+func newTxStrategy(subject uuid.UUID, relayConfig types.RelayConfig) (txmgrtypes.TxStrategy, error) {
+	if relayConfig.TxStrategy == nil {
+		return txmgrcommon.NewQueueingTxStrategy(subject, relayConfig.DefaultTransactionQueueDepth), nil
+	}
+	cfg := *relayConfig.TxStrategy
+	if cfg.QueueSize == 0 {
+		cfg.QueueSize = relayConfig.DefaultTransactionQueueDepth
+	}
+	strategy, err := txmgrcommon.NewTxStrategy(subject, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", types.ErrBadRelayConfig, err)
+	}
+	return strategy, nil
+}
+
+func generateTransmitterFrom(ctx context.Context, lggr logger.Logger, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts) (Transmitter, error) {
 	var relayConfig types.RelayConfig
 	if err := json.Unmarshal(rargs.RelayConfig, &relayConfig); err != nil {
 		return nil, err
@@ -721,13 +738,34 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 		fromAddresses = append(fromAddresses, common.HexToAddress(s))
 	}
 
+	if relayConfig.UserOpTransmitter != nil {
+		if sendingKeysLength != 1 {
+			return nil, pkgerrors.New("a user operation transmitter requires exactly one sending key, which owns the smart contract account")
+		}
+		cfg := *relayConfig.UserOpTransmitter
+		if cfg.Account == nil {
+			cfg.Account = &effectiveTransmitterAddress
+		}
+		if cfg.Owner == nil {
+			cfg.Owner = &fromAddresses[0]
+		}
+		transmitter, err := NewUserOpTransmitter(lggr, cfg, configWatcher.chain, ethKeystore)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to create user operation transmitter")
+		}
+		return transmitter, nil
+	}
+
 	subject := rargs.ExternalJobID
 	if opts.subjectID != nil {
 		subject = *opts.subjectID
 	}
-	strategy := txmgrcommon.NewQueueingTxStrategy(subject, relayConfig.DefaultTransactionQueueDepth)
+	strategy, err := newTxStrategy(subject, relayConfig)
+	if err != nil {
+		return nil, err
+	}
 
-	var checker txm.TransmitCheckerSpec
+	checker := txm.TransmitCheckerSpec{Simulate: relayConfig.SimulateBeforeBroadcast}
 	if relayConfig.SimulateTransactions {
 		checker.CheckerType = txm.TransmitCheckerTypeSimulate
 	}
@@ -742,7 +780,6 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 	}
 
 	var transmitter Transmitter
-	var err error
 
 	switch commontypes.OCR2PluginType(rargs.ProviderType) {
 	case commontypes.Median:
@@ -792,7 +829,16 @@ func (r *Relayer) NewChainWriter(_ context.Context, config []byte) (commontypes.
 		return nil, fmt.Errorf("failed to unmarshall chain writer config err: %s", err)
 	}
 
-	return NewChainWriterService(r.lggr, r.chain.Client(), r.chain.TxManager(), r.chain.GasEstimator(), cfg)
+	var opts []ChainWriterOption
+	if cfg.UserOpTransmitter != nil {
+		userOps, err := NewUserOpTransmitter(r.lggr, *cfg.UserOpTransmitter, r.chain, r.ks.Eth())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user operation transmitter: %w", err)
+		}
+		opts = append(opts, WithUserOpTransmitter(userOps))
+	}
+
+	return NewChainWriterService(r.lggr, r.chain.Client(), r.chain.TxManager(), r.chain.GasEstimator(), cfg, opts...)
 }
 
 func (r *Relayer) NewContractReader(chainReaderConfig []byte) (commontypes.ContractReader, error) {
@@ -277,6 +277,9 @@ func (d *DstExecProvider) Name() string {
 }
 
 func (d *DstExecProvider) Start(ctx context.Context) error {
+	if err := d.contractTransmitter.Start(ctx); err != nil {
+		return err
+	}
 	if d.startBlock != 0 {
 		d.lggr.Infow("start replaying dst chain", "fromBlock", d.startBlock)
 		return d.lp.Replay(ctx, int64(d.startBlock))
@@ -291,7 +294,7 @@ func (d *DstExecProvider) Start(ctx context.Context) error {
 func (d *DstExecProvider) Close() error {
 	versionFinder := ccip.NewEvmVersionFinder()
 
-	unregisterFuncs := make([]func() error, 0, 2)
+	unregisterFuncs := make([]func() error, 0, 3)
 	unregisterFuncs = append(unregisterFuncs, func() error {
 		if d.seenCommitStoreAddr == nil {
 			return nil
@@ -301,6 +304,7 @@ func (d *DstExecProvider) Close() error {
 	unregisterFuncs = append(unregisterFuncs, func() error {
 		return ccip.CloseOffRampReader(d.lggr, versionFinder, d.offRampAddress, d.client, d.lp, nil, big.NewInt(0))
 	})
+	unregisterFuncs = append(unregisterFuncs, d.contractTransmitter.Close)
 
 	var multiErr error
 	for _, fn := range unregisterFuncs {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-automation/pkg/v3/plugin"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/automation"
@@ -190,6 +191,19 @@ type ocr2keeperProvider struct {
 	logEventProvider          automation.LogEventProvider
 	logRecoverer              automation.LogRecoverer
 	conditionalUpkeepProvider automation.ConditionalUpkeepProvider
+	ms                        services.MultiStart
+}
+
+func (c *ocr2keeperProvider) Start(ctx context.Context) error {
+	return c.ms.Start(ctx, c.configWatcher, c.contractTransmitter)
+}
+
+func (c *ocr2keeperProvider) Close() error { return c.ms.Close() }
+
+func (c *ocr2keeperProvider) HealthReport() map[string]error {
+	hp := c.configWatcher.HealthReport()
+	services.CopyHealth(hp, c.contractTransmitter.HealthReport())
+	return hp
 }
 
 func (c *ocr2keeperProvider) ContractTransmitter() ocrtypes.ContractTransmitter {
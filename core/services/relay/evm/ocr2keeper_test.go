@@ -0,0 +1,74 @@
+package evm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// fakeConfigPoller only tracks whether it was started
+type fakeConfigPoller struct {
+	types.ConfigPoller
+	started, closed bool
+}
+
+func (p *fakeConfigPoller) Start()       { p.started = true }
+func (p *fakeConfigPoller) Close() error { p.closed = true; return nil }
+
+// serviceTransmitter is a Transmitter with a lifecycle, like the UserOpTransmitter
+type serviceTransmitter struct {
+	mockTransmitter
+	services.StateMachine
+}
+
+func (t *serviceTransmitter) Start(context.Context) error {
+	return t.StartOnce("serviceTransmitter", func() error { return nil })
+}
+
+func (t *serviceTransmitter) Close() error {
+	return t.StopOnce("serviceTransmitter", func() error { return nil })
+}
+
+func (t *serviceTransmitter) Name() string { return "serviceTransmitter" }
+
+func (t *serviceTransmitter) HealthReport() map[string]error {
+	return map[string]error{t.Name(): t.Healthy()}
+}
+
+func TestOCR2KeeperProvider_StartsContractTransmitter(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("RegisterFilter", mock.Anything, mock.Anything).Return(nil)
+
+	configPoller := &fakeConfigPoller{}
+	transmitter := &serviceTransmitter{}
+	contractTransmitter, err := NewOCRContractTransmitter(ctx, testutils.NewAddress(), nil, OCR2AggregatorTransmissionContractABI, transmitter, lp, lggr)
+	require.NoError(t, err)
+
+	provider := &ocr2keeperProvider{
+		configWatcher:       newConfigWatcher(lggr, testutils.NewAddress(), nil, configPoller, nil, 0, false),
+		contractTransmitter: contractTransmitter,
+	}
+
+	require.NoError(t, provider.Start(ctx))
+	assert.True(t, configPoller.started)
+	require.NoError(t, transmitter.Ready())
+	assert.Contains(t, provider.HealthReport(), transmitter.Name())
+
+	require.NoError(t, provider.Close())
+	assert.True(t, configPoller.closed)
+	assert.Error(t, transmitter.Ready())
+}
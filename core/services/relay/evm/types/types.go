@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/lib/pq"
 	"gopkg.in/guregu/null.v4"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/codec"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -26,6 +29,54 @@ import (
 type ChainWriterConfig struct {
 	Contracts   map[string]*ContractConfig
 	MaxGasPrice *assets.Wei
+	// UserOpTransmitter sends the transactions as user operations of a smart contract account instead of txes of the
+	// methods' FromAddress.
+	UserOpTransmitter *UserOpTransmitterConfig
+}
+
+// UserOpTransmitterConfig configures the sending of transactions as ERC-4337 user operations of a smart contract account,
+// which are submitted through a bundler, so that the gas is paid by the account or sponsored by a paymaster.
+type UserOpTransmitterConfig struct {
+	// BundlerURL is the JSON-RPC endpoint of the ERC-4337 bundler.
+	BundlerURL string `json:"bundlerURL"`
+	// EntryPoint is the address of the EntryPoint contract handling the user operations.
+	EntryPoint common.Address `json:"entryPoint"`
+	// Account is the smart contract account sending the user operations. It defaults to the EffectiveTransmitterID for OCR jobs.
+	Account *common.Address `json:"account"`
+	// Owner is the key owning the Account, which signs the user operations. It defaults to the sending key for OCR jobs.
+	Owner *common.Address `json:"owner"`
+	// PaymasterURL is an optional ERC-7677 paymaster web service sponsoring the gas of the user operations.
+	PaymasterURL string `json:"paymasterURL"`
+	// PaymasterContext is passed to the paymaster web service, e.g. to select a sponsorship policy.
+	PaymasterContext map[string]any `json:"paymasterContext"`
+	// PaymasterAndData is set on the user operations if there is no PaymasterURL, e.g. a paymaster address followed by its data.
+	PaymasterAndData hexutil.Bytes `json:"paymasterAndData"`
+	// ReceiptTimeout is how long a user operation may remain without a receipt before it is considered dropped by the bundler.
+	ReceiptTimeout *models.Interval `json:"receiptTimeout"`
+}
+
+func (c UserOpTransmitterConfig) Validate() error {
+	if c.BundlerURL == "" {
+		return errors.New("bundlerURL is required")
+	}
+	if _, err := url.ParseRequestURI(c.BundlerURL); err != nil {
+		return fmt.Errorf("invalid bundlerURL: %w", err)
+	}
+	if c.EntryPoint == (common.Address{}) {
+		return errors.New("entryPoint is required")
+	}
+	if c.Account == nil || c.Owner == nil {
+		return errors.New("account and owner are required")
+	}
+	if c.PaymasterURL != "" {
+		if len(c.PaymasterAndData) > 0 {
+			return errors.New("paymasterURL and paymasterAndData are mutually exclusive")
+		}
+		if _, err := url.ParseRequestURI(c.PaymasterURL); err != nil {
+			return fmt.Errorf("invalid paymasterURL: %w", err)
+		}
+	}
+	return nil
 }
 
 type ContractConfig struct {
@@ -183,6 +234,13 @@ type RelayConfig struct {
 
 	DefaultTransactionQueueDepth uint32 `json:"defaultTransactionQueueDepth"`
 	SimulateTransactions         bool   `json:"simulateTransactions"`
+	// SimulateBeforeBroadcast overrides the chain's Transactions.SimulateBeforeBroadcast setting for the job's transactions.
+	SimulateBeforeBroadcast *bool `json:"simulateBeforeBroadcast"`
+	// TxStrategy selects a registered TxStrategy for the job's transactions. QueueSize defaults to DefaultTransactionQueueDepth.
+	TxStrategy *txmgrcommon.TxStrategyConfig `json:"txStrategy"`
+	// UserOpTransmitter transmits the job's reports as ERC-4337 user operations of the EffectiveTransmitterID, which must
+	// be a smart contract account owned by the job's only sending key.
+	UserOpTransmitter *UserOpTransmitterConfig `json:"userOpTransmitter"`
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
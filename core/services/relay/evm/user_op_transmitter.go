@@ -0,0 +1,475 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/transmission/generated/entry_point"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/transmission/generated/sca_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+const (
+	defaultUserOpReceiptTimeout = 10 * time.Minute
+	userOpReceiptPollInterval   = 5 * time.Second
+	// userOpStatusRetention is how long the status of a user operation is kept after it was finalized or failed.
+	userOpStatusRetention = time.Hour
+)
+
+var (
+	scaABI        = evmtypes.MustGetABI(sca_wrapper.SCAMetaData.ABI)
+	entryPointABI = evmtypes.MustGetABI(entry_point.EntryPointMetaData.ABI)
+
+	// dummyUserOpSignature is a well-formed signature, used to estimate the gas of a user operation before it is signed.
+	dummyUserOpSignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+
+	promUserOps = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_op_transmitter_user_operations",
+		Help: "Number of user operations sent to the bundler, and how many of them succeeded, reverted or were dropped",
+	}, []string{"chainID", "account", "status"})
+)
+
+// userOperation is the JSON-RPC representation of an ERC-4337 v0.6 user operation.
+type userOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+func (op userOperation) toEntryPoint() entry_point.UserOperation {
+	return entry_point.UserOperation{
+		Sender:               op.Sender,
+		Nonce:                op.Nonce.ToInt(),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         op.CallGasLimit.ToInt(),
+		VerificationGasLimit: op.VerificationGasLimit.ToInt(),
+		PreVerificationGas:   op.PreVerificationGas.ToInt(),
+		MaxFeePerGas:         op.MaxFeePerGas.ToInt(),
+		MaxPriorityFeePerGas: op.MaxPriorityFeePerGas.ToInt(),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+type userOpGasEstimate struct {
+	PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+}
+
+type paymasterResult struct {
+	PaymasterAndData hexutil.Bytes `json:"paymasterAndData"`
+}
+
+type userOpReceipt struct {
+	Success bool   `json:"success"`
+	Reason  string `json:"reason"`
+	Receipt struct {
+		TransactionHash common.Hash  `json:"transactionHash"`
+		BlockNumber     *hexutil.Big `json:"blockNumber"`
+	} `json:"receipt"`
+}
+
+// rpcCaller is a JSON-RPC endpoint of a bundler or paymaster.
+type rpcCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	Close()
+}
+
+type userOpChainClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error)
+}
+
+type userOpSigner interface {
+	SignUserOperation(ctx context.Context, address common.Address, op entry_point.UserOperation, entryPoint common.Address, chainID *big.Int) ([]byte, error)
+}
+
+type trackedUserOp struct {
+	id         string
+	sentAt     time.Time
+	status     commontypes.TransactionStatus
+	err        error
+	includedIn int64 // block number of the tx including the user operation
+	finishedAt time.Time
+}
+
+var _ Transmitter = &UserOpTransmitter{}
+
+// UserOpTransmitter sends transactions as ERC-4337 user operations of a Chainlink smart contract account (SCA),
+// see contracts/src/v0.8/transmission. The user operations are signed by the owner of the account and submitted through
+// a bundler, with the gas paid by the account's EntryPoint deposit or sponsored by a paymaster, so no EOA of the node has
+// to be funded. The account must already be deployed.
+//
+// Sent user operations are tracked until they are finalized, failed, or dropped by the bundler.
+type UserOpTransmitter struct {
+	services.StateMachine
+	lggr logger.SugaredLogger
+
+	bundler          rpcCaller
+	paymaster        rpcCaller // nil unless a paymaster web service is configured
+	client           userOpChainClient
+	estimator        gas.EvmFeeEstimator
+	signer           userOpSigner
+	chainID          *big.Int
+	entryPoint       common.Address
+	account          common.Address
+	owner            common.Address
+	paymasterContext map[string]any
+	paymasterAndData []byte
+	receiptTimeout   time.Duration
+	maxGasPrice      *assets.Wei
+
+	sendMu sync.Mutex // serializes sends, so user operations get consecutive nonces
+
+	mu        sync.Mutex
+	nextNonce *big.Int // nil if the nonce has to be read from the account
+	ops       map[common.Hash]*trackedUserOp
+	opsByID   map[string]common.Hash
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewUserOpTransmitter creates a UserOpTransmitter for the chain, which signs with the Owner key of the keystore.
+func NewUserOpTransmitter(lggr logger.Logger, cfg types.UserOpTransmitterConfig, chain legacyevm.Chain, signer userOpSigner) (*UserOpTransmitter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: invalid userOpTransmitter config: %w", types.ErrBadRelayConfig, err)
+	}
+	bundler, err := rpc.Dial(cfg.BundlerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial bundler: %w", err)
+	}
+	var paymaster rpcCaller
+	if cfg.PaymasterURL != "" {
+		if paymaster, err = rpc.Dial(cfg.PaymasterURL); err != nil {
+			return nil, fmt.Errorf("failed to dial paymaster: %w", err)
+		}
+	}
+	maxGasPrice := chain.Config().EVM().GasEstimator().PriceMaxKey(*cfg.Owner)
+	return newUserOpTransmitter(lggr, cfg, bundler, paymaster, chain.Client(), chain.GasEstimator(), signer, chain.ID(), maxGasPrice), nil
+}
+
+func newUserOpTransmitter(lggr logger.Logger, cfg types.UserOpTransmitterConfig, bundler, paymaster rpcCaller, client userOpChainClient,
+	estimator gas.EvmFeeEstimator, signer userOpSigner, chainID *big.Int, maxGasPrice *assets.Wei) *UserOpTransmitter {
+	receiptTimeout := defaultUserOpReceiptTimeout
+	if cfg.ReceiptTimeout != nil && !cfg.ReceiptTimeout.IsZero() {
+		receiptTimeout = cfg.ReceiptTimeout.Duration()
+	}
+	return &UserOpTransmitter{
+		lggr:             logger.Sugared(logger.Named(lggr, "UserOpTransmitter")),
+		bundler:          bundler,
+		paymaster:        paymaster,
+		client:           client,
+		estimator:        estimator,
+		signer:           signer,
+		chainID:          chainID,
+		entryPoint:       cfg.EntryPoint,
+		account:          *cfg.Account,
+		owner:            *cfg.Owner,
+		paymasterContext: cfg.PaymasterContext,
+		paymasterAndData: cfg.PaymasterAndData,
+		receiptTimeout:   receiptTimeout,
+		maxGasPrice:      maxGasPrice,
+		ops:              map[common.Hash]*trackedUserOp{},
+		opsByID:          map[string]common.Hash{},
+		stopCh:           make(chan struct{}),
+	}
+}
+
+func (t *UserOpTransmitter) Start(context.Context) error {
+	return t.StartOnce("UserOpTransmitter", func() error {
+		t.wg.Add(1)
+		go t.trackUserOps()
+		return nil
+	})
+}
+
+func (t *UserOpTransmitter) Close() error {
+	return t.StopOnce("UserOpTransmitter", func() error {
+		close(t.stopCh)
+		t.wg.Wait()
+		t.bundler.Close()
+		if t.paymaster != nil {
+			t.paymaster.Close()
+		}
+		return nil
+	})
+}
+
+func (t *UserOpTransmitter) Name() string { return t.lggr.Name() }
+
+func (t *UserOpTransmitter) HealthReport() map[string]error {
+	return map[string]error{t.Name(): t.Healthy()}
+}
+
+// FromAddress returns the smart contract account, which is the sender of the transmitted transactions.
+func (t *UserOpTransmitter) FromAddress() common.Address { return t.account }
+
+// CreateEthTransaction sends the payload to the contract as a user operation of the account.
+func (t *UserOpTransmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte, _ *txmgr.TxMeta) error {
+	_, err := t.SendUserOperation(ctx, toAddress, payload, big.NewInt(0), "")
+	return err
+}
+
+// SendUserOperation sends a user operation calling the contract with the payload and value from the account, and returns
+// its hash. A non-empty id identifies the user operation for TransactionStatus, and a user operation is sent at most once
+// per id.
+func (t *UserOpTransmitter) SendUserOperation(ctx context.Context, to common.Address, payload []byte, value *big.Int, id string) (common.Hash, error) {
+	t.sendMu.Lock()
+	defer t.sendMu.Unlock()
+
+	if id != "" {
+		t.mu.Lock()
+		hash, ok := t.opsByID[id]
+		t.mu.Unlock()
+		if ok {
+			return hash, nil
+		}
+	}
+
+	// The SCA checks the deadline of the call, zero meaning none.
+	callData, err := scaABI.Pack("executeTransactionFromEntryPoint", to, value, big.NewInt(0), payload)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode call: %w", err)
+	}
+	nonce, err := t.nonce(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	fee, _, err := t.estimator.GetFee(ctx, callData, 0, t.maxGasPrice, &t.account, &t.entryPoint)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to estimate fee: %w", err)
+	}
+	op := userOperation{
+		Sender:               t.account,
+		Nonce:                (*hexutil.Big)(nonce),
+		CallData:             callData,
+		CallGasLimit:         new(hexutil.Big),
+		VerificationGasLimit: new(hexutil.Big),
+		PreVerificationGas:   new(hexutil.Big),
+		PaymasterAndData:     t.paymasterAndData,
+		Signature:            dummyUserOpSignature,
+	}
+	if fee.ValidDynamic() {
+		op.MaxFeePerGas = (*hexutil.Big)(fee.DynamicFeeCap.ToInt())
+		op.MaxPriorityFeePerGas = (*hexutil.Big)(fee.DynamicTipCap.ToInt())
+	} else {
+		op.MaxFeePerGas = (*hexutil.Big)(fee.Legacy.ToInt())
+		op.MaxPriorityFeePerGas = (*hexutil.Big)(fee.Legacy.ToInt())
+	}
+
+	if t.paymaster != nil {
+		if op.PaymasterAndData, err = t.paymasterData(ctx, "pm_getPaymasterStubData", op); err != nil {
+			return common.Hash{}, err
+		}
+	}
+	var estimate userOpGasEstimate
+	if err = t.bundler.CallContext(ctx, &estimate, "eth_estimateUserOperationGas", op, t.entryPoint); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to estimate user operation gas: %w", err)
+	}
+	if estimate.CallGasLimit == nil || estimate.VerificationGasLimit == nil || estimate.PreVerificationGas == nil {
+		return common.Hash{}, fmt.Errorf("bundler returned an incomplete gas estimate: %+v", estimate)
+	}
+	op.CallGasLimit, op.VerificationGasLimit, op.PreVerificationGas = estimate.CallGasLimit, estimate.VerificationGasLimit, estimate.PreVerificationGas
+	if t.paymaster != nil {
+		if op.PaymasterAndData, err = t.paymasterData(ctx, "pm_getPaymasterData", op); err != nil {
+			return common.Hash{}, err
+		}
+	}
+
+	if op.Signature, err = t.sign(ctx, op); err != nil {
+		return common.Hash{}, err
+	}
+	var hash common.Hash
+	if err = t.bundler.CallContext(ctx, &hash, "eth_sendUserOperation", op, t.entryPoint); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send user operation: %w", err)
+	}
+
+	t.mu.Lock()
+	t.nextNonce = new(big.Int).Add(nonce, big.NewInt(1))
+	t.ops[hash] = &trackedUserOp{id: id, sentAt: time.Now(), status: commontypes.Pending}
+	if id != "" {
+		t.opsByID[id] = hash
+	}
+	t.mu.Unlock()
+	promUserOps.WithLabelValues(t.chainID.String(), t.account.String(), "sent").Inc()
+	t.lggr.Infow("Sent user operation", "userOpHash", hash, "nonce", nonce, "to", to, "id", id)
+	return hash, nil
+}
+
+// TransactionStatus returns the status of the user operation sent with the id, and the error it failed with, if any.
+func (t *UserOpTransmitter) TransactionStatus(id string) (commontypes.TransactionStatus, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	hash, ok := t.opsByID[id]
+	if !ok {
+		return commontypes.Unknown, fmt.Errorf("failed to find user operation with id %s", id)
+	}
+	op := t.ops[hash]
+	return op.status, op.err
+}
+
+// nonce returns the nonce of the account, taking into account the user operations which are not included yet.
+func (t *UserOpTransmitter) nonce(ctx context.Context) (*big.Int, error) {
+	out, err := callContract(ctx, t.account, scaABI, "s_nonce", nil, t.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce of account %s: %w", t.account, err)
+	}
+	nonce := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.nextNonce != nil && t.nextNonce.Cmp(nonce) > 0 {
+		return new(big.Int).Set(t.nextNonce), nil
+	}
+	return nonce, nil
+}
+
+func (t *UserOpTransmitter) paymasterData(ctx context.Context, method string, op userOperation) ([]byte, error) {
+	var res paymasterResult
+	if err := t.paymaster.CallContext(ctx, &res, method, op, t.entryPoint, hexutil.EncodeBig(t.chainID), t.paymasterContext); err != nil {
+		return nil, fmt.Errorf("failed to get paymaster data: %w", err)
+	}
+	return res.PaymasterAndData, nil
+}
+
+// sign returns the signature of the user operation by the owner of the account, as verified by SCA.validateUserOp.
+func (t *UserOpTransmitter) sign(ctx context.Context, op userOperation) ([]byte, error) {
+	sig, err := t.signer.SignUserOperation(ctx, t.owner, op.toEntryPoint(), t.entryPoint, t.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign user operation: %w", err)
+	}
+	return sig, nil
+}
+
+func (t *UserOpTransmitter) trackUserOps() {
+	defer t.wg.Done()
+	ctx, cancel := t.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(userOpReceiptPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.checkUserOps(ctx)
+		}
+	}
+}
+
+// checkUserOps updates the status of the user operations from their receipts, and the latest finalized block.
+func (t *UserOpTransmitter) checkUserOps(ctx context.Context) {
+	type opState struct {
+		hash common.Hash
+		trackedUserOp
+	}
+	var pending, included []opState
+	t.mu.Lock()
+	for hash, op := range t.ops {
+		switch {
+		case op.status == commontypes.Pending:
+			pending = append(pending, opState{hash, *op})
+		case op.status == commontypes.Unconfirmed:
+			included = append(included, opState{hash, *op})
+		case time.Since(op.finishedAt) > userOpStatusRetention:
+			delete(t.ops, hash)
+			if op.id != "" {
+				delete(t.opsByID, op.id)
+			}
+		}
+	}
+	t.mu.Unlock()
+
+	for _, op := range pending {
+		var receipt *userOpReceipt
+		if err := t.bundler.CallContext(ctx, &receipt, "eth_getUserOperationReceipt", op.hash); err != nil {
+			t.lggr.Warnw("Failed to get user operation receipt", "userOpHash", op.hash, "err", err)
+			continue
+		}
+		switch {
+		case receipt == nil:
+			if time.Since(op.sentAt) < t.receiptTimeout {
+				continue
+			}
+			// The nonce of a dropped user operation is not used, so it has to be read from the account again.
+			t.lggr.Warnw("User operation was not included in time, it was likely dropped by the bundler", "userOpHash", op.hash, "timeout", t.receiptTimeout)
+			promUserOps.WithLabelValues(t.chainID.String(), t.account.String(), "dropped").Inc()
+			t.finish(op.hash, commontypes.Failed, fmt.Errorf("user operation %s was not included after %s", op.hash, t.receiptTimeout), true)
+		case !receipt.Success:
+			t.lggr.Errorw("User operation reverted", "userOpHash", op.hash, "txHash", receipt.Receipt.TransactionHash, "reason", receipt.Reason)
+			promUserOps.WithLabelValues(t.chainID.String(), t.account.String(), "reverted").Inc()
+			t.finish(op.hash, commontypes.Failed, fmt.Errorf("user operation %s reverted: %s", op.hash, receipt.Reason), false)
+		default:
+			t.lggr.Debugw("User operation included", "userOpHash", op.hash, "txHash", receipt.Receipt.TransactionHash)
+			promUserOps.WithLabelValues(t.chainID.String(), t.account.String(), "succeeded").Inc()
+			t.mu.Lock()
+			if tracked, ok := t.ops[op.hash]; ok {
+				tracked.status = commontypes.Unconfirmed
+				if receipt.Receipt.BlockNumber != nil {
+					tracked.includedIn = receipt.Receipt.BlockNumber.ToInt().Int64()
+				}
+			}
+			t.mu.Unlock()
+		}
+	}
+
+	if len(included) == 0 {
+		return
+	}
+	finalized, err := t.client.LatestFinalizedBlock(ctx)
+	if err == nil && finalized == nil {
+		err = errors.New("no finalized block")
+	}
+	if err != nil {
+		t.lggr.Warnw("Failed to get latest finalized block", "err", err)
+		return
+	}
+	for _, op := range included {
+		if op.includedIn <= finalized.Number {
+			t.finish(op.hash, commontypes.Finalized, nil, false)
+		}
+	}
+}
+
+func (t *UserOpTransmitter) finish(hash common.Hash, status commontypes.TransactionStatus, err error, resetNonce bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if op, ok := t.ops[hash]; ok {
+		op.status, op.err, op.finishedAt = status, err, time.Now()
+	}
+	if resetNonce {
+		t.nextNonce = nil
+	}
+}
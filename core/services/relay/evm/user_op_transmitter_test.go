@@ -0,0 +1,341 @@
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/transmission/generated/entry_point"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/transmission/generated/greeter_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/transmission/generated/sca_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+var greeterABI = evmtypes.MustGetABI(greeter_wrapper.GreeterMetaData.ABI)
+
+type keySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s keySigner) SignUserOperation(_ context.Context, _ common.Address, op entry_point.UserOperation, entryPoint common.Address, chainID *big.Int) ([]byte, error) {
+	hash, err := keystore.SCAUserOperationHash(op, entryPoint, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(hash[:], s.key)
+}
+
+// finalizingClient considers every block finalized.
+type finalizingClient struct {
+	*evmclient.SimulatedBackendClient
+}
+
+func (c finalizingClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	return c.HeadByNumber(ctx, nil)
+}
+
+// fakeBundler handles the user operations sent to it with the EntryPoint of a simulated backend.
+type fakeBundler struct {
+	t          *testing.T
+	backend    *backends.SimulatedBackend
+	entryPoint *entry_point.EntryPoint
+	submitter  *bind.TransactOpts
+	execute    bool
+
+	estimated []userOperation
+	sent      []userOperation
+	receipts  map[common.Hash]*userOpReceipt
+	closed    bool
+}
+
+func (b *fakeBundler) Close() { b.closed = true }
+
+func (b *fakeBundler) CallContext(_ context.Context, result interface{}, method string, args ...interface{}) error {
+	var res any
+	switch method {
+	case "eth_estimateUserOperationGas":
+		b.estimated = append(b.estimated, args[0].(userOperation))
+		res = userOpGasEstimate{
+			PreVerificationGas:   (*hexutil.Big)(big.NewInt(100_000)),
+			VerificationGasLimit: (*hexutil.Big)(big.NewInt(1_000_000)),
+			CallGasLimit:         (*hexutil.Big)(big.NewInt(1_000_000)),
+		}
+	case "eth_sendUserOperation":
+		op := args[0].(userOperation)
+		b.sent = append(b.sent, op)
+		hash, err := b.entryPoint.GetUserOpHash(nil, op.toEntryPoint())
+		require.NoError(b.t, err)
+		if b.execute {
+			tx, err := b.entryPoint.HandleOps(b.submitter, []entry_point.UserOperation{op.toEntryPoint()}, b.submitter.From)
+			if err != nil {
+				return err
+			}
+			b.backend.Commit()
+			receipt, err := b.backend.TransactionReceipt(testutils.Context(b.t), tx.Hash())
+			require.NoError(b.t, err)
+			r := &userOpReceipt{Success: receipt.Status == 1}
+			r.Receipt.TransactionHash = tx.Hash()
+			r.Receipt.BlockNumber = (*hexutil.Big)(receipt.BlockNumber)
+			b.receipts[hash] = r
+		}
+		res = common.Hash(hash)
+	case "eth_getUserOperationReceipt":
+		res = b.receipts[args[0].(common.Hash)]
+	default:
+		return errors.New("unsupported method " + method)
+	}
+	enc, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(enc, result)
+}
+
+type fakePaymaster struct {
+	calls  []string
+	closed bool
+}
+
+func (p *fakePaymaster) Close() { p.closed = true }
+
+func (p *fakePaymaster) CallContext(_ context.Context, result interface{}, method string, _ ...interface{}) error {
+	p.calls = append(p.calls, method)
+	data := hexutil.Bytes{0x1}
+	if method == "pm_getPaymasterData" {
+		data = hexutil.Bytes{0x2}
+	}
+	return json.Unmarshal([]byte(`{"paymasterAndData":"`+data.String()+`"}`), result)
+}
+
+type userOpUniverse struct {
+	owner      common.Address
+	account    common.Address
+	entryPoint common.Address
+	greeter    *greeter_wrapper.Greeter
+	greeterAdr common.Address
+	client     finalizingClient
+	bundler    *fakeBundler
+	estimator  *gasmocks.EvmFeeEstimator
+	signer     keySigner
+}
+
+func newUserOpUniverse(t *testing.T) *userOpUniverse {
+	ownerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+	deployer := testutils.MustNewSimTransactor(t)
+	submitter := testutils.MustNewSimTransactor(t)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		deployer.From:  {Balance: assets.Ether(1000).ToInt()},
+		submitter.From: {Balance: assets.Ether(1000).ToInt()},
+	}, 30e6)
+	t.Cleanup(func() { require.NoError(t, backend.Close()) })
+
+	entryPointAddress, _, entryPoint, err := entry_point.DeployEntryPoint(deployer, backend)
+	require.NoError(t, err)
+	account, _, _, err := sca_wrapper.DeploySCA(deployer, backend, owner, entryPointAddress)
+	require.NoError(t, err)
+	greeterAddress, _, greeter, err := greeter_wrapper.DeployGreeter(deployer, backend)
+	require.NoError(t, err)
+	backend.Commit()
+	deployer.Value = assets.Ether(10).ToInt()
+	_, err = entryPoint.DepositTo(deployer, account)
+	require.NoError(t, err)
+	deployer.Value = nil
+	backend.Commit()
+
+	estimator := gasmocks.NewEvmFeeEstimator(t)
+	estimator.On("GetFee", mock.Anything, mock.Anything, uint64(0), assets.GWei(500), &account, &entryPointAddress).
+		Return(gas.EvmFee{DynamicFeeCap: assets.GWei(100), DynamicTipCap: assets.GWei(1)}, uint64(0), nil).Maybe()
+
+	return &userOpUniverse{
+		owner:      owner,
+		account:    account,
+		entryPoint: entryPointAddress,
+		greeter:    greeter,
+		greeterAdr: greeterAddress,
+		client:     finalizingClient{evmclient.NewSimulatedBackendClient(t, backend, testutils.SimulatedChainID)},
+		bundler: &fakeBundler{
+			t:          t,
+			backend:    backend,
+			entryPoint: entryPoint,
+			submitter:  submitter,
+			execute:    true,
+			receipts:   map[common.Hash]*userOpReceipt{},
+		},
+		estimator: estimator,
+		signer:    keySigner{ownerKey},
+	}
+}
+
+func (u *userOpUniverse) newTransmitter(t *testing.T, cfg types.UserOpTransmitterConfig, paymaster rpcCaller) *UserOpTransmitter {
+	cfg.EntryPoint, cfg.Account, cfg.Owner = u.entryPoint, &u.account, &u.owner
+	return newUserOpTransmitter(logger.TestLogger(t), cfg, u.bundler, paymaster, u.client, u.estimator, u.signer, testutils.SimulatedChainID, assets.GWei(500))
+}
+
+func TestUserOpTransmitter_Close(t *testing.T) {
+	t.Parallel()
+	u := newUserOpUniverse(t)
+	paymaster := &fakePaymaster{}
+	transmitter := u.newTransmitter(t, types.UserOpTransmitterConfig{}, paymaster)
+
+	require.NoError(t, transmitter.Start(testutils.Context(t)))
+	require.NoError(t, transmitter.Close())
+	assert.True(t, u.bundler.closed)
+	assert.True(t, paymaster.closed)
+}
+
+func TestUserOpTransmitter_SendUserOperation(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	u := newUserOpUniverse(t)
+	transmitter := u.newTransmitter(t, types.UserOpTransmitterConfig{}, nil)
+	require.Equal(t, u.account, transmitter.FromAddress())
+
+	payload, err := greeterABI.Pack("setGreeting", "hi")
+	require.NoError(t, err)
+	require.NoError(t, transmitter.CreateEthTransaction(ctx, u.greeterAdr, payload, nil))
+	greeting, err := u.greeter.GetGreeting(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "hi", greeting)
+
+	require.Len(t, u.bundler.sent, 1)
+	op := u.bundler.sent[0]
+	assert.Equal(t, int64(0), op.Nonce.ToInt().Int64())
+	assert.Equal(t, assets.GWei(100).ToInt(), op.MaxFeePerGas.ToInt())
+	assert.Equal(t, assets.GWei(1).ToInt(), op.MaxPriorityFeePerGas.ToInt())
+	assert.Equal(t, int64(1_000_000), op.CallGasLimit.ToInt().Int64())
+	assert.Equal(t, dummyUserOpSignature, []byte(u.bundler.estimated[0].Signature))
+
+	payload, err = greeterABI.Pack("setGreeting", "bye")
+	require.NoError(t, err)
+	hash, err := transmitter.SendUserOperation(ctx, u.greeterAdr, payload, big.NewInt(0), "tx-2")
+	require.NoError(t, err)
+	greeting, err = u.greeter.GetGreeting(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "bye", greeting)
+	assert.Equal(t, int64(1), u.bundler.sent[1].Nonce.ToInt().Int64())
+
+	t.Run("is sent once per id", func(t *testing.T) {
+		sameHash, err := transmitter.SendUserOperation(ctx, u.greeterAdr, payload, big.NewInt(0), "tx-2")
+		require.NoError(t, err)
+		assert.Equal(t, hash, sameHash)
+		assert.Len(t, u.bundler.sent, 2)
+	})
+
+	t.Run("tracks the status", func(t *testing.T) {
+		status, err := transmitter.TransactionStatus("tx-2")
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Pending, status)
+
+		transmitter.checkUserOps(ctx) // receipt
+		status, err = transmitter.TransactionStatus("tx-2")
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Unconfirmed, status)
+
+		transmitter.checkUserOps(ctx) // finality
+		status, err = transmitter.TransactionStatus("tx-2")
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Finalized, status)
+
+		_, err = transmitter.TransactionStatus("unknown")
+		require.Error(t, err)
+	})
+}
+
+func TestUserOpTransmitter_Paymaster(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	u := newUserOpUniverse(t)
+	u.bundler.execute = false
+	paymaster := &fakePaymaster{}
+	transmitter := u.newTransmitter(t, types.UserOpTransmitterConfig{PaymasterContext: map[string]any{"policyId": "abc"}}, paymaster)
+
+	_, err := transmitter.SendUserOperation(ctx, u.greeterAdr, []byte{0x1}, big.NewInt(0), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pm_getPaymasterStubData", "pm_getPaymasterData"}, paymaster.calls)
+	assert.Equal(t, hexutil.Bytes{0x1}, u.bundler.estimated[0].PaymasterAndData)
+	assert.Equal(t, hexutil.Bytes{0x2}, u.bundler.sent[0].PaymasterAndData)
+}
+
+func TestUserOpTransmitter_DroppedUserOperation(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	u := newUserOpUniverse(t)
+	u.bundler.execute = false
+	timeout := models.Interval(time.Nanosecond)
+	transmitter := u.newTransmitter(t, types.UserOpTransmitterConfig{ReceiptTimeout: &timeout}, nil)
+
+	_, err := transmitter.SendUserOperation(ctx, u.greeterAdr, []byte{0x1}, big.NewInt(0), "dropped")
+	require.NoError(t, err)
+	_, err = transmitter.SendUserOperation(ctx, u.greeterAdr, []byte{0x1}, big.NewInt(0), "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), u.bundler.sent[1].Nonce.ToInt().Int64())
+
+	transmitter.checkUserOps(ctx)
+	status, err := transmitter.TransactionStatus("dropped")
+	assert.Equal(t, commontypes.Failed, status)
+	require.ErrorContains(t, err, "was not included after")
+
+	// The nonces of dropped user operations are reused.
+	_, err = transmitter.SendUserOperation(ctx, u.greeterAdr, []byte{0x1}, big.NewInt(0), "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), u.bundler.sent[2].Nonce.ToInt().Int64())
+}
+
+func TestUserOpTransmitterConfig_Validate(t *testing.T) {
+	t.Parallel()
+	account, owner := testutils.NewAddress(), testutils.NewAddress()
+	valid := types.UserOpTransmitterConfig{
+		BundlerURL: "http://bundler.test",
+		EntryPoint: testutils.NewAddress(),
+		Account:    &account,
+		Owner:      &owner,
+	}
+	require.NoError(t, valid.Validate())
+
+	for _, tt := range []struct {
+		name   string
+		update func(*types.UserOpTransmitterConfig)
+		err    string
+	}{
+		{"missing bundler", func(c *types.UserOpTransmitterConfig) { c.BundlerURL = "" }, "bundlerURL is required"},
+		{"invalid bundler", func(c *types.UserOpTransmitterConfig) { c.BundlerURL = "bundler" }, "invalid bundlerURL"},
+		{"missing entry point", func(c *types.UserOpTransmitterConfig) { c.EntryPoint = common.Address{} }, "entryPoint is required"},
+		{"missing owner", func(c *types.UserOpTransmitterConfig) { c.Owner = nil }, "account and owner are required"},
+		{"both paymasters", func(c *types.UserOpTransmitterConfig) {
+			c.PaymasterURL, c.PaymasterAndData = "http://paymaster.test", []byte{0x1}
+		}, "mutually exclusive"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.update(&cfg)
+			require.ErrorContains(t, cfg.Validate(), tt.err)
+		})
+	}
+}
@@ -68,10 +68,12 @@ func (s *stream) executeRun(ctx context.Context) (*pipeline.Run, pipeline.TaskRu
 		s.Lock()
 		if s.spec.Pipeline == nil {
 			s.spec.Pipeline = pipeline
-			// initialize it for the given runner
-			if _, err := s.runner.InitializePipeline(*s.spec); err != nil {
+			// initialize it for the given runner, which also expands its include tasks
+			initialized, err := s.runner.InitializePipeline(*s.spec)
+			if err != nil {
 				return nil, nil, fmt.Errorf("Run failed due to error while initializing pipeline: %w", err)
 			}
+			s.spec.Pipeline = initialized
 		}
 		s.Unlock()
 	}
@@ -19,7 +19,6 @@ import (
 var UUID = uuid.New()
 
 type mockRunner struct {
-	p    *pipeline.Pipeline
 	run  *pipeline.Run
 	trrs pipeline.TaskRunResults
 	err  error
@@ -29,7 +28,7 @@ func (m *mockRunner) ExecuteRun(ctx context.Context, spec pipeline.Spec, vars pi
 	return m.run, m.trrs, m.err
 }
 func (m *mockRunner) InitializePipeline(spec pipeline.Spec) (p *pipeline.Pipeline, err error) {
-	return m.p, m.err
+	return spec.Pipeline, m.err
 }
 func (m *mockRunner) InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *pipeline.Run, saveSuccessfulTaskRuns bool) error {
 	return m.err
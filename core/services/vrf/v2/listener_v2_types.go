@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -242,6 +243,7 @@ func (lsn *listenerV2) processBatch(
 			EncodedPayload: payload,
 			FeeLimit:       uint64(totalGasLimitBumped),
 			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			Priority:       txmgrtypes.TxPriorityHigh,
 			Meta: &txmgr.TxMeta{
 				RequestIDs:      reqIDHashes,
 				MaxLink:         &maxLink,
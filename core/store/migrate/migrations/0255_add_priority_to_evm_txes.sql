@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE evm.txes ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE evm.txes DROP COLUMN priority;
+
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE evm.txes ADD COLUMN revert_reason text;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE evm.txes DROP COLUMN revert_reason;
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE pipeline_templates (
+	id BIGSERIAL PRIMARY KEY,
+	name TEXT NOT NULL CHECK (name <> ''),
+	version INT NOT NULL CHECK (version > 0),
+	dot_dag_source TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL,
+	CONSTRAINT pipeline_templates_name_version_key UNIQUE (name, version)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE pipeline_templates;
+
+-- +goose StatementEnd
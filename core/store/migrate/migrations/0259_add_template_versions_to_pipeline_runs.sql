@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE pipeline_runs ADD COLUMN template_versions jsonb;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE pipeline_runs DROP COLUMN template_versions;
+
+-- +goose StatementEnd
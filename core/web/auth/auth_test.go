@@ -300,12 +300,18 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/jobs", true, true, true},
 	{"GET", "/v2/jobs/MOCK", true, true, true},
 	{"POST", "/v2/jobs", false, false, true},
+	{"POST", "/v2/jobs/simulate", false, false, true},
+	{"POST", "/v2/jobs/replay", false, false, true},
 	{"DELETE", "/v2/jobs/MOCK", false, false, true},
 	{"GET", "/v2/pipeline/runs", true, true, true},
+	{"GET", "/v2/pipeline/runs/MOCK/replay_bundle", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
 	{"GET", "/v2/features", true, true, true},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
+	{"GET", "/v2/pipeline/templates", true, true, true},
+	{"GET", "/v2/pipeline/templates/MOCK", true, true, true},
+	{"POST", "/v2/pipeline/templates", false, false, true},
 	{"GET", "/v2/log", true, true, true},
 	{"PATCH", "/v2/log", false, false, false},
 	{"GET", "/v2/chains/evm", true, true, true},
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -338,6 +339,59 @@ func (ekc *ETHKeysController) Chain(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
+// RepairNonceGaps finds the nonce gaps blocking the transactions of the key and, unless dryRun is true, repairs them
+// Example:
+// "POST <application>/keys/evm/nonce_gaps?address=<address>&evmChainID=<chainID>&mode=fill&dryRun=false"
+func (ekc *ETHKeysController) RepairNonceGaps(c *gin.Context) {
+	keyID := c.Query("address")
+	if !common.IsHexAddress(keyID) {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("invalid address: %s, must be hex address", keyID))
+		return
+	}
+	address := common.HexToAddress(keyID)
+
+	chain, ok := ekc.getChain(c, c.Query("evmChainID"))
+	if !ok {
+		return
+	}
+
+	mode, err := txmgrcommon.ParseSequenceRepairMode(c.Query("mode"))
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dryRun := true
+	if dryRunStr := c.Query("dryRun"); dryRunStr != "" {
+		dryRun, err = strconv.ParseBool(dryRunStr)
+		if err != nil {
+			jsonAPIError(c, http.StatusBadRequest, errors.Wrapf(err, "invalid value for dryRun: expected boolean, got: %s", dryRunStr))
+			return
+		}
+	}
+
+	gasLimit := chain.Config().EVM().GasEstimator().LimitTransfer()
+	report, err := chain.TxManager().RepairSequenceGaps(c.Request.Context(), address, mode, gasLimit, dryRun)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if !dryRun {
+		ekc.app.GetAuditLogger().Audit(audit.KeyNonceGapsRepaired, map[string]interface{}{
+			"type":         "ethereum",
+			"id":           keyID,
+			"evmChainID":   chain.ID().String(),
+			"mode":         mode,
+			"gaps":         report.Gaps,
+			"filledTxIDs":  report.FilledTxIDs,
+			"rewoundTxIDs": report.RewoundTxIDs,
+		})
+	}
+
+	jsonAPIResponse(c, presenters.NewNonceGapReportResource(report, chain.ID()), "nonceGapReport")
+}
+
 func (ekc *ETHKeysController) setEthBalance(bal *big.Int) presenters.NewETHKeyOption {
 	return presenters.SetETHKeyEthBalance((*assets.Eth)(bal))
 }
@@ -12,6 +12,7 @@ import (
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	commonmocks "github.com/smartcontractkit/chainlink/v2/common/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -647,6 +648,82 @@ func TestETHKeysController_ChainFailure_MissingChainID(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestETHKeysController_RepairNonceGaps_DryRun(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	ethClient.On("PendingNonceAt", mock.Anything, mock.Anything).Return(uint64(0), nil)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+	require.NoError(t, app.KeyStore.Unlock(ctx, cltest.Password))
+
+	_, addr := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, addr)
+	ethClient.On("SequenceAt", mock.Anything, addr, mock.Anything).Return(evmtypes.Nonce(0), nil)
+
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	repairURL := url.URL{Path: "/v2/keys/evm/nonce_gaps"}
+	query := repairURL.Query()
+	query.Set("address", addr.Hex())
+	query.Set("evmChainID", cltest.FixtureChainID.String())
+	query.Set("mode", "rewind")
+	repairURL.RawQuery = query.Encode()
+
+	resp, cleanup := client.Post(repairURL.String(), nil)
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var report webpresenters.NonceGapReportResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &report))
+	assert.Equal(t, addr.Hex(), report.Address)
+	assert.Equal(t, cltest.FixtureChainID.String(), report.EVMChainID.String())
+	assert.True(t, report.DryRun)
+	assert.Equal(t, "rewind", report.Mode)
+	assert.Equal(t, []int64{0, 1}, report.Gaps)
+	require.NotNil(t, report.LatestNonce)
+	assert.Equal(t, int64(2), *report.LatestNonce)
+	assert.Empty(t, report.RewoundTxIDs)
+}
+
+func TestETHKeysController_RepairNonceGapsFailure_InvalidMode(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	ethClient.On("PendingNonceAt", mock.Anything, mock.Anything).Return(uint64(0), nil)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+
+	_, addr := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	require.NoError(t, app.KeyStore.Unlock(ctx, cltest.Password))
+
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	repairURL := url.URL{Path: "/v2/keys/evm/nonce_gaps"}
+	query := repairURL.Query()
+	query.Set("address", addr.Hex())
+	query.Set("evmChainID", cltest.FixtureChainID.String())
+	query.Set("mode", "invalid")
+	repairURL.RawQuery = query.Encode()
+
+	resp, cleanup := client.Post(repairURL.String(), nil)
+	defer cleanup()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
 func TestETHKeysController_DeleteSuccess(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -1,6 +1,8 @@
 package web
 
 import (
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -9,6 +11,16 @@ import (
 func NewEVMNodesController(app chainlink.Application) NodesController {
 	scopedNodeStatuser := NewNetworkScopedNodeStatuser(app.GetRelayers(), relay.NetworkEVM)
 
+	newResource := func(status types.NodeStatus) presenters.EVMNodeResource {
+		r := presenters.NewEVMNodeResource(status)
+		if chain, err := app.GetRelayers().LegacyEVMChains().Get(status.ChainID); err == nil {
+			if score, ok := chain.Client().NodeLatencyScores()[status.Name]; ok {
+				r.LatencyScore = score.String()
+			}
+		}
+		return r
+	}
+
 	return newNodesController[presenters.EVMNodeResource](
-		scopedNodeStatuser, ErrEVMNotEnabled, presenters.NewEVMNodeResource, app.GetAuditLogger())
+		scopedNodeStatuser, ErrEVMNotEnabled, newResource, app.GetAuditLogger())
 }
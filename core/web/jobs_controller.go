@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -135,6 +136,79 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to simulate a run of a job (V2), without creating it.
+type SimulateJobRequest struct {
+	TOML string `json:"toml"`
+	// Vars are the pipeline variables of the run, e.g. jobRun.requestBody for webhook jobs
+	Vars map[string]interface{} `json:"vars"`
+	// Stubs replace the results of tasks, keyed by their dot ID
+	Stubs pipeline.TaskStubs `json:"stubs"`
+}
+
+// Simulate executes the pipeline of a job spec in memory, and returns a trace of the run.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	spec, err := job.ValidatedPipelineSpec(request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		return
+	}
+
+	trace, err := jc.App.SimulateJobV2(c.Request.Context(), spec, request.Vars, request.Stubs)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineSimulationResource(trace), "pipelineSimulation")
+}
+
+// ReplayJobRequest represents a request to replay a recorded pipeline run against a job spec (V2).
+type ReplayJobRequest struct {
+	TOML   string                `json:"toml"`
+	Bundle pipeline.ReplayBundle `json:"bundle"`
+}
+
+// Replay executes the pipeline of a job spec in memory with the vars and the results of the external tasks of a
+// recorded run, and returns a trace of the run.
+// Example:
+// "POST <application>/jobs/replay"
+func (jc *JobsController) Replay(c *gin.Context) {
+	request := ReplayJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	spec, err := job.ValidatedPipelineSpec(request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		return
+	}
+	// the spec expands the template versions of the recorded run
+	spec.TemplateVersions = request.Bundle.TemplateVersions
+	stubs, err := request.Bundle.Stubs(spec, jc.App.PipelineORM().Templates())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	trace, err := jc.App.SimulateJobV2(c.Request.Context(), spec, request.Bundle.RunVars(), stubs)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineSimulationResource(trace), "pipelineSimulation")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -26,6 +26,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
@@ -37,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -522,6 +524,129 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	simulate := func(t *testing.T, request web.SimulateJobRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return response
+	}
+
+	t.Run("returns the trace of the run", func(t *testing.T) {
+		response := simulate(t, web.SimulateJobRequest{
+			TOML: `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+fetch    [type=bridge name="undeployed-bridge" requestData="{}"]
+parse    [type=jsonparse path="data,price" data="$(fetch)"]
+multiply [type=multiply input="$(parse)" times="$(scale)"]
+fetch -> parse -> multiply
+"""
+`,
+			Vars:  map[string]interface{}{"scale": 100},
+			Stubs: pipeline.TaskStubs{"fetch": {Value: `{"data": {"price": 1.5}}`}},
+		})
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		var resource presenters.PipelineSimulationResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, pipeline.RunStatusCompleted, resource.State)
+		assert.Equal(t, []interface{}{"150"}, resource.Outputs.Val)
+		require.Len(t, resource.Tasks, 3)
+		assert.Equal(t, "fetch", resource.Tasks[0].DotID)
+		assert.True(t, resource.Tasks[0].Stubbed)
+		assert.Equal(t, "parse", resource.Tasks[1].DotID)
+		require.Len(t, resource.Tasks[1].Inputs, 1)
+		assert.Equal(t, `{"data": {"price": 1.5}}`, resource.Tasks[1].Inputs[0].Value.Val)
+		assert.Contains(t, resource.DOT, `"parse" -> "multiply" [label="1.5"];`)
+
+		runs, err := app.PipelineORM().GetAllRuns(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Empty(t, runs, "simulated runs are not persisted")
+	})
+
+	t.Run("rejects invalid TOML", func(t *testing.T) {
+		response := simulate(t, web.SimulateJobRequest{TOML: `type = "webhook"`})
+		require.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+	})
+
+	t.Run("rejects unstubbed ethtx tasks", func(t *testing.T) {
+		response := simulate(t, web.SimulateJobRequest{TOML: `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+submit [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x"]
+"""
+`})
+		require.Equal(t, http.StatusBadRequest, response.StatusCode)
+		assert.Contains(t, string(cltest.ParseResponseBody(t, response)), "ethtx task submit must be stubbed to simulate the run")
+	})
+}
+
+func TestJobsController_Replay(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	replay := func(t *testing.T, request web.ReplayJobRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/replay", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return response
+	}
+	bundle := pipeline.ReplayBundle{
+		RunID: 1,
+		Vars:  jsonserializable.JSONSerializable{Val: map[string]interface{}{"scale": 100}, Valid: true},
+		Results: map[string]pipeline.RecordedResult{
+			"fetch": {Type: pipeline.TaskTypeBridge, TaskStub: pipeline.TaskStub{Value: `{"data": {"price": 1.5}}`}},
+		},
+	}
+
+	t.Run("replays the recorded run against the spec", func(t *testing.T) {
+		response := replay(t, web.ReplayJobRequest{
+			TOML: `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+fetch    [type=bridge name="undeployed-bridge" requestData="{}"]
+parse    [type=jsonparse path="data,price" data="$(fetch)"]
+multiply [type=multiply input="$(parse)" times="$(scale)"]
+fetch -> parse -> multiply
+"""
+`,
+			Bundle: bundle,
+		})
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		var resource presenters.PipelineSimulationResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, pipeline.RunStatusCompleted, resource.State)
+		assert.Equal(t, []interface{}{"150"}, resource.Outputs.Val)
+	})
+
+	t.Run("rejects specs with unrecorded external tasks", func(t *testing.T) {
+		response := replay(t, web.ReplayJobRequest{
+			TOML: `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+fetch [type=http method=GET url="https://chain.link/price"]
+"""
+`,
+			Bundle: bundle,
+		})
+		require.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+		assert.Contains(t, string(cltest.ParseResponseBody(t, response)), "no recorded results for tasks [fetch]")
+	})
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -2,6 +2,7 @@ package loader
 
 import (
 	"context"
+	"time"
 
 	"github.com/graph-gophers/dataloader"
 	"github.com/pkg/errors"
@@ -56,6 +57,24 @@ func GetNodesByChainID(ctx context.Context, id string) ([]types.Node, error) {
 	return nodes, nil
 }
 
+// GetNodeLatencyScoresByChainID fetches the latency scores of the nodes of a chain, by node name.
+func GetNodeLatencyScoresByChainID(ctx context.Context, id string) (map[string]time.Duration, error) {
+	ldr := For(ctx)
+
+	thunk := ldr.NodeLatencyScoresByChainIDLoader.Load(ctx, dataloader.StringKey(id))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	scores, ok := result.(map[string]time.Duration)
+	if !ok {
+		return nil, ErrInvalidType
+	}
+
+	return scores, nil
+}
+
 // GetFeedsManagerByID fetches the feed manager by ID.
 func GetFeedsManagerByID(ctx context.Context, id string) (*feeds.FeedsManager, error) {
 	ldr := For(ctx)
@@ -24,6 +24,7 @@ type Dataloader struct {
 	JobsByExternalJobIDs                      *dataloader.Loader
 	JobsByPipelineSpecIDLoader                *dataloader.Loader
 	NodesByChainIDLoader                      *dataloader.Loader
+	NodeLatencyScoresByChainIDLoader          *dataloader.Loader
 	SpecErrorsByJobIDLoader                   *dataloader.Loader
 }
 
@@ -54,6 +55,7 @@ func New(app chainlink.Application) *Dataloader {
 		JobsByExternalJobIDs:                      dataloader.NewBatchedLoader(jbs.loadByExternalJobIDs),
 		JobsByPipelineSpecIDLoader:                dataloader.NewBatchedLoader(jbs.loadByPipelineSpecIDs),
 		NodesByChainIDLoader:                      dataloader.NewBatchedLoader(nodes.loadByChainIDs),
+		NodeLatencyScoresByChainIDLoader:          dataloader.NewBatchedLoader(nodes.loadLatencyScoresByChainIDs),
 		SpecErrorsByJobIDLoader:                   dataloader.NewBatchedLoader(specErrs.loadByJobIDs),
 	}
 }
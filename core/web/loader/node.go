@@ -2,6 +2,7 @@ package loader
 
 import (
 	"context"
+	"time"
 
 	"github.com/graph-gophers/dataloader"
 
@@ -56,3 +57,19 @@ func (b *nodeBatcher) loadByChainIDs(ctx context.Context, keys dataloader.Keys)
 
 	return results
 }
+
+// loadLatencyScoresByChainIDs loads the latency scores of the nodes of EVM chains. Chains that are not running EVM
+// chains have no scores.
+func (b *nodeBatcher) loadLatencyScoresByChainIDs(_ context.Context, keys dataloader.Keys) []*dataloader.Result {
+	chains := b.app.GetRelayers().LegacyEVMChains()
+	results := make([]*dataloader.Result, len(keys))
+	for ix, key := range keys {
+		var scores map[string]time.Duration
+		if chain, err := chains.Get(key.String()); err == nil {
+			scores = chain.Client().NodeLatencyScores()
+		}
+		results[ix] = &dataloader.Result{Data: scores, Error: nil}
+	}
+
+	return results
+}
@@ -0,0 +1,42 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// LogPollerFiltersController lists the LogPoller filters.
+type LogPollerFiltersController struct {
+	App chainlink.Application
+}
+
+// Index lists the filters registered with the LogPoller of the chain, with the storage used by their logs and their
+// owner jobs
+// Example:
+//
+//	"<application>/v2/log_poller/filters?evmChainID=1"
+func (lpfc *LogPollerFiltersController) Index(c *gin.Context) {
+	chain, err := getChain(lpfc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	chainID := chain.ID()
+
+	filters, err := lpfc.App.LogPollerFilters(c.Request.Context(), chainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewLogPollerFilterResources(filters, chainID), "logPollerFilters")
+}
@@ -0,0 +1,36 @@
+package web_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+)
+
+func TestLogPollerFiltersController_Index(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	ec := setupEthClientForControllerTests(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, cltest.DefaultP2PKey, ec)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/log_poller/filters?evmChainID=1")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "chain id does not match any local chains")
+
+	resp, cleanup = client.Get("/v2/log_poller/filters")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	b, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "LogPollerFilters is only available if LogPoller is enabled")
+}
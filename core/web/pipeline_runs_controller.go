@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -85,6 +86,37 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// ReplayBundle records the external interactions of a finished pipeline run, to replay them with POST /jobs/replay.
+// Example:
+// "GET <application>/pipeline/runs/:runID/replay_bundle"
+func (prc *PipelineRunsController) ReplayBundle(c *gin.Context) {
+	ctx := c.Request.Context()
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	bundle, err := pipeline.NewReplayBundle(pipelineRun, prc.App.PipelineORM().Templates())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewReplayBundleResource(bundle), "replayBundle")
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
@@ -250,6 +250,28 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_ReplayBundle(t *testing.T) {
+	client, _, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/pipeline/runs/%d/replay_bundle", runIDs[0]))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.ReplayBundleResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+	require.NoError(t, err)
+	assert.Equal(t, runIDs[0], parsedResponse.RunID)
+	assert.Contains(t, parsedResponse.DotDagSource, "ds1_parse")
+	assert.Contains(t, parsedResponse.RunVars(), "jobRun")
+	assert.NotContains(t, parsedResponse.RunVars(), "ds1", "task results are not recorded as vars")
+	assert.Empty(t, parsedResponse.Results, "memo tasks are not recorded")
+	assert.Equal(t, []interface{}{"3"}, parsedResponse.Outputs.Val)
+
+	response, cleanup = client.Get("/v2/pipeline/runs/999999/replay_bundle")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -0,0 +1,83 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PipelineTemplatesController manages the pipeline templates which job pipelines can include.
+type PipelineTemplatesController struct {
+	App chainlink.Application
+}
+
+// CreatePipelineTemplateRequest is the request body to create a new version of a pipeline template.
+type CreatePipelineTemplateRequest struct {
+	Name         string `json:"name"`
+	DotDagSource string `json:"dotDagSource"`
+}
+
+// Index lists every version of every pipeline template.
+// Example:
+// "<application>/pipeline/templates"
+func (ptc *PipelineTemplatesController) Index(c *gin.Context) {
+	templates, err := ptc.App.PipelineORM().GetAllTemplates(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateResources(templates), "pipelineTemplates")
+}
+
+// Show lists the versions of a pipeline template, latest first.
+// Example:
+// "<application>/pipeline/templates/:Name"
+func (ptc *PipelineTemplatesController) Show(c *gin.Context) {
+	templates, err := ptc.App.PipelineORM().FindTemplates(c.Request.Context(), c.Param("Name"))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(templates) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline template not found"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateResources(templates), "pipelineTemplates")
+}
+
+// Create stores a new version of a pipeline template. Jobs including the template without pinning a version pick it
+// up on their next run.
+// Example:
+// "<application>/pipeline/templates"
+func (ptc *PipelineTemplatesController) Create(c *gin.Context) {
+	var request CreatePipelineTemplateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := pipeline.ValidateTemplate(request.Name, request.DotDagSource); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	template, err := ptc.App.PipelineORM().CreateTemplate(c.Request.Context(), request.Name, request.DotDagSource)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ptc.App.GetAuditLogger().Audit(audit.PipelineTemplateCreated, map[string]interface{}{
+		"name":    template.Name,
+		"version": template.Version,
+	})
+
+	jsonAPIResponseWithStatus(c, presenters.NewPipelineTemplateResource(template), "pipelineTemplate", http.StatusCreated)
+}
@@ -0,0 +1,65 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestPipelineTemplatesController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	name := testutils.RandomizeName("eth-usd")
+	create := func(t *testing.T, source string, expectedStatus int) {
+		body, err := json.Marshal(web.CreatePipelineTemplateRequest{Name: name, DotDagSource: source})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/pipeline/templates", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, expectedStatus)
+	}
+
+	t.Run("creates new versions", func(t *testing.T) {
+		create(t, `answer [type=memo value="{{ .answer }}"]`, http.StatusCreated)
+		create(t, `answer [type=memo value="{{ .answer }}0"]`, http.StatusCreated)
+
+		resp, cleanup := client.Get("/v2/pipeline/templates/" + name)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var resources []presenters.PipelineTemplateResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 2)
+		assert.Equal(t, int32(2), resources[0].Version)
+		assert.Equal(t, int32(1), resources[1].Version)
+	})
+
+	t.Run("includes the latest version", func(t *testing.T) {
+		p, err := pipeline.Parse(`price [type=include name="` + name + `" vars=<{"answer": 4}>]`)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 1)
+		assert.Equal(t, "40", p.Tasks[0].(*pipeline.MemoTask).Value)
+	})
+
+	t.Run("rejects invalid templates", func(t *testing.T) {
+		create(t, `answer [type=memo value="{{ .answer "]`, http.StatusBadRequest)
+	})
+
+	t.Run("returns 404 for unknown templates", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/pipeline/templates/unknown")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
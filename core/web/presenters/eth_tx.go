@@ -14,18 +14,20 @@ import (
 // EthTxResource represents a Ethereum Transaction JSONAPI resource.
 type EthTxResource struct {
 	JAID
-	State      string          `json:"state"`
-	Data       hexutil.Bytes   `json:"data"`
-	From       *common.Address `json:"from"`
-	GasLimit   string          `json:"gasLimit"`
-	GasPrice   string          `json:"gasPrice"`
-	Hash       common.Hash     `json:"hash"`
-	Hex        string          `json:"rawHex"`
-	Nonce      string          `json:"nonce"`
-	SentAt     string          `json:"sentAt"`
-	To         *common.Address `json:"to"`
-	Value      string          `json:"value"`
-	EVMChainID big.Big         `json:"evmChainID"`
+	State        string          `json:"state"`
+	Data         hexutil.Bytes   `json:"data"`
+	From         *common.Address `json:"from"`
+	GasLimit     string          `json:"gasLimit"`
+	GasPrice     string          `json:"gasPrice"`
+	Hash         common.Hash     `json:"hash"`
+	Hex          string          `json:"rawHex"`
+	Nonce        string          `json:"nonce"`
+	SentAt       string          `json:"sentAt"`
+	To           *common.Address `json:"to"`
+	Value        string          `json:"value"`
+	EVMChainID   big.Big         `json:"evmChainID"`
+	Priority     int32           `json:"priority"`
+	RevertReason string          `json:"revertReason,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -47,6 +49,10 @@ func NewEthTxResource(tx txmgr.Tx) EthTxResource {
 		State:    string(tx.State),
 		To:       &tx.ToAddress,
 		Value:    v.String(),
+		Priority: tx.Priority,
+	}
+	if tx.RevertReason.Valid {
+		r.RevertReason = tx.RevertReason.String
 	}
 
 	if tx.ChainID != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
@@ -30,6 +31,7 @@ func TestEthTxResource(t *testing.T) {
 		ChainID:        chainID,
 		State:          txmgrcommon.TxConfirmed,
 		Value:          big.Int(assets.NewEthValue(1)),
+		Priority:       txmgrtypes.TxPriorityHigh,
 	}
 
 	r := NewEthTxResource(tx)
@@ -54,7 +56,8 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "54321"
+			"evmChainID": "54321",
+			"priority": 100
 		  }
 		}
 	  }
@@ -100,7 +103,8 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "300",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "54321"
+			"evmChainID": "54321",
+			"priority": 100
 		  }
 		}
 	  }
@@ -0,0 +1,64 @@
+package presenters
+
+import (
+	"math/big"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+// LogPollerFilterResource represents a LogPoller filter JSONAPI resource, with the storage used by its logs.
+type LogPollerFilterResource struct {
+	JAID
+	EVMChainID   ubig.Big `json:"evmChainID"`
+	Addresses    []string `json:"addresses"`
+	EventSigs    []string `json:"eventSigs"`
+	Retention    string   `json:"retention"`
+	MaxLogsKept  uint64   `json:"maxLogsKept"`
+	LogsPerBlock uint64   `json:"logsPerBlock"`
+	Logs         int64    `json:"logs"`
+	SizeBytes    int64    `json:"sizeBytes"`
+	LogsLastHour int64    `json:"logsLastHour"`
+	JobIDs       []int32  `json:"jobIDs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LogPollerFilterResource) GetName() string {
+	return "logPollerFilters"
+}
+
+// NewLogPollerFilterResource constructs a new LogPollerFilterResource from a LogPollerFilter.
+func NewLogPollerFilterResource(filter chainlink.LogPollerFilter, chainID *big.Int) LogPollerFilterResource {
+	r := LogPollerFilterResource{
+		JAID:         NewJAID(filter.Name),
+		EVMChainID:   *ubig.New(chainID),
+		Addresses:    []string{},
+		EventSigs:    []string{},
+		Retention:    filter.Retention.String(),
+		MaxLogsKept:  filter.MaxLogsKept,
+		LogsPerBlock: filter.LogsPerBlock,
+		Logs:         filter.Logs,
+		SizeBytes:    filter.SizeBytes,
+		LogsLastHour: filter.LogsLastHour,
+		JobIDs:       filter.JobIDs,
+	}
+	for _, address := range filter.Addresses {
+		r.Addresses = append(r.Addresses, address.Hex())
+	}
+	for _, eventSig := range filter.EventSigs {
+		r.EventSigs = append(r.EventSigs, eventSig.Hex())
+	}
+	if r.JobIDs == nil {
+		r.JobIDs = []int32{}
+	}
+	return r
+}
+
+// NewLogPollerFilterResources constructs a slice of LogPollerFilterResources from LogPollerFilters.
+func NewLogPollerFilterResources(filters []chainlink.LogPollerFilter, chainID *big.Int) []LogPollerFilterResource {
+	rs := []LogPollerFilterResource{}
+	for _, filter := range filters {
+		rs = append(rs, NewLogPollerFilterResource(filter, chainID))
+	}
+	return rs
+}
@@ -0,0 +1,56 @@
+package presenters
+
+import (
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// NonceGapReportResource represents the nonce gaps of an EVM key JSONAPI resource.
+type NonceGapReportResource struct {
+	JAID
+	EVMChainID   ubig.Big `json:"evmChainID"`
+	Address      string   `json:"address"`
+	OnChainNonce int64    `json:"onChainNonce"`
+	LatestNonce  *int64   `json:"latestNonce"`
+	Gaps         []int64  `json:"gaps"`
+	Mode         string   `json:"mode"`
+	DryRun       bool     `json:"dryRun"`
+	FilledTxIDs  []int64  `json:"filledTxIDs"`
+	RewoundTxIDs []int64  `json:"rewoundTxIDs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r NonceGapReportResource) GetName() string {
+	return "nonceGapReports"
+}
+
+// NewNonceGapReportResource constructs a new NonceGapReportResource from a NonceGapReport.
+func NewNonceGapReportResource(report txmgr.NonceGapReport, chainID *big.Int) *NonceGapReportResource {
+	r := &NonceGapReportResource{
+		JAID:         NewPrefixedJAID(report.Address.Hex(), chainID.String()),
+		EVMChainID:   *ubig.New(chainID),
+		Address:      report.Address.Hex(),
+		OnChainNonce: report.OnChainSequence.Int64(),
+		Gaps:         []int64{},
+		Mode:         string(report.Mode),
+		DryRun:       report.DryRun,
+		FilledTxIDs:  report.FilledTxIDs,
+		RewoundTxIDs: report.RewoundTxIDs,
+	}
+	if report.LatestSequence != nil {
+		latest := report.LatestSequence.Int64()
+		r.LatestNonce = &latest
+	}
+	for _, gap := range report.Gaps {
+		r.Gaps = append(r.Gaps, gap.Int64())
+	}
+	if r.FilledTxIDs == nil {
+		r.FilledTxIDs = []int64{}
+	}
+	if r.RewoundTxIDs == nil {
+		r.RewoundTxIDs = []int64{}
+	}
+	return r
+}
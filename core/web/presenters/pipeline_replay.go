@@ -0,0 +1,24 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// ReplayBundleResource represents the replay bundle of a pipeline run JSONAPI resource.
+type ReplayBundleResource struct {
+	JAID
+	pipeline.ReplayBundle
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReplayBundleResource) GetName() string {
+	return "replayBundles"
+}
+
+// NewReplayBundleResource constructs a new ReplayBundleResource, identified by the ID of the recorded run.
+func NewReplayBundleResource(bundle *pipeline.ReplayBundle) *ReplayBundleResource {
+	return &ReplayBundleResource{
+		JAID:         NewJAIDInt64(bundle.RunID),
+		ReplayBundle: *bundle,
+	}
+}
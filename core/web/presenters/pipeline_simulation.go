@@ -0,0 +1,30 @@
+package presenters
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineSimulationResource represents the trace of a simulated pipeline run JSONAPI resource.
+type PipelineSimulationResource struct {
+	JAID
+	pipeline.RunTrace
+	// DOT is the pipeline annotated with the results of the run
+	DOT string `json:"dot"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineSimulationResource) GetName() string {
+	return "pipelineSimulations"
+}
+
+// NewPipelineSimulationResource constructs a new PipelineSimulationResource. Simulated runs are not persisted, so
+// they are identified by a random ID.
+func NewPipelineSimulationResource(trace *pipeline.RunTrace) *PipelineSimulationResource {
+	return &PipelineSimulationResource{
+		JAID:     NewJAID(uuid.New().String()),
+		RunTrace: *trace,
+		DOT:      trace.DOT(),
+	}
+}
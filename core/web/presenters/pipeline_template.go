@@ -0,0 +1,42 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineTemplateResource represents a version of a pipeline template JSONAPI resource.
+type PipelineTemplateResource struct {
+	JAID
+	Name         string    `json:"name"`
+	Version      int32     `json:"version"`
+	DotDagSource string    `json:"dotDagSource"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineTemplateResource) GetName() string {
+	return "pipelineTemplates"
+}
+
+// NewPipelineTemplateResource constructs a new PipelineTemplateResource, identified by its name and version
+func NewPipelineTemplateResource(t pipeline.Template) *PipelineTemplateResource {
+	return &PipelineTemplateResource{
+		JAID:         NewJAID(fmt.Sprintf("%s@%d", t.Name, t.Version)),
+		Name:         t.Name,
+		Version:      t.Version,
+		DotDagSource: t.DotDagSource,
+		CreatedAt:    t.CreatedAt,
+	}
+}
+
+// NewPipelineTemplateResources constructs a slice of PipelineTemplateResources
+func NewPipelineTemplateResources(templates []pipeline.Template) []PipelineTemplateResource {
+	rs := []PipelineTemplateResource{}
+	for _, t := range templates {
+		rs = append(rs, *NewPipelineTemplateResource(t))
+	}
+	return rs
+}
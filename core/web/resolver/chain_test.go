@@ -64,6 +64,7 @@ MaxQueued = 99
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
+SimulateBeforeBroadcast = false
 `
 	)
 	var chain evmtoml.EVMConfig
@@ -180,6 +181,7 @@ MaxQueued = 99
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
+SimulateBeforeBroadcast = false
 `
 	)
 	var chain evmtoml.Chain
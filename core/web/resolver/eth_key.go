@@ -2,10 +2,15 @@ package resolver
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
@@ -131,3 +136,119 @@ func NewETHKeysPayload(keys []ETHKey) *ETHKeysPayloadResolver {
 func (r *ETHKeysPayloadResolver) Results() []*ETHKeyResolver {
 	return NewETHKeys(r.keys)
 }
+
+// -- RepairNonceGaps Mutation --
+
+type NonceGapReportResolver struct {
+	report  txmgr.NonceGapReport
+	chainID string
+}
+
+func NewNonceGapReport(report txmgr.NonceGapReport, chainID string) *NonceGapReportResolver {
+	return &NonceGapReportResolver{report: report, chainID: chainID}
+}
+
+func (r *NonceGapReportResolver) Address() string {
+	return r.report.Address.Hex()
+}
+
+func (r *NonceGapReportResolver) Chain(ctx context.Context) (*ChainResolver, error) {
+	chain, err := loader.GetChainByID(ctx, r.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChain(*chain), nil
+}
+
+func (r *NonceGapReportResolver) OnChainNonce() string {
+	return r.report.OnChainSequence.String()
+}
+
+func (r *NonceGapReportResolver) LatestNonce() *string {
+	if r.report.LatestSequence == nil {
+		return nil
+	}
+	latest := r.report.LatestSequence.String()
+	return &latest
+}
+
+func (r *NonceGapReportResolver) Gaps() []string {
+	gaps := []string{}
+	for _, gap := range r.report.Gaps {
+		gaps = append(gaps, gap.String())
+	}
+	return gaps
+}
+
+func (r *NonceGapReportResolver) Mode() string {
+	return strings.ToUpper(string(r.report.Mode))
+}
+
+func (r *NonceGapReportResolver) DryRun() bool {
+	return r.report.DryRun
+}
+
+func (r *NonceGapReportResolver) FilledTxIDs() []graphql.ID {
+	return toGraphQLIDs(r.report.FilledTxIDs)
+}
+
+func (r *NonceGapReportResolver) RewoundTxIDs() []graphql.ID {
+	return toGraphQLIDs(r.report.RewoundTxIDs)
+}
+
+func toGraphQLIDs(ids []int64) []graphql.ID {
+	gids := []graphql.ID{}
+	for _, id := range ids {
+		gids = append(gids, graphql.ID(strconv.FormatInt(id, 10)))
+	}
+	return gids
+}
+
+type RepairNonceGapsPayloadResolver struct {
+	report    *NonceGapReportResolver
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewRepairNonceGapsPayload(report *NonceGapReportResolver, err error, inputErrs map[string]string) *RepairNonceGapsPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "chain not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, chains.ErrNoSuchChainID)
+	}}
+
+	return &RepairNonceGapsPayloadResolver{report: report, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *RepairNonceGapsPayloadResolver) ToRepairNonceGapsSuccess() (*RepairNonceGapsSuccessResolver, bool) {
+	if r.report == nil {
+		return nil, false
+	}
+
+	return NewRepairNonceGapsSuccess(r.report), true
+}
+
+func (r *RepairNonceGapsPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type RepairNonceGapsSuccessResolver struct {
+	report *NonceGapReportResolver
+}
+
+func NewRepairNonceGapsSuccess(report *NonceGapReportResolver) *RepairNonceGapsSuccessResolver {
+	return &RepairNonceGapsSuccessResolver{report: report}
+}
+
+func (r *RepairNonceGapsSuccessResolver) Report() *NonceGapReportResolver {
+	return r.report
+}
@@ -13,10 +13,13 @@ import (
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	mocks2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -429,3 +432,132 @@ func TestResolver_ETHKeys(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_RepairNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RepairNonceGaps($input: RepairNonceGapsInput!) {
+			repairNonceGaps(input: $input) {
+				... on RepairNonceGapsSuccess {
+					report {
+						address
+						onChainNonce
+						latestNonce
+						gaps
+						mode
+						dryRun
+						filledTxIDs
+						rewoundTxIDs
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+
+	address := common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+	variables := func(addr, chainID, mode string) map[string]interface{} {
+		return map[string]interface{}{
+			"input": map[string]interface{}{
+				"address":    addr,
+				"evmChainID": chainID,
+				"mode":       mode,
+				"dryRun":     true,
+			},
+		}
+	}
+	latest := evmtypes.Nonce(5)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables(address.Hex(), "12", "FILL")}, "repairNonceGaps"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				txm := txmmocks.NewMockEvmTxManager(t)
+				txm.On("RepairSequenceGaps", mock.Anything, address, txmgrcommon.SequenceRepairFill, uint64(21000), true).Return(txmgr.NonceGapReport{
+					Address:         address,
+					OnChainSequence: 2,
+					LatestSequence:  &latest,
+					Gaps:            []evmtypes.Nonce{2, 4},
+					Mode:            txmgrcommon.SequenceRepairFill,
+					DryRun:          true,
+				}, nil)
+				gasEstimator := mocks2.NewGasEstimator(t)
+				gasEstimator.On("LimitTransfer").Return(uint64(21000))
+
+				cfg := configtest.NewGeneralConfig(t, nil)
+				legacyEVMChains := legacyevm.NewLegacyChains(map[string]legacyevm.Chain{"12": f.Mocks.chain}, cfg.EVMConfigs())
+				f.Mocks.chain.On("ID").Return(big.NewI(12).ToInt())
+				f.Mocks.chain.On("Config").Return(f.Mocks.scfg)
+				f.Mocks.chain.On("TxManager").Return(txm)
+				f.Mocks.scfg.On("EVM").Return(&mockEvmConfig{gasEstimatorMock: gasEstimator})
+				f.Mocks.relayerChainInterops.EVMChains = legacyEVMChains
+				f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+			},
+			query:     mutation,
+			variables: variables(address.Hex(), "12", "FILL"),
+			result: `
+				{
+					"repairNonceGaps": {
+						"report": {
+							"address": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"onChainNonce": "2",
+							"latestNonce": "5",
+							"gaps": ["2", "4"],
+							"mode": "FILL",
+							"dryRun": true,
+							"filledTxIDs": [],
+							"rewoundTxIDs": []
+						}
+					}
+				}`,
+		},
+		{
+			name:          "chain not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				cfg := configtest.NewGeneralConfig(t, nil)
+				f.Mocks.relayerChainInterops.EVMChains = legacyevm.NewLegacyChains(map[string]legacyevm.Chain{}, cfg.EVMConfigs())
+				f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+			},
+			query:     mutation,
+			variables: variables(address.Hex(), "12", "REWIND"),
+			result: `
+				{
+					"repairNonceGaps": {
+						"message": "chain not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+		{
+			name:          "invalid address",
+			authenticated: true,
+			query:         mutation,
+			variables:     variables("0xinvalid", "12", "FILL"),
+			result: `
+				{
+					"repairNonceGaps": {
+						"errors": [{
+							"path": "input/address",
+							"message": "invalid hex address",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
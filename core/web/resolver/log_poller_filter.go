@@ -0,0 +1,125 @@
+package resolver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
+)
+
+type LogPollerFilterResolver struct {
+	filter  chainlink.LogPollerFilter
+	chainID string
+}
+
+func NewLogPollerFilter(filter chainlink.LogPollerFilter, chainID string) *LogPollerFilterResolver {
+	return &LogPollerFilterResolver{filter: filter, chainID: chainID}
+}
+
+func NewLogPollerFilters(filters []chainlink.LogPollerFilter, chainID string) []*LogPollerFilterResolver {
+	var resolvers []*LogPollerFilterResolver
+	for _, f := range filters {
+		resolvers = append(resolvers, NewLogPollerFilter(f, chainID))
+	}
+
+	return resolvers
+}
+
+func (r *LogPollerFilterResolver) Name() string {
+	return r.filter.Name
+}
+
+func (r *LogPollerFilterResolver) Chain(ctx context.Context) (*ChainResolver, error) {
+	chain, err := loader.GetChainByID(ctx, r.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChain(*chain), nil
+}
+
+func (r *LogPollerFilterResolver) Addresses() []string {
+	addresses := []string{}
+	for _, address := range r.filter.Addresses {
+		addresses = append(addresses, address.Hex())
+	}
+	return addresses
+}
+
+func (r *LogPollerFilterResolver) EventSigs() []string {
+	eventSigs := []string{}
+	for _, eventSig := range r.filter.EventSigs {
+		eventSigs = append(eventSigs, eventSig.Hex())
+	}
+	return eventSigs
+}
+
+func (r *LogPollerFilterResolver) Retention() string {
+	return r.filter.Retention.String()
+}
+
+func (r *LogPollerFilterResolver) MaxLogsKept() string {
+	return strconv.FormatUint(r.filter.MaxLogsKept, 10)
+}
+
+func (r *LogPollerFilterResolver) LogsPerBlock() string {
+	return strconv.FormatUint(r.filter.LogsPerBlock, 10)
+}
+
+func (r *LogPollerFilterResolver) Logs() string {
+	return strconv.FormatInt(r.filter.Logs, 10)
+}
+
+func (r *LogPollerFilterResolver) SizeBytes() string {
+	return strconv.FormatInt(r.filter.SizeBytes, 10)
+}
+
+func (r *LogPollerFilterResolver) LogsLastHour() string {
+	return strconv.FormatInt(r.filter.LogsLastHour, 10)
+}
+
+func (r *LogPollerFilterResolver) JobIDs() []graphql.ID {
+	ids := []graphql.ID{}
+	for _, id := range r.filter.JobIDs {
+		ids = append(ids, int32GQLID(id))
+	}
+	return ids
+}
+
+// -- LogPollerFilters Query --
+
+type LogPollerFiltersPayloadResolver struct {
+	filters []chainlink.LogPollerFilter
+	chainID string
+	NotFoundErrorUnionType
+}
+
+func NewLogPollerFiltersPayload(filters []chainlink.LogPollerFilter, chainID string, err error) *LogPollerFiltersPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "chain not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, chains.ErrNoSuchChainID)
+	}}
+
+	return &LogPollerFiltersPayloadResolver{filters: filters, chainID: chainID, NotFoundErrorUnionType: e}
+}
+
+func (r *LogPollerFiltersPayloadResolver) ToLogPollerFilters() (*LogPollerFiltersResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &LogPollerFiltersResolver{filters: r.filters, chainID: r.chainID}, true
+}
+
+type LogPollerFiltersResolver struct {
+	filters []chainlink.LogPollerFilter
+	chainID string
+}
+
+func (r *LogPollerFiltersResolver) Results() []*LogPollerFilterResolver {
+	return NewLogPollerFilters(r.filters, r.chainID)
+}
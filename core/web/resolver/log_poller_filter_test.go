@@ -0,0 +1,109 @@
+package resolver
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+func TestResolver_LogPollerFilters(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetLogPollerFilters($chainID: ID!) {
+			logPollerFilters(chainID: $chainID) {
+				... on LogPollerFilters {
+					results {
+						name
+						addresses
+						eventSigs
+						retention
+						maxLogsKept
+						logsPerBlock
+						logs
+						sizeBytes
+						logsLastHour
+						jobIDs
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{"chainID": "12"}
+	address := common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+	eventSig := common.HexToHash("0x1")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "logPollerFilters"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("LogPollerFilters", mock.Anything, big.NewInt(12)).Return([]chainlink.LogPollerFilter{{
+					FilterStats: logpoller.FilterStats{
+						Filter: logpoller.Filter{
+							Name:        "OCR2ConfigPoller",
+							Addresses:   evmtypes.AddressArray{address},
+							EventSigs:   evmtypes.HashArray{eventSig},
+							Retention:   time.Hour,
+							MaxLogsKept: 100,
+						},
+						Logs:         42,
+						SizeBytes:    4096,
+						LogsLastHour: 3,
+					},
+					JobIDs: []int32{1, 2},
+				}}, nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"logPollerFilters": {
+						"results": [{
+							"name": "OCR2ConfigPoller",
+							"addresses": ["0x5431F5F973781809D18643b87B44921b11355d81"],
+							"eventSigs": ["0x0000000000000000000000000000000000000000000000000000000000000001"],
+							"retention": "1h0m0s",
+							"maxLogsKept": "100",
+							"logsPerBlock": "0",
+							"logs": "42",
+							"sizeBytes": "4096",
+							"logsLastHour": "3",
+							"jobIDs": ["1", "2"]
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "chain not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("LogPollerFilters", mock.Anything, big.NewInt(12)).Return(nil, chains.ErrNoSuchChainID)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"logPollerFilters": {
+						"message": "chain not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
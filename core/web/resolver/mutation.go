@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
@@ -15,9 +17,11 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
@@ -38,6 +42,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -848,6 +853,62 @@ func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	return NewRejectJobProposalSpecPayload(spec, err), nil
 }
 
+// RepairNonceGaps reports the nonce gaps of an EVM key and, unless it is a dry run, repairs them.
+func (r *Resolver) RepairNonceGaps(ctx context.Context, args struct {
+	Input struct {
+		Address    string
+		EVMChainID graphql.ID
+		Mode       string
+		DryRun     bool
+	}
+}) (*RepairNonceGapsPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(args.Input.Address) {
+		return NewRepairNonceGapsPayload(nil, nil, map[string]string{
+			"input/address": "invalid hex address",
+		}), nil
+	}
+	address := common.HexToAddress(args.Input.Address)
+
+	mode, err := txmgrcommon.ParseSequenceRepairMode(strings.ToLower(args.Input.Mode))
+	if err != nil {
+		return NewRepairNonceGapsPayload(nil, nil, map[string]string{
+			"input/mode": err.Error(),
+		}), nil
+	}
+
+	chain, err := r.App.GetRelayers().LegacyEVMChains().Get(string(args.Input.EVMChainID))
+	if err != nil {
+		if errors.Is(err, chains.ErrNoSuchChainID) {
+			return NewRepairNonceGapsPayload(nil, err, nil), nil
+		}
+		return nil, err
+	}
+
+	gasLimit := chain.Config().EVM().GasEstimator().LimitTransfer()
+	report, err := chain.TxManager().RepairSequenceGaps(ctx, address, mode, gasLimit, args.Input.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if !args.Input.DryRun {
+		r.App.GetAuditLogger().Audit(audit.KeyNonceGapsRepaired, map[string]interface{}{
+			"type":         "ethereum",
+			"id":           address.Hex(),
+			"evmChainID":   chain.ID().String(),
+			"mode":         mode,
+			"gaps":         report.Gaps,
+			"filledTxIDs":  report.FilledTxIDs,
+			"rewoundTxIDs": report.RewoundTxIDs,
+		})
+	}
+
+	return NewRepairNonceGapsPayload(NewNonceGapReport(report, chain.ID().String()), nil, nil), nil
+}
+
 // UpdateJobProposalSpecDefinition updates the spec definition.
 func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args struct {
 	ID    graphql.ID
@@ -1191,6 +1252,46 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
+func (r *Resolver) SimulateJob(ctx context.Context, args struct {
+	Input struct {
+		TOML  string
+		Vars  *string
+		Stubs *string
+	}
+}) (*SimulateJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	inputErrs := make(map[string]string)
+	spec, err := job.ValidatedPipelineSpec(args.Input.TOML)
+	if err != nil {
+		inputErrs["TOML spec"] = errors.Wrap(err, "failed to parse TOML").Error()
+	}
+	var vars map[string]interface{}
+	if args.Input.Vars != nil {
+		if err = json.Unmarshal([]byte(*args.Input.Vars), &vars); err != nil {
+			inputErrs["vars"] = "invalid JSON object"
+		}
+	}
+	var stubs pipeline.TaskStubs
+	if args.Input.Stubs != nil {
+		if err = json.Unmarshal([]byte(*args.Input.Stubs), &stubs); err != nil {
+			inputErrs["stubs"] = "invalid JSON object"
+		}
+	}
+	if len(inputErrs) > 0 {
+		return NewSimulateJobPayload(nil, inputErrs), nil
+	}
+
+	trace, err := r.App.SimulateJobV2(ctx, spec, vars, stubs)
+	if err != nil {
+		return NewSimulateJobPayload(nil, map[string]string{"input": err.Error()}), nil
+	}
+
+	return NewSimulateJobPayload(trace, nil), nil
+}
+
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
@@ -86,6 +86,20 @@ func (r *NodeResolver) Order() *int32 {
 	return r.node.Order
 }
 
+// LatencyScore resolves the node's score for the LowestLatency selection mode, null if it was not scored yet.
+func (r *NodeResolver) LatencyScore(ctx context.Context) (*string, error) {
+	scores, err := loader.GetNodeLatencyScoresByChainID(ctx, r.status.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	score, ok := scores[r.status.Name]
+	if !ok {
+		return nil, nil
+	}
+	s := score.String()
+	return &s, nil
+}
+
 // Chain resolves the node's chain object field.
 func (r *NodeResolver) Chain(ctx context.Context) (*ChainResolver, error) {
 	chain, err := loader.GetChainByID(ctx, r.status.ChainID)
@@ -3,6 +3,7 @@ package resolver
 import (
 	"context"
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
@@ -76,6 +77,43 @@ func TestResolver_Nodes(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			name:          "latency score",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.ethClient.On("NodeLatencyScores").Return(map[string]time.Duration{"node-name": 150 * time.Millisecond})
+				f.Mocks.chain.On("Client").Return(f.Mocks.ethClient)
+				f.Mocks.legacyEVMChains.On("Get", "1").Return(f.Mocks.chain, nil)
+				f.Mocks.legacyEVMChains.On("Get", "2").Return(nil, errors.New("chain not found"))
+				f.App.On("GetRelayers").Return(&chainlinkmocks.FakeRelayerChainInteroperators{
+					EVMChains: f.Mocks.legacyEVMChains,
+					Nodes: []types.NodeStatus{
+						{ChainID: "1", Name: "node-name", Config: "Name='node-name'", State: "alive"},
+						{ChainID: "1", Name: "unscored", Config: "Name='unscored'", State: "alive"},
+						{ChainID: "2", Name: "other", Config: "Name='other'", State: "alive"},
+					},
+				})
+			},
+			query: `
+				query GetNodes {
+					nodes {
+						results {
+							name
+							latencyScore
+						}
+					}
+				}`,
+			result: `
+			{
+				"nodes": {
+					"results": [
+						{"name": "node-name", "latencyScore": "150ms"},
+						{"name": "unscored", "latencyScore": null},
+						{"name": "other", "latencyScore": null}
+					]
+				}
+			}`,
+		},
 		{
 			name:          "generic error",
 			authenticated: true,
@@ -0,0 +1,204 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineSimulationResolver resolves the trace of a simulated pipeline run
+type PipelineSimulationResolver struct {
+	trace *pipeline.RunTrace
+}
+
+func NewPipelineSimulation(trace *pipeline.RunTrace) *PipelineSimulationResolver {
+	return &PipelineSimulationResolver{trace: trace}
+}
+
+func (r *PipelineSimulationResolver) State() string {
+	return string(r.trace.State)
+}
+
+func (r *PipelineSimulationResolver) Outputs() string {
+	return jsonString(r.trace.Outputs)
+}
+
+func (r *PipelineSimulationResolver) AllErrors() []string {
+	return validStrings(r.trace.AllErrors)
+}
+
+func (r *PipelineSimulationResolver) FatalErrors() []string {
+	return validStrings(r.trace.FatalErrors)
+}
+
+func (r *PipelineSimulationResolver) Tasks() []*PipelineSimulationTaskResolver {
+	resolvers := []*PipelineSimulationTaskResolver{}
+	for _, task := range r.trace.Tasks {
+		resolvers = append(resolvers, &PipelineSimulationTaskResolver{task: task})
+	}
+	return resolvers
+}
+
+func (r *PipelineSimulationResolver) Edges() []*PipelineSimulationEdgeResolver {
+	resolvers := []*PipelineSimulationEdgeResolver{}
+	for _, edge := range r.trace.Edges {
+		resolvers = append(resolvers, &PipelineSimulationEdgeResolver{edge: edge})
+	}
+	return resolvers
+}
+
+func (r *PipelineSimulationResolver) Dot() string {
+	return r.trace.DOT()
+}
+
+func (r *PipelineSimulationResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.trace.CreatedAt}
+}
+
+func (r *PipelineSimulationResolver) FinishedAt() *graphql.Time {
+	if !r.trace.FinishedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.trace.FinishedAt.Time}
+}
+
+type PipelineSimulationTaskResolver struct {
+	task pipeline.TaskTrace
+}
+
+func (r *PipelineSimulationTaskResolver) DotID() string {
+	return r.task.DotID
+}
+
+func (r *PipelineSimulationTaskResolver) Type() string {
+	return string(r.task.Type)
+}
+
+func (r *PipelineSimulationTaskResolver) Inputs() []*PipelineSimulationTaskInputResolver {
+	resolvers := []*PipelineSimulationTaskInputResolver{}
+	for _, input := range r.task.Inputs {
+		resolvers = append(resolvers, &PipelineSimulationTaskInputResolver{input: input})
+	}
+	return resolvers
+}
+
+func (r *PipelineSimulationTaskResolver) Output() string {
+	return jsonString(r.task.Output)
+}
+
+func (r *PipelineSimulationTaskResolver) Error() *string {
+	return r.task.Error.Ptr()
+}
+
+func (r *PipelineSimulationTaskResolver) Stubbed() bool {
+	return r.task.Stubbed
+}
+
+func (r *PipelineSimulationTaskResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.task.CreatedAt}
+}
+
+func (r *PipelineSimulationTaskResolver) FinishedAt() *graphql.Time {
+	if !r.task.FinishedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.task.FinishedAt.Time}
+}
+
+func (r *PipelineSimulationTaskResolver) Elapsed() string {
+	return r.task.Elapsed.Duration().String()
+}
+
+type PipelineSimulationTaskInputResolver struct {
+	input pipeline.TaskTraceInput
+}
+
+func (r *PipelineSimulationTaskInputResolver) DotID() string {
+	return r.input.DotID
+}
+
+func (r *PipelineSimulationTaskInputResolver) Value() string {
+	return jsonString(r.input.Value)
+}
+
+func (r *PipelineSimulationTaskInputResolver) Error() *string {
+	return r.input.Error.Ptr()
+}
+
+type PipelineSimulationEdgeResolver struct {
+	edge pipeline.EdgeTrace
+}
+
+func (r *PipelineSimulationEdgeResolver) From() string {
+	return r.edge.From
+}
+
+func (r *PipelineSimulationEdgeResolver) To() string {
+	return r.edge.To
+}
+
+func (r *PipelineSimulationEdgeResolver) Implicit() bool {
+	return r.edge.Implicit
+}
+
+// -- SimulateJob Mutation --
+
+type SimulateJobPayloadResolver struct {
+	trace     *pipeline.RunTrace
+	inputErrs map[string]string
+}
+
+func NewSimulateJobPayload(trace *pipeline.RunTrace, inputErrs map[string]string) *SimulateJobPayloadResolver {
+	return &SimulateJobPayloadResolver{trace: trace, inputErrs: inputErrs}
+}
+
+func (r *SimulateJobPayloadResolver) ToSimulateJobSuccess() (*SimulateJobSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return &SimulateJobSuccessResolver{trace: r.trace}, true
+}
+
+func (r *SimulateJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type SimulateJobSuccessResolver struct {
+	trace *pipeline.RunTrace
+}
+
+func (r *SimulateJobSuccessResolver) Simulation() *PipelineSimulationResolver {
+	return NewPipelineSimulation(r.trace)
+}
+
+func jsonString(v jsonserializable.JSONSerializable) string {
+	b, err := v.MarshalJSON()
+	if err != nil {
+		return "error: unable to marshal value"
+	}
+	return string(b)
+}
+
+func validStrings(ss []null.String) []string {
+	strs := []string{}
+	for _, s := range ss {
+		if s.Valid {
+			strs = append(strs, s.String)
+		}
+	}
+	return strs
+}
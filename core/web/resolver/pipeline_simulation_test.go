@@ -0,0 +1,188 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestResolver_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SimulateJob($input: SimulateJobInput!) {
+			simulateJob(input: $input) {
+				... on SimulateJobSuccess {
+					simulation {
+						state
+						outputs
+						allErrors
+						fatalErrors
+						tasks {
+							dotID
+							type
+							inputs {
+								dotID
+								value
+								error
+							}
+							output
+							error
+							stubbed
+							elapsed
+						}
+						edges {
+							from
+							to
+							implicit
+						}
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	toml := `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+ds    [type=bridge name="bridge"]
+parse [type=jsonparse path="price"]
+ds -> parse
+"""
+`
+	stubs := pipeline.TaskStubs{"ds": {Value: `{"price": 1}`}}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: map[string]interface{}{"input": map[string]interface{}{"TOML": toml}}}, "simulateJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("SimulateJobV2", mock.Anything, mock.MatchedBy(func(spec pipeline.Spec) bool {
+					return spec.JobType == "webhook"
+				}), map[string]interface{}{"foo": "bar"}, stubs).Return(&pipeline.RunTrace{
+					State:       pipeline.RunStatusCompleted,
+					Outputs:     jsonserializable.JSONSerializable{Val: []interface{}{"1"}, Valid: true},
+					FatalErrors: pipeline.RunErrors{null.String{}},
+					Tasks: []pipeline.TaskTrace{
+						{
+							DotID:   "ds",
+							Type:    pipeline.TaskTypeBridge,
+							Output:  jsonserializable.JSONSerializable{Val: `{"price": 1}`, Valid: true},
+							Stubbed: true,
+						},
+						{
+							DotID:   "parse",
+							Type:    pipeline.TaskTypeJSONParse,
+							Inputs:  []pipeline.TaskTraceInput{{DotID: "ds", Value: jsonserializable.JSONSerializable{Val: `{"price": 1}`, Valid: true}}},
+							Output:  jsonserializable.JSONSerializable{Val: "1", Valid: true},
+							Elapsed: models.Interval(time.Millisecond),
+						},
+					},
+					Edges: []pipeline.EdgeTrace{{From: "ds", To: "parse"}},
+				}, nil)
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML":  toml,
+					"vars":  `{"foo": "bar"}`,
+					"stubs": `{"ds": {"value": "{\"price\": 1}"}}`,
+				},
+			},
+			result: `
+				{
+					"simulateJob": {
+						"simulation": {
+							"state": "completed",
+							"outputs": "[\"1\"]",
+							"allErrors": [],
+							"fatalErrors": [],
+							"tasks": [
+								{
+									"dotID": "ds",
+									"type": "bridge",
+									"inputs": [],
+									"output": "\"{\\\"price\\\": 1}\"",
+									"error": null,
+									"stubbed": true,
+									"elapsed": "0s"
+								},
+								{
+									"dotID": "parse",
+									"type": "jsonparse",
+									"inputs": [{"dotID": "ds", "value": "\"{\\\"price\\\": 1}\"", "error": null}],
+									"output": "\"1\"",
+									"error": null,
+									"stubbed": false,
+									"elapsed": "1ms"
+								}
+							],
+							"edges": [{"from": "ds", "to": "parse", "implicit": false}]
+						}
+					}
+				}`,
+		},
+		{
+			name:          "input errors",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML": `type = "webhook"`,
+				},
+			},
+			result: `
+				{
+					"simulateJob": {
+						"errors": [{
+							"path": "TOML spec",
+							"message": "failed to parse TOML: invalid schema version",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "simulation error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("SimulateJobV2", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.New("cannot stub task ds2: no such task"))
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML":  toml,
+					"stubs": `{"ds2": {"value": 1}}`,
+				},
+			},
+			result: `
+				{
+					"simulateJob": {
+						"errors": [{
+							"path": "input",
+							"message": "cannot stub task ds2: no such task",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
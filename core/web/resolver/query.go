@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -385,6 +386,31 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	return NewJobRunsPayload(runs, int32(count), r.App), nil
 }
 
+// LogPollerFilters retrieves the filters registered with the LogPoller of a chain, with the storage used by their logs.
+func (r *Resolver) LogPollerFilters(ctx context.Context, args struct {
+	ChainID graphql.ID
+}) (*LogPollerFiltersPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	chainID, ok := new(big.Int).SetString(string(args.ChainID), 10)
+	if !ok {
+		return nil, errors.Errorf("invalid chain id: %s", args.ChainID)
+	}
+
+	filters, err := r.App.LogPollerFilters(ctx, chainID)
+	if err != nil {
+		if errors.Is(err, chains.ErrNoSuchChainID) {
+			return NewLogPollerFiltersPayload(nil, chainID.String(), err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewLogPollerFiltersPayload(filters, chainID.String(), nil), nil
+}
+
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
@@ -293,6 +293,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		lpfc := LogPollerFiltersController{app}
+		authv2.GET("/log_poller/filters", lpfc.Index)
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
@@ -321,6 +323,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
 		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
 		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		authv2.POST("/keys/evm/nonce_gaps", auth.RequiresAdminRole(ekc.RepairNonceGaps))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
@@ -370,11 +373,14 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresEditRole(jc.Simulate))
+		authv2.POST("/jobs/replay", auth.RequiresEditRole(jc.Replay))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.GET("/pipeline/runs/:runID/replay_bundle", prc.ReplayBundle)
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
@@ -385,6 +391,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		// PipelineJobSpecErrorsController
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
 
+		ptc := PipelineTemplatesController{app}
+		authv2.GET("/pipeline/templates", ptc.Index)
+		authv2.GET("/pipeline/templates/:Name", ptc.Show)
+		authv2.POST("/pipeline/templates", auth.RequiresEditRole(ptc.Create))
+
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))